@@ -28,3 +28,43 @@ type TypeWithValidate interface {
 	// Type.
 	Validate(context.Context, tftypes.Value, path.Path) diag.Diagnostics
 }
+
+// TypeWithConversionError extends the attr.Type interface to include a
+// ConversionError method, used to customize the error diagnostics returned
+// when the Type ValueFromTerraform method returns an error while the
+// framework is converting Terraform data, such as configuration, into the
+// Type's value. By default, the framework returns a generic error diagnostic
+// which directs practitioners to report the issue to the provider developer.
+type TypeWithConversionError interface {
+	attr.Type
+
+	// ConversionError should set practitioner-facing error diagnostics which
+	// describe why the Terraform value could not be converted, such as
+	// "expected CIDR like 10.0.0.0/16". If no diagnostics are set, the
+	// framework default error diagnostic is used.
+	ConversionError(context.Context, ConversionErrorRequest, *ConversionErrorResponse)
+}
+
+// ConversionErrorRequest represents a request for the Type to describe a
+// ValueFromTerraform error. An instance of this request struct is supplied
+// as an argument to the ConversionError method.
+type ConversionErrorRequest struct {
+	// Path is the path to the value which could not be converted.
+	Path path.Path
+
+	// Value is the Terraform value which could not be converted.
+	Value tftypes.Value
+
+	// Error is the error returned by the Type ValueFromTerraform method.
+	Error error
+}
+
+// ConversionErrorResponse represents a response to a ConversionErrorRequest.
+// An instance of this response struct is supplied as an argument to the
+// ConversionError method.
+type ConversionErrorResponse struct {
+	// Diagnostics replace the framework default error diagnostic, if any
+	// are set. Diagnostics without a path are associated with the request
+	// path.
+	Diagnostics diag.Diagnostics
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configgen
+
+import (
+	"context"
+	"fmt"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// DataSource returns the configuration of a data source with the given type
+// name and name, such as data "examplecloud_thing" "example" { ... }, with the
+// configurable attribute and block values of the given model. The model
+// should be a struct whose fields have the tfsdk field tag, like the struct
+// used with the tfsdk.Config type Get method.
+//
+// Computed-only attributes and null values are omitted. An error diagnostic
+// is returned if the model contains unknown values.
+func DataSource(ctx context.Context, typeName string, name string, schema datasourceschema.Schema, model any) (string, diag.Diagnostics) {
+	return generate(ctx, fmt.Sprintf("data %q %q", typeName, name), schema, model)
+}
+
+// Resource returns the configuration of a managed resource with the given
+// type name and name, such as resource "examplecloud_thing" "example" { ... },
+// with the configurable attribute and block values of the given model. The
+// model should be a struct whose fields have the tfsdk field tag, like the
+// struct used with the tfsdk.Config type Get method.
+//
+// Computed-only attributes and null values are omitted. An error diagnostic
+// is returned if the model contains unknown values.
+func Resource(ctx context.Context, typeName string, name string, schema resourceschema.Schema, model any) (string, diag.Diagnostics) {
+	return generate(ctx, fmt.Sprintf("resource %q %q", typeName, name), schema, model)
+}
+
+// generate returns the configuration block with the given header and the
+// body generated from the model.
+func generate(ctx context.Context, header string, schema fwschema.Schema, model any) (string, diag.Diagnostics) {
+	value, diags := reflect.FromValue(ctx, schema.Type(), model, reflect.Options{UntypedNilAsNull: true}, path.Empty())
+
+	if diags.HasError() {
+		return "", diags
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"Configuration Generation Error",
+			"An unexpected error was encountered trying to convert the model into a Terraform value.\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+
+		return "", diags
+	}
+
+	w := &writer{}
+
+	w.writeLine(0, header+" {")
+	diags.Append(w.writeBody(1, path.Empty(), schema.GetAttributes(), schema.GetBlocks(), tfValue)...)
+	w.writeLine(0, "}")
+
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return w.String(), diags
+}
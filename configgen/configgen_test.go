@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configgen_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/configgen"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResource(t *testing.T) {
+	t.Parallel()
+
+	testSchema := resourceschema.Schema{
+		Attributes: map[string]resourceschema.Attribute{
+			"id": resourceschema.StringAttribute{
+				Computed: true,
+			},
+			"name": resourceschema.StringAttribute{
+				Required: true,
+			},
+			"enabled": resourceschema.BoolAttribute{
+				Optional: true,
+			},
+			"port": resourceschema.Int64Attribute{
+				Optional: true,
+			},
+			"ratio": resourceschema.Float64Attribute{
+				Optional: true,
+			},
+			"tags": resourceschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"zones": resourceschema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"settings": resourceschema.SingleNestedAttribute{
+				Attributes: map[string]resourceschema.Attribute{
+					"mode": resourceschema.StringAttribute{
+						Optional: true,
+					},
+					"status": resourceschema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Optional: true,
+			},
+		},
+		Blocks: map[string]resourceschema.Block{
+			"rule": resourceschema.ListNestedBlock{
+				NestedObject: resourceschema.NestedBlockObject{
+					Attributes: map[string]resourceschema.Attribute{
+						"action": resourceschema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	type testSettingsModel struct {
+		Mode   types.String `tfsdk:"mode"`
+		Status types.String `tfsdk:"status"`
+	}
+
+	type testRuleModel struct {
+		Action types.String `tfsdk:"action"`
+	}
+
+	type testModel struct {
+		ID       types.String       `tfsdk:"id"`
+		Name     types.String       `tfsdk:"name"`
+		Enabled  types.Bool         `tfsdk:"enabled"`
+		Port     types.Int64        `tfsdk:"port"`
+		Ratio    types.Float64      `tfsdk:"ratio"`
+		Tags     map[string]string  `tfsdk:"tags"`
+		Zones    []string           `tfsdk:"zones"`
+		Settings *testSettingsModel `tfsdk:"settings"`
+		Rule     []testRuleModel    `tfsdk:"rule"`
+	}
+
+	testCases := map[string]struct {
+		model         any
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"all": {
+			model: testModel{
+				ID:      types.StringValue("computed"),
+				Name:    types.StringValue("example \"quoted\" ${template}"),
+				Enabled: types.BoolValue(true),
+				Port:    types.Int64Value(8080),
+				Ratio:   types.Float64Value(1.5),
+				Tags: map[string]string{
+					"Environment": "test",
+					"team:name":   "example",
+				},
+				Zones: []string{"a", "b"},
+				Settings: &testSettingsModel{
+					Mode:   types.StringValue("fast"),
+					Status: types.StringValue("computed"),
+				},
+				Rule: []testRuleModel{
+					{Action: types.StringValue("allow")},
+					{Action: types.StringValue("deny")},
+				},
+			},
+			expected: `resource "examplecloud_thing" "example" {
+  enabled  = true
+  name     = "example \"quoted\" $${template}"
+  port     = 8080
+  ratio    = 1.5
+  settings = { mode = "fast" }
+  tags     = { Environment = "test", "team:name" = "example" }
+  zones    = ["a", "b"]
+
+  rule {
+    action = "allow"
+  }
+
+  rule {
+    action = "deny"
+  }
+}
+`,
+		},
+		"null-values": {
+			model: testModel{
+				Name: types.StringValue("example"),
+			},
+			expected: `resource "examplecloud_thing" "example" {
+  name = "example"
+}
+`,
+		},
+		"unknown-value": {
+			model: testModel{
+				Name: types.StringUnknown(),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("name"),
+					"Invalid Configuration Value",
+					"Configuration cannot be generated for unknown values. Set the value to a known value or null.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := configgen.Resource(context.Background(), "examplecloud_thing", "example", testSchema, testCase.model)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDataSource(t *testing.T) {
+	t.Parallel()
+
+	testSchema := datasourceschema.Schema{
+		Attributes: map[string]datasourceschema.Attribute{
+			"filter": datasourceschema.ObjectAttribute{
+				AttributeTypes: map[string]attr.Type{
+					"name":   types.StringType,
+					"values": types.SetType{ElemType: types.StringType},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	model := struct {
+		Filter types.Object `tfsdk:"filter"`
+	}{
+		Filter: types.ObjectValueMust(
+			map[string]attr.Type{
+				"name":   types.StringType,
+				"values": types.SetType{ElemType: types.StringType},
+			},
+			map[string]attr.Value{
+				"name":   types.StringValue("tag:Name"),
+				"values": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("example")}),
+			},
+		),
+	}
+
+	expected := `data "examplecloud_thing" "example" {
+  filter = { name = "tag:Name", values = ["example"] }
+}
+`
+
+	got, diags := configgen.DataSource(context.Background(), "examplecloud_thing", "example", testSchema, model)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package configgen renders example Terraform configuration from a data
+// source or resource schema and a Go model value, such as the model types
+// used with the tfsdk.Config type Get method. Since the configuration is
+// generated using the schema, configuration in unit tests or documentation
+// examples stays valid as the schema evolves, and models which no longer
+// match the schema return error diagnostics.
+//
+// For example:
+//
+//	config, diags := configgen.Resource(ctx, "examplecloud_thing", "example", resp.Schema, ThingResourceModel{
+//		Name: types.StringValue("example"),
+//	})
+package configgen
@@ -0,0 +1,411 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configgen
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// identifierRegex matches map and object keys which can be written without
+// quotes.
+var identifierRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// writer accumulates generated configuration.
+type writer struct {
+	strings.Builder
+}
+
+// writeLine writes a single line with the given indentation level.
+func (w *writer) writeLine(indent int, line string) {
+	w.WriteString(strings.Repeat("  ", indent))
+	w.WriteString(line)
+	w.WriteString("\n")
+}
+
+// writeBody writes the configurable attributes, then blocks, of the given
+// object value. Attribute equals signs are aligned, matching terraform fmt.
+func (w *writer) writeBody(indent int, bodyPath path.Path, attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block, value tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	values, ok := objectValues(value)
+
+	if !ok {
+		return diags
+	}
+
+	var names []string
+	var expressions []string
+	var width int
+
+	for _, name := range fwschema.SortedNames(attributes) {
+		attribute := attributes[name]
+
+		if !isConfigurable(attribute) || values[name].IsNull() {
+			continue
+		}
+
+		expression, expressionDiags := attributeExpression(bodyPath.AtName(name), attribute, values[name])
+
+		diags.Append(expressionDiags...)
+
+		if expressionDiags.HasError() {
+			continue
+		}
+
+		names = append(names, name)
+		expressions = append(expressions, expression)
+
+		if len(name) > width {
+			width = len(name)
+		}
+	}
+
+	for i, name := range names {
+		w.writeLine(indent, fmt.Sprintf("%-*s = %s", width, name, expressions[i]))
+	}
+
+	written := len(names) > 0
+
+	for _, name := range fwschema.SortedNames(blocks) {
+		block := blocks[name]
+		blockPath := bodyPath.AtName(name)
+
+		if values[name].IsNull() {
+			continue
+		}
+
+		if !values[name].IsKnown() {
+			diags.Append(unknownValueDiag(blockPath))
+			continue
+		}
+
+		var elements []tftypes.Value
+
+		switch block.GetNestingMode() {
+		case fwschema.BlockNestingModeSingle:
+			elements = []tftypes.Value{values[name]}
+		default:
+			if err := values[name].As(&elements); err != nil {
+				diags.Append(valueConversionDiag(blockPath, err))
+				continue
+			}
+		}
+
+		for index, element := range elements {
+			if element.IsNull() {
+				continue
+			}
+
+			// Set element paths require the element value, so diagnostics
+			// for set elements use the set path instead.
+			elementPath := blockPath
+
+			if block.GetNestingMode() == fwschema.BlockNestingModeList {
+				elementPath = blockPath.AtListIndex(index)
+			}
+
+			if !element.IsKnown() {
+				diags.Append(unknownValueDiag(elementPath))
+				continue
+			}
+
+			if written {
+				w.writeLine(0, "")
+			}
+
+			nestedObject := block.GetNestedObject()
+
+			w.writeLine(indent, name+" {")
+			diags.Append(w.writeBody(indent+1, elementPath, nestedObject.GetAttributes(), nestedObject.GetBlocks(), element)...)
+			w.writeLine(indent, "}")
+
+			written = true
+		}
+	}
+
+	return diags
+}
+
+// attributeExpression returns the expression of an attribute value. The
+// values of nested attributes only include configurable nested attributes.
+func attributeExpression(attributePath path.Path, attribute fwschema.Attribute, value tftypes.Value) (string, diag.Diagnostics) {
+	nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+	if !ok || nestedAttribute.GetNestedObject() == nil {
+		return expression(attributePath, value)
+	}
+
+	nestedAttributes := nestedAttribute.GetNestedObject().GetAttributes()
+
+	if nestedAttribute.GetNestingMode() == fwschema.NestingModeSingle {
+		return nestedObjectExpression(attributePath, nestedAttributes, value)
+	}
+
+	if !value.IsKnown() {
+		return "", diag.Diagnostics{unknownValueDiag(attributePath)}
+	}
+
+	switch nestedAttribute.GetNestingMode() {
+	case fwschema.NestingModeMap:
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return "", diag.Diagnostics{valueConversionDiag(attributePath, err)}
+		}
+
+		return mapExpression(elements, func(key string, element tftypes.Value) (string, diag.Diagnostics) {
+			return nestedObjectExpression(attributePath.AtMapKey(key), nestedAttributes, element)
+		})
+	default:
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return "", diag.Diagnostics{valueConversionDiag(attributePath, err)}
+		}
+
+		return listExpression(elements, func(index int, element tftypes.Value) (string, diag.Diagnostics) {
+			// Set element paths require the element value, so diagnostics
+			// for set elements use the set path instead.
+			elementPath := attributePath
+
+			if nestedAttribute.GetNestingMode() == fwschema.NestingModeList {
+				elementPath = attributePath.AtListIndex(index)
+			}
+
+			return nestedObjectExpression(elementPath, nestedAttributes, element)
+		})
+	}
+}
+
+// nestedObjectExpression returns the object expression of a nested attribute
+// object value, only including configurable, non-null attributes.
+func nestedObjectExpression(objectPath path.Path, attributes map[string]fwschema.Attribute, value tftypes.Value) (string, diag.Diagnostics) {
+	if value.IsNull() {
+		return "null", nil
+	}
+
+	values, ok := objectValues(value)
+
+	if !ok {
+		return "", diag.Diagnostics{unknownValueDiag(objectPath)}
+	}
+
+	configurableValues := make(map[string]tftypes.Value, len(values))
+
+	for name, attribute := range attributes {
+		if !isConfigurable(attribute) || values[name].IsNull() {
+			continue
+		}
+
+		configurableValues[name] = values[name]
+	}
+
+	return mapExpression(configurableValues, func(name string, element tftypes.Value) (string, diag.Diagnostics) {
+		return attributeExpression(objectPath.AtName(name), attributes[name], element)
+	})
+}
+
+// expression returns the expression of a value.
+func expression(valuePath path.Path, value tftypes.Value) (string, diag.Diagnostics) {
+	if value.IsNull() {
+		return "null", nil
+	}
+
+	if !value.IsKnown() {
+		return "", diag.Diagnostics{unknownValueDiag(valuePath)}
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+
+		if err := value.As(&s); err != nil {
+			return "", diag.Diagnostics{valueConversionDiag(valuePath, err)}
+		}
+
+		return quote(s), nil
+	case value.Type().Is(tftypes.Number):
+		n := new(big.Float)
+
+		if err := value.As(&n); err != nil {
+			return "", diag.Diagnostics{valueConversionDiag(valuePath, err)}
+		}
+
+		return n.Text('f', -1), nil
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+
+		if err := value.As(&b); err != nil {
+			return "", diag.Diagnostics{valueConversionDiag(valuePath, err)}
+		}
+
+		return fmt.Sprintf("%t", b), nil
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return "", diag.Diagnostics{valueConversionDiag(valuePath, err)}
+		}
+
+		isSet := value.Type().Is(tftypes.Set{})
+
+		return listExpression(elements, func(index int, element tftypes.Value) (string, diag.Diagnostics) {
+			// Set element paths require the element value, so diagnostics
+			// for set elements use the set path instead.
+			if isSet {
+				return expression(valuePath, element)
+			}
+
+			return expression(valuePath.AtListIndex(index), element)
+		})
+	case value.Type().Is(tftypes.Map{}):
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return "", diag.Diagnostics{valueConversionDiag(valuePath, err)}
+		}
+
+		return mapExpression(elements, func(key string, element tftypes.Value) (string, diag.Diagnostics) {
+			return expression(valuePath.AtMapKey(key), element)
+		})
+	case value.Type().Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return "", diag.Diagnostics{valueConversionDiag(valuePath, err)}
+		}
+
+		return mapExpression(elements, func(name string, element tftypes.Value) (string, diag.Diagnostics) {
+			return expression(valuePath.AtName(name), element)
+		})
+	default:
+		return "", diag.Diagnostics{valueConversionDiag(valuePath, fmt.Errorf("unsupported value type: %s", value.Type()))}
+	}
+}
+
+// listExpression returns the tuple expression of the given elements.
+func listExpression(elements []tftypes.Value, elementExpression func(int, tftypes.Value) (string, diag.Diagnostics)) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	expressions := make([]string, 0, len(elements))
+
+	for index, element := range elements {
+		expression, expressionDiags := elementExpression(index, element)
+
+		diags.Append(expressionDiags...)
+
+		expressions = append(expressions, expression)
+	}
+
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return "[" + strings.Join(expressions, ", ") + "]", diags
+}
+
+// mapExpression returns the object expression of the given elements, sorted
+// by key.
+func mapExpression(elements map[string]tftypes.Value, elementExpression func(string, tftypes.Value) (string, diag.Diagnostics)) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(elements) == 0 {
+		return "{}", diags
+	}
+
+	keys := make([]string, 0, len(elements))
+
+	for key := range elements {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	items := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		expression, expressionDiags := elementExpression(key, elements[key])
+
+		diags.Append(expressionDiags...)
+
+		if !identifierRegex.MatchString(key) {
+			key = quote(key)
+		}
+
+		items = append(items, key+" = "+expression)
+	}
+
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return "{ " + strings.Join(items, ", ") + " }", diags
+}
+
+// quote returns the quoted string template expression of the given string,
+// escaping template sequences.
+func quote(s string) string {
+	s = strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+		"${", "$${",
+		"%{", "%%{",
+	).Replace(s)
+
+	return `"` + s + `"`
+}
+
+// objectValues returns the attribute values of a known, non-null object.
+func objectValues(value tftypes.Value) (map[string]tftypes.Value, bool) {
+	if value.IsNull() || !value.IsKnown() {
+		return nil, false
+	}
+
+	var values map[string]tftypes.Value
+
+	if err := value.As(&values); err != nil {
+		return nil, false
+	}
+
+	return values, true
+}
+
+// isConfigurable returns true if the attribute can be configured.
+func isConfigurable(attribute fwschema.Attribute) bool {
+	return attribute.IsRequired() || attribute.IsOptional()
+}
+
+// unknownValueDiag returns an error diagnostic for an unknown value, which
+// cannot be represented in configuration.
+func unknownValueDiag(valuePath path.Path) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		valuePath,
+		"Invalid Configuration Value",
+		"Configuration cannot be generated for unknown values. Set the value to a known value or null.",
+	)
+}
+
+// valueConversionDiag returns an error diagnostic for unexpected value
+// conversion errors.
+func valueConversionDiag(valuePath path.Path, err error) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		valuePath,
+		"Configuration Generation Error",
+		"An unexpected error was encountered trying to generate configuration for the value.\n\n"+
+			fmt.Sprintf("Error: %s", err),
+	)
+}
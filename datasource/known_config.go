@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// RequireKnownConfigValues verifies that the configuration values matching
+// the given path expressions are known, so the data source Read method can
+// use them. It returns true when every matching value is known and Read
+// should continue. Call it at the beginning of the Read method:
+//
+//	func (d *ThingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+//		if !datasource.RequireKnownConfigValues(ctx, req, resp, path.MatchRoot("id")) {
+//			return
+//		}
+//
+//		// ... read the thing ...
+//	}
+//
+// When a value is unknown and the Terraform client supports deferred
+// actions, the response is deferred with
+// DeferredReasonDataSourceConfigUnknown so Terraform can read the data
+// source once the value is known. Otherwise, an error diagnostic with
+// guidance for practitioners is added for each unknown value.
+func RequireKnownConfigValues(ctx context.Context, req ReadRequest, resp *ReadResponse, expressions ...path.Expression) bool {
+	var unknownPaths path.Paths
+
+	for _, expression := range expressions {
+		matchedPaths, diags := req.Config.PathMatches(ctx, expression)
+
+		resp.Diagnostics.Append(diags...)
+
+		for _, matchedPath := range matchedPaths {
+			var value attr.Value
+
+			diags := req.Config.GetAttribute(ctx, matchedPath, &value)
+
+			resp.Diagnostics.Append(diags...)
+
+			if diags.HasError() {
+				continue
+			}
+
+			if value.IsUnknown() {
+				unknownPaths.Append(matchedPath)
+			}
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return false
+	}
+
+	if len(unknownPaths) == 0 {
+		return true
+	}
+
+	if req.ClientCapabilities.DeferralAllowed {
+		resp.Deferred = &Deferred{
+			Reason: DeferredReasonDataSourceConfigUnknown,
+		}
+
+		return false
+	}
+
+	for _, unknownPath := range unknownPaths {
+		resp.Diagnostics.AddAttributeError(
+			unknownPath,
+			"Unknown Configuration Value",
+			"The data source cannot be read because this configuration value is unknown. "+
+				"It may depend on a resource which has not been created or updated yet.\n\n"+
+				"Use a value which is known during planning, or apply the resources it depends on first, "+
+				"such as with the -target flag, and then apply the configuration again.",
+		)
+	}
+
+	return false
+}
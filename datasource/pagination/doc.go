@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package pagination provides helpers for data sources which list remote
+// objects across multiple API response pages, so the max_items attribute,
+// result filtering, and assembly of the resulting list are handled
+// consistently across data sources.
+package pagination
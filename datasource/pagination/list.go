@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pagination
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MaxItemsName is the conventional name of the maximum items attribute.
+const MaxItemsName = "max_items"
+
+// MaxItemsAttribute returns an optional int64 attribute for limiting the
+// number of list results, which should be added to the data source schema
+// attributes under MaxItemsName. The attribute value can be passed as the
+// ListRequest type MaxItems field.
+func MaxItemsAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description:         "Maximum number of results to return. Defaults to all results.",
+		MarkdownDescription: "Maximum number of results to return. Defaults to all results.",
+		Optional:            true,
+	}
+}
+
+// Page is a single page of results returned by a FetchFunc.
+type Page[T any] struct {
+	// Items are the results in the page.
+	Items []T
+
+	// NextPageToken is the opaque token used to fetch the next page. An
+	// empty token signals the last page.
+	NextPageToken string
+}
+
+// FetchFunc is the provider-defined logic which fetches a single page of
+// results. The page token is empty for the first page.
+type FetchFunc[T any] func(ctx context.Context, pageToken string) (Page[T], diag.Diagnostics)
+
+// ListRequest is the request for List.
+type ListRequest[T any] struct {
+	// ElementType is the element type of the returned list. The fetched
+	// items must be convertible into values of this type, following the
+	// framework reflection rules, such as struct types with `tfsdk` field
+	// tags for object element types.
+	ElementType attr.Type
+
+	// Fetch fetches a single page of results.
+	Fetch FetchFunc[T]
+
+	// Filter, if set, is called for each fetched item and only items for
+	// which it returns true are included in the results.
+	Filter func(T) bool
+
+	// MaxItems limits the number of results. Null or unknown values return
+	// all results. No further pages are fetched once the limit is reached.
+	MaxItems types.Int64
+
+	// MaxItemsPath is the path of the MaxItems attribute, used for
+	// diagnostics. Defaults to the root MaxItemsName attribute.
+	MaxItemsPath path.Path
+}
+
+// List fetches pages of results until the last page or the MaxItems limit is
+// reached, filters the items, and returns the results as a list value. Error
+// diagnostics are returned if MaxItems is negative, fetching a page fails, or
+// a page token repeats, which would otherwise fetch pages indefinitely.
+func List[T any](ctx context.Context, req ListRequest[T]) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	maxItems := int64(-1)
+
+	if !req.MaxItems.IsNull() && !req.MaxItems.IsUnknown() {
+		maxItems = req.MaxItems.ValueInt64()
+
+		if maxItems < 0 {
+			maxItemsPath := req.MaxItemsPath
+
+			if len(maxItemsPath.Steps()) == 0 {
+				maxItemsPath = path.Root(MaxItemsName)
+			}
+
+			diags.AddAttributeError(
+				maxItemsPath,
+				"Invalid Maximum Items",
+				fmt.Sprintf("The maximum number of items must not be negative. Received: %d", maxItems),
+			)
+
+			return types.ListNull(req.ElementType), diags
+		}
+	}
+
+	items := []T{}
+	pageToken := ""
+	seenPageTokens := map[string]struct{}{}
+
+	for maxItems < 0 || int64(len(items)) < maxItems {
+		page, fetchDiags := req.Fetch(ctx, pageToken)
+
+		diags.Append(fetchDiags...)
+
+		if diags.HasError() {
+			return types.ListNull(req.ElementType), diags
+		}
+
+		for _, item := range page.Items {
+			if req.Filter != nil && !req.Filter(item) {
+				continue
+			}
+
+			if maxItems >= 0 && int64(len(items)) >= maxItems {
+				break
+			}
+
+			items = append(items, item)
+		}
+
+		if page.NextPageToken == "" {
+			break
+		}
+
+		if _, ok := seenPageTokens[page.NextPageToken]; ok {
+			diags.AddError(
+				"Pagination Error",
+				"An unexpected error was encountered while fetching results. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("The page token %q was returned more than once.", page.NextPageToken),
+			)
+
+			return types.ListNull(req.ElementType), diags
+		}
+
+		seenPageTokens[page.NextPageToken] = struct{}{}
+		pageToken = page.NextPageToken
+	}
+
+	result, listDiags := types.ListValueFrom(ctx, req.ElementType, items)
+
+	diags.Append(listDiags...)
+
+	return result, diags
+}
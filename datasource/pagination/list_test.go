@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pagination_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/pagination"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	testPages := map[string]pagination.Page[string]{
+		"":      {Items: []string{"a1", "b1"}, NextPageToken: "page2"},
+		"page2": {Items: []string{"a2", "b2"}, NextPageToken: "page3"},
+		"page3": {Items: []string{"a3"}},
+	}
+
+	testFetch := func(_ context.Context, pageToken string) (pagination.Page[string], diag.Diagnostics) {
+		return testPages[pageToken], nil
+	}
+
+	testListValue := func(values ...string) types.List {
+		elements := make([]attr.Value, 0, len(values))
+
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+
+		return types.ListValueMust(types.StringType, elements)
+	}
+
+	testCases := map[string]struct {
+		request       pagination.ListRequest[string]
+		expected      types.List
+		expectedPages int
+		expectedDiags diag.Diagnostics
+	}{
+		"all": {
+			request: pagination.ListRequest[string]{
+				MaxItems: types.Int64Null(),
+			},
+			expected:      testListValue("a1", "b1", "a2", "b2", "a3"),
+			expectedPages: 3,
+		},
+		"filter": {
+			request: pagination.ListRequest[string]{
+				Filter: func(item string) bool {
+					return strings.HasPrefix(item, "a")
+				},
+			},
+			expected:      testListValue("a1", "a2", "a3"),
+			expectedPages: 3,
+		},
+		"max-items": {
+			request: pagination.ListRequest[string]{
+				MaxItems: types.Int64Value(3),
+			},
+			expected:      testListValue("a1", "b1", "a2"),
+			expectedPages: 2,
+		},
+		"max-items-page-boundary": {
+			request: pagination.ListRequest[string]{
+				MaxItems: types.Int64Value(2),
+			},
+			expected:      testListValue("a1", "b1"),
+			expectedPages: 1,
+		},
+		"max-items-zero": {
+			request: pagination.ListRequest[string]{
+				MaxItems: types.Int64Value(0),
+			},
+			expected: testListValue(),
+		},
+		"max-items-filter": {
+			request: pagination.ListRequest[string]{
+				Filter: func(item string) bool {
+					return strings.HasPrefix(item, "b")
+				},
+				MaxItems: types.Int64Value(2),
+			},
+			expected:      testListValue("b1", "b2"),
+			expectedPages: 2,
+		},
+		"max-items-negative": {
+			request: pagination.ListRequest[string]{
+				MaxItems: types.Int64Value(-1),
+			},
+			expected: types.ListNull(types.StringType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("max_items"),
+					"Invalid Maximum Items",
+					"The maximum number of items must not be negative. Received: -1",
+				),
+			},
+		},
+		"max-items-negative-path": {
+			request: pagination.ListRequest[string]{
+				MaxItems:     types.Int64Value(-1),
+				MaxItemsPath: path.Root("limit"),
+			},
+			expected: types.ListNull(types.StringType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("limit"),
+					"Invalid Maximum Items",
+					"The maximum number of items must not be negative. Received: -1",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotPages int
+
+			testCase.request.ElementType = types.StringType
+			testCase.request.Fetch = func(ctx context.Context, pageToken string) (pagination.Page[string], diag.Diagnostics) {
+				gotPages++
+
+				return testFetch(ctx, pageToken)
+			}
+
+			got, diags := pagination.List(context.Background(), testCase.request)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if gotPages != testCase.expectedPages {
+				t.Errorf("expected %d pages fetched, got: %d", testCase.expectedPages, gotPages)
+			}
+		})
+	}
+}
+
+func TestList_FetchErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fetch         pagination.FetchFunc[string]
+		expectedDiags diag.Diagnostics
+	}{
+		"diagnostics": {
+			fetch: func(_ context.Context, _ string) (pagination.Page[string], diag.Diagnostics) {
+				return pagination.Page[string]{}, diag.Diagnostics{
+					diag.NewErrorDiagnostic("test summary", "test detail"),
+				}
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail"),
+			},
+		},
+		"repeated-page-token": {
+			fetch: func(_ context.Context, _ string) (pagination.Page[string], diag.Diagnostics) {
+				return pagination.Page[string]{
+					Items:         []string{"test"},
+					NextPageToken: "repeat",
+				}, nil
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Pagination Error",
+					"An unexpected error was encountered while fetching results. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The page token \"repeat\" was returned more than once.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := pagination.List(context.Background(), pagination.ListRequest[string]{
+				ElementType: types.StringType,
+				Fetch:       testCase.fetch,
+			})
+
+			if diff := cmp.Diff(got, types.ListNull(types.StringType)); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
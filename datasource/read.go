@@ -59,6 +59,7 @@ type ReadResponse struct {
 	//
 	// This field can only be set if
 	// `(datasource.ReadRequest).ClientCapabilities.DeferralAllowed` is true.
+	// Otherwise, the framework returns an error diagnostic.
 	//
 	// NOTE: This functionality is related to deferred action support, which is currently experimental and is subject
 	// to change or break without warning. It is not protected by version compatibility guarantees.
@@ -15,8 +15,9 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                             = BoolAttribute{}
-	_ fwxschema.AttributeWithBoolValidators = BoolAttribute{}
+	_ Attribute                                      = BoolAttribute{}
+	_ fwxschema.AttributeWithBoolValidators          = BoolAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = BoolAttribute{}
 )
 
 // BoolAttribute represents a schema attribute that is a boolean. When
@@ -119,6 +120,11 @@ type BoolAttribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Bool
+
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -132,6 +138,11 @@ func (a BoolAttribute) BoolValidators() []validator.Bool {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a BoolAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // Equal returns true if the given Attribute is a BoolAttribute
 // and all fields are equal.
 func (a BoolAttribute) Equal(o fwschema.Attribute) bool {
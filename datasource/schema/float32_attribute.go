@@ -16,8 +16,9 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                                = Float32Attribute{}
-	_ fwxschema.AttributeWithFloat32Validators = Float32Attribute{}
+	_ Attribute                                      = Float32Attribute{}
+	_ fwxschema.AttributeWithFloat32Validators       = Float32Attribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = Float32Attribute{}
 )
 
 // Float32Attribute represents a schema attribute that is a 32-bit floating
@@ -123,6 +124,11 @@ type Float32Attribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Float32
+
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -146,6 +152,11 @@ func (a Float32Attribute) Float32Validators() []validator.Float32 {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a Float32Attribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a Float32Attribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
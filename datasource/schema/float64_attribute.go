@@ -15,8 +15,9 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                                = Float64Attribute{}
-	_ fwxschema.AttributeWithFloat64Validators = Float64Attribute{}
+	_ Attribute                                      = Float64Attribute{}
+	_ fwxschema.AttributeWithFloat64Validators       = Float64Attribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = Float64Attribute{}
 )
 
 // Float64Attribute represents a schema attribute that is a 64-bit floating
@@ -122,6 +123,11 @@ type Float64Attribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Float64
+
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -145,6 +151,11 @@ func (a Float64Attribute) Float64Validators() []validator.Float64 {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a Float64Attribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a Float64Attribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
@@ -16,8 +16,9 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                              = Int32Attribute{}
-	_ fwxschema.AttributeWithInt32Validators = Int32Attribute{}
+	_ Attribute                                      = Int32Attribute{}
+	_ fwxschema.AttributeWithInt32Validators         = Int32Attribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = Int32Attribute{}
 )
 
 // Int32Attribute represents a schema attribute that is a 32-bit integer.
@@ -123,6 +124,11 @@ type Int32Attribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Int32
+
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -170,6 +176,11 @@ func (a Int32Attribute) Int32Validators() []validator.Int32 {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a Int32Attribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // IsComputed returns the Computed field value.
 func (a Int32Attribute) IsComputed() bool {
 	return a.Computed
@@ -18,9 +18,10 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                                    = ListAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = ListAttribute{}
-	_ fwxschema.AttributeWithListValidators        = ListAttribute{}
+	_ Attribute                                      = ListAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = ListAttribute{}
+	_ fwxschema.AttributeWithListValidators          = ListAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = ListAttribute{}
 )
 
 // ListAttribute represents a schema attribute that is a list with a single
@@ -138,6 +139,11 @@ type ListAttribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.List
+
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into a list
@@ -207,6 +213,11 @@ func (a ListAttribute) ListValidators() []validator.List {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a ListAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC
@@ -20,9 +20,10 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ NestedAttribute                              = MapNestedAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = MapNestedAttribute{}
-	_ fwxschema.AttributeWithMapValidators         = MapNestedAttribute{}
+	_ NestedAttribute                                = MapNestedAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = MapNestedAttribute{}
+	_ fwxschema.AttributeWithMapValidators           = MapNestedAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = MapNestedAttribute{}
 )
 
 // MapNestedAttribute represents an attribute that is a set of objects where
@@ -149,6 +150,11 @@ type MapNestedAttribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Map
+
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
 }
 
 // ApplyTerraform5AttributePathStep returns the Attributes field value if step
@@ -236,6 +242,11 @@ func (a MapNestedAttribute) MapValidators() []validator.Map {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a MapNestedAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
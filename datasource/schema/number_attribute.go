@@ -15,8 +15,9 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                               = NumberAttribute{}
-	_ fwxschema.AttributeWithNumberValidators = NumberAttribute{}
+	_ Attribute                                      = NumberAttribute{}
+	_ fwxschema.AttributeWithNumberValidators        = NumberAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = NumberAttribute{}
 )
 
 // NumberAttribute represents a schema attribute that is a generic number with
@@ -123,6 +124,11 @@ type NumberAttribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Number
+
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -189,3 +195,8 @@ func (a NumberAttribute) IsSensitive() bool {
 func (a NumberAttribute) NumberValidators() []validator.Number {
 	return a.Validators
 }
+
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a NumberAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
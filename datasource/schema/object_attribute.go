@@ -18,9 +18,10 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                                    = ObjectAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = ObjectAttribute{}
-	_ fwxschema.AttributeWithObjectValidators      = ObjectAttribute{}
+	_ Attribute                                      = ObjectAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = ObjectAttribute{}
+	_ fwxschema.AttributeWithObjectValidators        = ObjectAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = ObjectAttribute{}
 )
 
 // ObjectAttribute represents a schema attribute that is an object with only
@@ -140,6 +141,11 @@ type ObjectAttribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Object
+
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into an
@@ -209,6 +215,11 @@ func (a ObjectAttribute) ObjectValidators() []validator.Object {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a ObjectAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC
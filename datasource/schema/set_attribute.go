@@ -18,9 +18,10 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                                    = SetAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = SetAttribute{}
-	_ fwxschema.AttributeWithSetValidators         = SetAttribute{}
+	_ Attribute                                      = SetAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = SetAttribute{}
+	_ fwxschema.AttributeWithSetValidators           = SetAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = SetAttribute{}
 )
 
 // SetAttribute represents a schema attribute that is a set with a single
@@ -136,6 +137,11 @@ type SetAttribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Set
+
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into a set
@@ -205,6 +211,11 @@ func (a SetAttribute) SetValidators() []validator.Set {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a SetAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC
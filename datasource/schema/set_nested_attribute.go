@@ -20,9 +20,10 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ NestedAttribute                              = SetNestedAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = SetNestedAttribute{}
-	_ fwxschema.AttributeWithSetValidators         = SetNestedAttribute{}
+	_ NestedAttribute                                = SetNestedAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = SetNestedAttribute{}
+	_ fwxschema.AttributeWithSetValidators           = SetNestedAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = SetNestedAttribute{}
 )
 
 // SetNestedAttribute represents an attribute that is a set of objects where
@@ -144,6 +145,11 @@ type SetNestedAttribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Set
+
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
 }
 
 // ApplyTerraform5AttributePathStep returns the Attributes field value if step
@@ -231,6 +237,11 @@ func (a SetNestedAttribute) SetValidators() []validator.Set {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a SetNestedAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
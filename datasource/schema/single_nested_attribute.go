@@ -18,8 +18,9 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ NestedAttribute                         = SingleNestedAttribute{}
-	_ fwxschema.AttributeWithObjectValidators = SingleNestedAttribute{}
+	_ NestedAttribute                                = SingleNestedAttribute{}
+	_ fwxschema.AttributeWithObjectValidators        = SingleNestedAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = SingleNestedAttribute{}
 )
 
 // SingleNestedAttribute represents an attribute that is a single object where
@@ -136,6 +137,11 @@ type SingleNestedAttribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Object
+
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
 }
 
 // ApplyTerraform5AttributePathStep returns the Attributes field value if step
@@ -244,3 +250,8 @@ func (a SingleNestedAttribute) IsSensitive() bool {
 func (a SingleNestedAttribute) ObjectValidators() []validator.Object {
 	return a.Validators
 }
+
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a SingleNestedAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
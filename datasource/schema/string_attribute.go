@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/transformer"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -15,8 +16,10 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                               = StringAttribute{}
-	_ fwxschema.AttributeWithStringValidators = StringAttribute{}
+	_ Attribute                                      = StringAttribute{}
+	_ fwxschema.AttributeWithStringValidators        = StringAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = StringAttribute{}
+	_ fwschema.AttributeWithStringConfigTransformers = StringAttribute{}
 )
 
 // StringAttribute represents a schema attribute that is a string. When
@@ -119,6 +122,23 @@ type StringAttribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.String
+
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
+
+	// ConfigTransformers define transformations of the configuration value
+	// when provider logic reads the configuration, such as with the
+	// tfsdk.Config type Get and GetAttribute methods. All elements of the
+	// slice are run in order, each receiving the value returned by the
+	// previous transformer, until a transformer returns an error diagnostic.
+	// Null and unknown values are not transformed. The transformers run once
+	// for each configuration in an RPC.
+	//
+	// Transformed values are never sent to Terraform, therefore validators,
+	// plan modifiers, and the plan and state are unaffected.
+	ConfigTransformers []transformer.String
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -181,7 +201,17 @@ func (a StringAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// StringConfigTransformers returns the ConfigTransformers field value.
+func (a StringAttribute) StringConfigTransformers() []transformer.String {
+	return a.ConfigTransformers
+}
+
 // StringValidators returns the Validators field value.
 func (a StringAttribute) StringValidators() []validator.String {
 	return a.Validators
 }
+
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a StringAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+import (
+	"fmt"
+	"sync"
+)
+
+var (
+	// codeRegistry contains the description of each registered code.
+	codeRegistry = make(map[string]string)
+
+	// codeRegistryMutex is a mutex to protect concurrent codeRegistry access
+	// from race conditions.
+	codeRegistryMutex sync.RWMutex
+)
+
+// RegisterCode registers a machine-readable diagnostic code with a human
+// readable description, which documents the class of diagnostic for
+// automation. The code is returned, so it can be assigned to a package level
+// variable for usage with the WithCode() function.
+//
+// RegisterCode panics if the code is empty or already registered.
+func RegisterCode(code string, description string) string {
+	if code == "" {
+		panic("diag: RegisterCode called with empty code")
+	}
+
+	codeRegistryMutex.Lock()
+	defer codeRegistryMutex.Unlock()
+
+	if _, ok := codeRegistry[code]; ok {
+		panic(fmt.Sprintf("diag: RegisterCode called twice for code %q", code))
+	}
+
+	codeRegistry[code] = description
+
+	return code
+}
+
+// CodeDescription returns the description of the registered diagnostic code
+// and whether the code is registered.
+func CodeDescription(code string) (string, bool) {
+	codeRegistryMutex.RLock()
+	defer codeRegistryMutex.RUnlock()
+
+	description, ok := codeRegistry[code]
+
+	return description, ok
+}
+
+// RegisteredCodes returns a copy of all registered diagnostic codes and their
+// descriptions.
+func RegisteredCodes() map[string]string {
+	codeRegistryMutex.RLock()
+	defer codeRegistryMutex.RUnlock()
+
+	result := make(map[string]string, len(codeRegistry))
+
+	for code, description := range codeRegistry {
+		result[code] = description
+	}
+
+	return result
+}
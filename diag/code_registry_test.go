@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestRegisterCode(t *testing.T) {
+	t.Parallel()
+
+	got := diag.RegisterCode("TEST_REGISTER_CODE", "test description")
+
+	if diff := cmp.Diff(got, "TEST_REGISTER_CODE"); diff != "" {
+		t.Errorf("Unexpected code difference: %s", diff)
+	}
+
+	description, ok := diag.CodeDescription("TEST_REGISTER_CODE")
+
+	if !ok {
+		t.Fatal("Expected code to be registered")
+	}
+
+	if diff := cmp.Diff(description, "test description"); diff != "" {
+		t.Errorf("Unexpected description difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(diag.RegisteredCodes()["TEST_REGISTER_CODE"], "test description"); diff != "" {
+		t.Errorf("Unexpected registered codes difference: %s", diff)
+	}
+}
+
+func TestRegisterCode_duplicate(t *testing.T) {
+	t.Parallel()
+
+	diag.RegisterCode("TEST_REGISTER_CODE_DUPLICATE", "test description")
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("Expected panic")
+		}
+	}()
+
+	diag.RegisterCode("TEST_REGISTER_CODE_DUPLICATE", "test description")
+}
+
+func TestRegisterCode_empty(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("Expected panic")
+		}
+	}()
+
+	diag.RegisterCode("", "test description")
+}
+
+func TestCodeDescription_unregistered(t *testing.T) {
+	t.Parallel()
+
+	_, ok := diag.CodeDescription("TEST_UNREGISTERED_CODE")
+
+	if ok {
+		t.Error("Expected code to not be registered")
+	}
+}
@@ -15,7 +15,8 @@ import "github.com/hashicorp/terraform-plugin-framework/path"
 // implementations.
 //
 // To add path information to an existing diagnostic, see the WithPath()
-// function.
+// function. To add a machine-readable code to an existing diagnostic, see the
+// WithCode() function.
 type Diagnostic interface {
 	// Severity returns the desired level of feedback for the diagnostic.
 	Severity() Severity
@@ -51,3 +52,15 @@ type DiagnosticWithPath interface {
 	// supporting implementations such as Terraform CLI commands.
 	Path() path.Path
 }
+
+// DiagnosticWithCode is a diagnostic associated with a machine-readable code.
+//
+// This code information is used to enable automation to determine the class
+// of a diagnostic without matching on the summary or detail text.
+type DiagnosticWithCode interface {
+	Diagnostic
+
+	// Code is a machine-readable identifier for the class of diagnostic,
+	// such as "INVALID_REGION".
+	Code() string
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrorConverter converts errors, such as API client errors, into
+// diagnostics. Providers register converters with the
+// provider.ProviderWithErrorConverters interface, which are then used by
+// error-returning resource handlers, such as resource.CreateWithError.
+type ErrorConverter interface {
+	// ConvertError should return diagnostics describing the error and true,
+	// if the converter handles the error. Otherwise, it should return false.
+	ConvertError(ctx context.Context, err error) (Diagnostics, bool)
+}
+
+// ErrorAsConverter returns an ErrorConverter which handles errors matching
+// the error type T with errors.As, including wrapped errors, and converts
+// them with the given function. For example:
+//
+//	diag.ErrorAsConverter(func(ctx context.Context, err *api.Error) diag.Diagnostics {
+//		return diag.Diagnostics{
+//			diag.NewErrorDiagnostic("API Error", err.Code+": "+err.Message),
+//		}
+//	})
+func ErrorAsConverter[T error](convert func(context.Context, T) Diagnostics) ErrorConverter {
+	return errorAsConverter[T]{
+		convert: convert,
+	}
+}
+
+// errorAsConverter is the ErrorConverter returned by ErrorAsConverter.
+type errorAsConverter[T error] struct {
+	convert func(context.Context, T) Diagnostics
+}
+
+// ConvertError satisfies the ErrorConverter interface.
+func (c errorAsConverter[T]) ConvertError(ctx context.Context, err error) (Diagnostics, bool) {
+	var target T
+
+	if !errors.As(err, &target) {
+		return nil, false
+	}
+
+	return c.convert(ctx, target), true
+}
+
+// ConvertError returns the diagnostics of the first converter which handles
+// the error. If no converter handles the error, an error diagnostic with the
+// given summary and the error text as the detail is returned. If the error is
+// nil, no diagnostics are returned.
+func ConvertError(ctx context.Context, err error, summary string, converters ...ErrorConverter) Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	for _, converter := range converters {
+		if converter == nil {
+			continue
+		}
+
+		if diags, ok := converter.ConvertError(ctx, err); ok {
+			return diags
+		}
+	}
+
+	return Diagnostics{
+		NewErrorDiagnostic(summary, err.Error()),
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type testAPIError struct {
+	Code string
+}
+
+func (e *testAPIError) Error() string {
+	return "API error: " + e.Code
+}
+
+type testOtherError struct{}
+
+func (e *testOtherError) Error() string {
+	return "other error"
+}
+
+func TestConvertError(t *testing.T) {
+	t.Parallel()
+
+	apiErrorConverter := diag.ErrorAsConverter(func(_ context.Context, err *testAPIError) diag.Diagnostics {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic("API Error", err.Code),
+		}
+	})
+	otherErrorConverter := diag.ErrorAsConverter(func(_ context.Context, err *testOtherError) diag.Diagnostics {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic("Other Error", err.Error()),
+		}
+	})
+
+	testCases := map[string]struct {
+		err        error
+		converters []diag.ErrorConverter
+		expected   diag.Diagnostics
+	}{
+		"nil": {
+			converters: []diag.ErrorConverter{apiErrorConverter},
+			expected:   nil,
+		},
+		"no-converters": {
+			err: errors.New("test error"),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test error"),
+			},
+		},
+		"nil-converter": {
+			err:        errors.New("test error"),
+			converters: []diag.ErrorConverter{nil},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test error"),
+			},
+		},
+		"unhandled": {
+			err:        errors.New("test error"),
+			converters: []diag.ErrorConverter{apiErrorConverter},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test error"),
+			},
+		},
+		"handled": {
+			err:        &testAPIError{Code: "NotFound"},
+			converters: []diag.ErrorConverter{otherErrorConverter, apiErrorConverter},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("API Error", "NotFound"),
+			},
+		},
+		"handled-wrapped": {
+			err:        fmt.Errorf("reading thing: %w", &testAPIError{Code: "NotFound"}),
+			converters: []diag.ErrorConverter{apiErrorConverter},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("API Error", "NotFound"),
+			},
+		},
+		"handled-first-converter": {
+			err:        errors.Join(&testOtherError{}, &testAPIError{Code: "NotFound"}),
+			converters: []diag.ErrorConverter{apiErrorConverter, otherErrorConverter},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("API Error", "NotFound"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.ConvertError(context.Background(), tc.err, "test summary", tc.converters...)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
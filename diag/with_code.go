@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+var _ DiagnosticWithCode = withCode{}
+
+// withCode wraps a diagnostic with a machine-readable code.
+type withCode struct {
+	Diagnostic
+
+	code string
+}
+
+// Code returns the diagnostic code.
+func (d withCode) Code() string {
+	return d.code
+}
+
+// Equal returns true if the other diagnostic is wholly equivalent.
+func (d withCode) Equal(other Diagnostic) bool {
+	o, ok := other.(withCode)
+
+	if !ok {
+		return false
+	}
+
+	if d.Code() != o.Code() {
+		return false
+	}
+
+	if d.Diagnostic == nil {
+		return d.Diagnostic == o.Diagnostic
+	}
+
+	return d.Diagnostic.Equal(o.Diagnostic)
+}
+
+// WithCode wraps a diagnostic with a machine-readable code or overwrites the
+// code. Any path information of the diagnostic is preserved. Use the Code()
+// function to read the code of a diagnostic.
+//
+// Codes are included in the diagnostic detail and function error text sent to
+// Terraform. Register codes with the RegisterCode() function to document them.
+func WithCode(code string, d Diagnostic) Diagnostic {
+	switch d := d.(type) {
+	case withCode:
+		d.code = code
+
+		return d
+	case withPath:
+		d.Diagnostic = WithCode(code, d.Diagnostic)
+
+		return d
+	}
+
+	return withCode{
+		Diagnostic: d,
+		code:       code,
+	}
+}
+
+// Code returns the machine-readable code of the diagnostic, if any. An empty
+// string is returned if the diagnostic has no code.
+func Code(d Diagnostic) string {
+	switch d := d.(type) {
+	case DiagnosticWithCode:
+		return d.Code()
+	case withPath:
+		return Code(d.Diagnostic)
+	}
+
+	return ""
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestWithCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		code       string
+		diagnostic diag.Diagnostic
+		expected   diag.Diagnostic
+	}{
+		"diagnostic": {
+			code:       "TEST_CODE",
+			diagnostic: diag.NewErrorDiagnostic("test summary", "test detail"),
+			expected:   diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+		},
+		"diagnosticwithcode-overwrite": {
+			code:       "TEST_CODE",
+			diagnostic: diag.WithCode("OTHER_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+			expected:   diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+		},
+		"diagnosticwithpath": {
+			code:       "TEST_CODE",
+			diagnostic: diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			expected:   diag.WithPath(path.Root("test"), diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail"))),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.WithCode(tc.code, tc.diagnostic)
+
+			if !got.Equal(tc.expected) {
+				t.Errorf("Unexpected response: got: %#v, wanted: %#v", got, tc.expected)
+			}
+
+			if diff := cmp.Diff(diag.Code(got), tc.code); diff != "" {
+				t.Errorf("Unexpected code difference: %s", diff)
+			}
+
+			if _, ok := tc.diagnostic.(diag.DiagnosticWithPath); ok {
+				if _, ok := got.(diag.DiagnosticWithPath); !ok {
+					t.Errorf("Expected path information to be preserved")
+				}
+			}
+		})
+	}
+}
+
+func TestCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diagnostic diag.Diagnostic
+		expected   string
+	}{
+		"diagnostic": {
+			diagnostic: diag.NewErrorDiagnostic("test summary", "test detail"),
+			expected:   "",
+		},
+		"diagnosticwithcode": {
+			diagnostic: diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+			expected:   "TEST_CODE",
+		},
+		"diagnosticwithpath-diagnosticwithcode": {
+			diagnostic: diag.WithPath(path.Root("test"), diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail"))),
+			expected:   "TEST_CODE",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.Code(tc.diagnostic)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWithCodeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diagnostic diag.Diagnostic
+		other      diag.Diagnostic
+		expected   bool
+	}{
+		"matching": {
+			diagnostic: diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:      diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+			expected:   true,
+		},
+		"different-code": {
+			diagnostic: diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:      diag.WithCode("OTHER_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+			expected:   false,
+		},
+		"different-diagnostic": {
+			diagnostic: diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:      diag.WithCode("TEST_CODE", diag.NewWarningDiagnostic("test summary", "test detail")),
+			expected:   false,
+		},
+		"no-code": {
+			diagnostic: diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:      diag.NewErrorDiagnostic("test summary", "test detail"),
+			expected:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diagnostic.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("Unexpected response: got: %t, wanted: %t", got, tc.expected)
+			}
+		})
+	}
+}
@@ -95,6 +95,11 @@ func (d Definition) ValidateImplementation(ctx context.Context, req DefinitionVa
 		}
 
 		name := param.GetName()
+
+		if name != "" && !fwfunction.ValidNameRegex.MatchString(name) {
+			diags.Append(fwfunction.InvalidParameterNameDiag(req.FuncName, &parameterPosition, name))
+		}
+
 		conflictPos, exists := paramNames[name]
 
 		if exists && name != "" {
@@ -124,6 +129,11 @@ func (d Definition) ValidateImplementation(ctx context.Context, req DefinitionVa
 		}
 
 		name := d.VariadicParameter.GetName()
+
+		if name != "" && !fwfunction.ValidNameRegex.MatchString(name) {
+			diags.Append(fwfunction.InvalidParameterNameDiag(req.FuncName, nil, name))
+		}
+
 		conflictPos, exists := paramNames[name]
 
 		if exists && name != "" {
@@ -177,6 +177,56 @@ func TestDefinitionValidateImplementation(t *testing.T) {
 				},
 			},
 		},
+		"invalid-param-name": {
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.StringParameter{
+						Name: "1param",
+					},
+					function.StringParameter{
+						Name: "param two",
+					},
+				},
+				Return: function.StringReturn{},
+			},
+			expected: function.DefinitionValidateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Function Definition",
+						"When validating the function definition, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Parameter names must begin with an alphabet character (a-z, A-Z) or underscore (_) and must only contain alphanumeric characters (a-z, A-Z, 0-9), underscores (_), and hyphens (-). "+
+							"Function \"test-function\" - Parameter at position 0 has an invalid name \"1param\"",
+					),
+					diag.NewErrorDiagnostic(
+						"Invalid Function Definition",
+						"When validating the function definition, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Parameter names must begin with an alphabet character (a-z, A-Z) or underscore (_) and must only contain alphanumeric characters (a-z, A-Z, 0-9), underscores (_), and hyphens (-). "+
+							"Function \"test-function\" - Parameter at position 1 has an invalid name \"param two\"",
+					),
+				},
+			},
+		},
+		"invalid-param-name-variadic": {
+			definition: function.Definition{
+				VariadicParameter: function.StringParameter{
+					Name: "param.variadic",
+				},
+				Return: function.StringReturn{},
+			},
+			expected: function.DefinitionValidateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Function Definition",
+						"When validating the function definition, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Parameter names must begin with an alphabet character (a-z, A-Z) or underscore (_) and must only contain alphanumeric characters (a-z, A-Z, 0-9), underscores (_), and hyphens (-). "+
+							"Function \"test-function\" - The variadic parameter has an invalid name \"param.variadic\"",
+					),
+				},
+			},
+		},
 		"conflicting-param-names": {
 			definition: function.Definition{
 				Parameters: []function.Parameter{
@@ -110,16 +110,22 @@ func ConcatFuncErrors(funcErrs ...*FuncError) *FuncError {
 }
 
 // FuncErrorFromDiags iterates over the given diagnostics and returns a new function error
-// with the summary and detail text from all error diagnostics concatenated together.
-// Diagnostics with a severity of warning are logged but are not included in the returned
-// function error.
+// with the summary, detail, and any code text from all error diagnostics concatenated
+// together. Diagnostics with a severity of warning are logged but are not included in
+// the returned function error.
 func FuncErrorFromDiags(ctx context.Context, diags diag.Diagnostics) *FuncError {
 	var funcErr *FuncError
 
 	for _, d := range diags {
 		switch d.Severity() {
 		case diag.SeverityError:
-			funcErr = ConcatFuncErrors(funcErr, NewFuncError(fmt.Sprintf("%s: %s", d.Summary(), d.Detail())))
+			text := fmt.Sprintf("%s: %s", d.Summary(), d.Detail())
+
+			if code := diag.Code(d); code != "" {
+				text += fmt.Sprintf(" (Diagnostic Code: %s)", code)
+			}
+
+			funcErr = ConcatFuncErrors(funcErr, NewFuncError(text))
 		case diag.SeverityWarning:
 			tflog.Warn(ctx, "warning: call function", map[string]interface{}{"summary": d.Summary(), "detail": d.Detail()})
 		}
@@ -127,3 +133,22 @@ func FuncErrorFromDiags(ctx context.Context, diags diag.Diagnostics) *FuncError
 
 	return funcErr
 }
+
+// ArgumentFuncErrorFromDiags iterates over the given diagnostics and returns a
+// new function error for the given zero-based function argument position with
+// the summary and detail text from all error diagnostics concatenated
+// together. Diagnostics with a severity of warning are logged but are not
+// included in the returned function error. This enables Terraform to
+// highlight the offending function argument, such as when converting
+// diagnostics from argument data handling.
+func ArgumentFuncErrorFromDiags(ctx context.Context, functionArgument int64, diags diag.Diagnostics) *FuncError {
+	funcErr := FuncErrorFromDiags(ctx, diags)
+
+	if funcErr == nil {
+		return nil
+	}
+
+	funcErr.FunctionArgument = &functionArgument
+
+	return funcErr
+}
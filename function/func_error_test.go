@@ -282,6 +282,12 @@ func TestFuncErrorFromDiags(t *testing.T) {
 				},
 			},
 		},
+		"error-code": {
+			diags: diag.Diagnostics{
+				diag.WithCode("TEST_CODE", diag.NewErrorDiagnostic("one summary", "one detail")),
+			},
+			expected: function.NewFuncError("one summary: one detail (Diagnostic Code: TEST_CODE)"),
+		},
 		"multiple": {
 			diags: diag.Diagnostics{
 				diag.NewErrorDiagnostic("one summary", "one detail"),
@@ -337,3 +343,77 @@ func TestFuncErrorFromDiags(t *testing.T) {
 		})
 	}
 }
+
+func TestArgumentFuncErrorFromDiags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		functionArgument int64
+		diags            diag.Diagnostics
+		expected         *function.FuncError
+		expectedLog      []map[string]interface{}
+	}{
+		"nil": {},
+		"empty": {
+			diags: diag.Diagnostics{},
+		},
+		"error": {
+			functionArgument: 1,
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+			},
+			expected: function.NewArgumentFuncError(1, "one summary: one detail"),
+		},
+		"warning": {
+			functionArgument: 1,
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("one summary", "one detail"),
+			},
+			expectedLog: []map[string]interface{}{
+				{
+					"@level":   "warn",
+					"@message": "warning: call function",
+					"@module":  "provider",
+					"detail":   "one detail",
+					"summary":  "one summary",
+				},
+			},
+		},
+		"multiple": {
+			functionArgument: 2,
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewErrorDiagnostic("two summary", "two detail"),
+			},
+			expected: function.NewArgumentFuncError(2, "one summary: one detail\ntwo summary: two detail"),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+
+			ctx := tflogtest.RootLogger(context.Background(), &output)
+
+			got := function.ArgumentFuncErrorFromDiags(ctx, tc.functionArgument, tc.diags)
+
+			entries, err := tflogtest.MultilineJSONDecode(&output)
+
+			if err != nil {
+				t.Fatalf("unable to read multiple line JSON: %s", err)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(entries, tc.expectedLog); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
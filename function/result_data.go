@@ -32,7 +32,7 @@ func (d ResultData) Equal(o ResultData) bool {
 // Set saves the result data. The value type must be acceptable for the data
 // type in the result definition.
 func (d *ResultData) Set(ctx context.Context, value any) *FuncError {
-	reflectValue, reflectDiags := fwreflect.FromValue(ctx, d.value.Type(ctx), value, path.Empty())
+	reflectValue, reflectDiags := fwreflect.FromValue(ctx, d.value.Type(ctx), value, fwreflect.Options{}, path.Empty())
 
 	funcErr := FuncErrorFromDiags(ctx, reflectDiags)
 
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errorconverters
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// contextKey is the context key for the error converters.
+type contextKey struct{}
+
+// WithConverters returns a new Context with the given error converters.
+func WithConverters(ctx context.Context, converters []diag.ErrorConverter) context.Context {
+	return context.WithValue(ctx, contextKey{}, converters)
+}
+
+// Converters returns the error converters in the Context, if any.
+func Converters(ctx context.Context) []diag.ErrorConverter {
+	converters, _ := ctx.Value(contextKey{}).([]diag.ErrorConverter)
+
+	return converters
+}
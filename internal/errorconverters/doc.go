@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package errorconverters contains the context handling for the provider
+// defined error converters used by error-returning resource handlers.
+package errorconverters
@@ -27,6 +27,7 @@ func ReadResourceRequest(ctx context.Context, proto5 *tfprotov5.ReadResourceRequ
 	fw := &fwserver.ReadResourceRequest{
 		Resource:           reqResource,
 		ClientCapabilities: ReadResourceClientCapabilities(proto5.ClientCapabilities),
+		TypeName:           proto5.TypeName,
 	}
 
 	currentState, currentStateDiags := State(ctx, proto5.CurrentState, resourceSchema)
@@ -194,6 +194,15 @@ func TestReadResourceRequest(t *testing.T) {
 				},
 			},
 		},
+		"typename": {
+			input: &tfprotov5.ReadResourceRequest{
+				TypeName: "test_resource",
+			},
+			resourceSchema: testFwSchema,
+			expected: &fwserver.ReadResourceRequest{
+				TypeName: "test_resource",
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
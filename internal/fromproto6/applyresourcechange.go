@@ -41,6 +41,7 @@ func ApplyResourceChangeRequest(ctx context.Context, proto6 *tfprotov6.ApplyReso
 	fw := &fwserver.ApplyResourceChangeRequest{
 		ResourceSchema: resourceSchema,
 		Resource:       resource,
+		TypeName:       proto6.TypeName,
 	}
 
 	config, configDiags := Config(ctx, proto6.Config, resourceSchema)
@@ -27,6 +27,7 @@ func ReadResourceRequest(ctx context.Context, proto6 *tfprotov6.ReadResourceRequ
 	fw := &fwserver.ReadResourceRequest{
 		Resource:           reqResource,
 		ClientCapabilities: ReadResourceClientCapabilities(proto6.ClientCapabilities),
+		TypeName:           proto6.TypeName,
 	}
 
 	currentState, currentStateDiags := State(ctx, proto6.CurrentState, resourceSchema)
@@ -33,7 +33,7 @@ func AttributePath(ctx context.Context, tfType *tftypes.AttributePath, schema fw
 						// conversion, we cannot return a protocol path-based
 						// diagnostic. Returning a framework human-readable
 						// representation seems like the next best thing to do.
-						fmt.Sprintf("Attribute Path: %s\n", currentTfTypePath.String())+
+						fmt.Sprintf("Attribute Path: %s\n", AttributePathString(currentTfTypePath))+
 						fmt.Sprintf("Original Error: %s", err),
 				),
 			}
@@ -52,7 +52,7 @@ func AttributePath(ctx context.Context, tfType *tftypes.AttributePath, schema fw
 						// conversion, we cannot return a protocol path-based
 						// diagnostic. Returning a framework human-readable
 						// representation seems like the next best thing to do.
-						fmt.Sprintf("Attribute Path: %s\n", currentTfTypePath.String())+
+						fmt.Sprintf("Attribute Path: %s\n", AttributePathString(currentTfTypePath))+
 						fmt.Sprintf("Original Error: %s", err),
 				),
 			}
@@ -80,7 +80,7 @@ func AttributePath(ctx context.Context, tfType *tftypes.AttributePath, schema fw
 						// conversion, we cannot return a protocol path-based
 						// diagnostic. Returning a framework human-readable
 						// representation seems like the next best thing to do.
-						fmt.Sprintf("Attribute Path: %s\n", currentTfTypePath.String())+
+						fmt.Sprintf("Attribute Path: %s\n", AttributePathString(currentTfTypePath))+
 						fmt.Sprintf("Original Error: unknown path.PathStep type: %#v", fwStep),
 				),
 			}
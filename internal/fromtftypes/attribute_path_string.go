@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromtftypes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AttributePathString returns a human-readable representation of a
+// *tftypes.AttributePath, using the same syntax as path.Path, such as
+// rule[0].action. Unlike AttributePath, no schema information is required,
+// so it can be used in diagnostics where path conversion is not possible.
+func AttributePathString(tfType *tftypes.AttributePath) string {
+	var result strings.Builder
+
+	for stepIndex, step := range tfType.Steps() {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			if stepIndex != 0 {
+				result.WriteString(".")
+			}
+
+			result.WriteString(string(step))
+		case tftypes.ElementKeyInt:
+			result.WriteString(fmt.Sprintf("[%d]", int64(step)))
+		case tftypes.ElementKeyString:
+			result.WriteString(fmt.Sprintf("[%q]", string(step)))
+		case tftypes.ElementKeyValue:
+			result.WriteString(fmt.Sprintf("[Value(%s)]", tftypes.Value(step).String()))
+		default:
+			result.WriteString(fmt.Sprintf("[%s]", step))
+		}
+	}
+
+	return result.String()
+}
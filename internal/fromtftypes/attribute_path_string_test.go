@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromtftypes_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+)
+
+func TestAttributePathString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfType   *tftypes.AttributePath
+		expected string
+	}{
+		"nil": {
+			tfType:   nil,
+			expected: "",
+		},
+		"empty": {
+			tfType:   tftypes.NewAttributePath(),
+			expected: "",
+		},
+		"AttributeName": {
+			tfType:   tftypes.NewAttributePath().WithAttributeName("test"),
+			expected: "test",
+		},
+		"AttributeName-AttributeName": {
+			tfType:   tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("nested"),
+			expected: "test.nested",
+		},
+		"AttributeName-ElementKeyInt-AttributeName": {
+			tfType:   tftypes.NewAttributePath().WithAttributeName("rule").WithElementKeyInt(0).WithAttributeName("action"),
+			expected: "rule[0].action",
+		},
+		"AttributeName-ElementKeyString": {
+			tfType:   tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyString("key"),
+			expected: `tags["key"]`,
+		},
+		"AttributeName-ElementKeyValue": {
+			tfType:   tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "test-value")),
+			expected: `test[Value(tftypes.String<"test-value">)]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromtftypes.AttributePathString(testCase.tfType)
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
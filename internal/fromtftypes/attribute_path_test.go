@@ -62,7 +62,7 @@ func TestAttributePath(t *testing.T) {
 					"An unexpected error occurred while trying to convert an attribute path. "+
 						"This is an error in terraform-plugin-framework used by the provider. "+
 						"Please report the following to the provider developers.\n\n"+
-						"Attribute Path: AttributeName(\"test\")\n"+
+						"Attribute Path: test\n"+
 						"Original Error: AttributeName(\"test\") still remains in the path: could not find attribute or block \"test\" in schema",
 				),
 			},
@@ -111,7 +111,7 @@ func TestAttributePath(t *testing.T) {
 					"An unexpected error occurred while trying to convert an attribute path. "+
 						"This is either an error in terraform-plugin-framework or a custom attribute type used by the provider. "+
 						"Please report the following to the provider developers.\n\n"+
-						"Attribute Path: AttributeName(\"test\").ElementKeyValue(tftypes.String<\"test-value\">)\n"+
+						"Attribute Path: test[Value(tftypes.String<\"test-value\">)]\n"+
 						"Original Error: unable to create PathStepElementKeyValue from tftypes.Value: unable to convert tftypes.Value (tftypes.String<\"test-value\">) to attr.Value: intentional ValueFromTerraform error",
 				),
 			},
@@ -132,7 +132,7 @@ func TestAttributePath(t *testing.T) {
 					"An unexpected error occurred while trying to convert an attribute path. "+
 						"This is an error in terraform-plugin-framework used by the provider. "+
 						"Please report the following to the provider developers.\n\n"+
-						"Attribute Path: ElementKeyInt(1)\n"+
+						"Attribute Path: [1]\n"+
 						"Original Error: ElementKeyInt(1) still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyInt to schema",
 				),
 			},
@@ -153,7 +153,7 @@ func TestAttributePath(t *testing.T) {
 					"An unexpected error occurred while trying to convert an attribute path. "+
 						"This is an error in terraform-plugin-framework used by the provider. "+
 						"Please report the following to the provider developers.\n\n"+
-						"Attribute Path: ElementKeyString(\"test\")\n"+
+						"Attribute Path: [\"test\"]\n"+
 						"Original Error: ElementKeyString(\"test\") still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyString to schema",
 				),
 			},
@@ -174,7 +174,7 @@ func TestAttributePath(t *testing.T) {
 					"An unexpected error occurred while trying to convert an attribute path. "+
 						"This is an error in terraform-plugin-framework used by the provider. "+
 						"Please report the following to the provider developers.\n\n"+
-						"Attribute Path: ElementKeyValue(tftypes.String<\"test-value\">)\n"+
+						"Attribute Path: [Value(tftypes.String<\"test-value\">)]\n"+
 						"Original Error: ElementKeyValue(tftypes.String<\"test-value\">) still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyValue to schema",
 				),
 			},
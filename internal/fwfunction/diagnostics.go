@@ -26,3 +26,23 @@ func MissingParameterNameDiag(functionName string, position *int64) diag.Diagnos
 			fmt.Sprintf("Function %q - Parameter at position %d does not have a name", functionName, *position),
 	)
 }
+
+func InvalidParameterNameDiag(functionName string, position *int64, name string) diag.Diagnostic {
+	if position == nil {
+		return diag.NewErrorDiagnostic(
+			"Invalid Function Definition",
+			"When validating the function definition, an implementation issue was found. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				"Parameter names must begin with an alphabet character (a-z, A-Z) or underscore (_) and must only contain alphanumeric characters (a-z, A-Z, 0-9), underscores (_), and hyphens (-). "+
+				fmt.Sprintf("Function %q - The variadic parameter has an invalid name %q", functionName, name),
+		)
+	}
+
+	return diag.NewErrorDiagnostic(
+		"Invalid Function Definition",
+		"When validating the function definition, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			"Parameter names must begin with an alphabet character (a-z, A-Z) or underscore (_) and must only contain alphanumeric characters (a-z, A-Z, 0-9), underscores (_), and hyphens (-). "+
+			fmt.Sprintf("Function %q - Parameter at position %d has an invalid name %q", functionName, *position, name),
+	)
+}
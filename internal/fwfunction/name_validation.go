@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwfunction
+
+import (
+	"regexp"
+)
+
+// ValidNameRegex contains the regular expression to validate function and
+// parameter names, which are considered [identifiers] in the Terraform
+// configuration language.
+//
+// [identifiers]: https://developer.hashicorp.com/terraform/language/syntax/configuration#identifiers
+var ValidNameRegex = regexp.MustCompile("^[a-zA-Z_][a-zA-Z0-9_-]*$")
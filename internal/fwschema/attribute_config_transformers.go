@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/schema/transformer"
+)
+
+// AttributeWithStringConfigTransformers is an optional interface on
+// Attribute which enables String configuration transformer support.
+type AttributeWithStringConfigTransformers interface {
+	Attribute
+
+	StringConfigTransformers() []transformer.String
+}
@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+// AttributeWithRenamedFrom is an optional interface on Attribute which
+// declares the name the attribute previously had in resource state. The
+// framework moves the prior state value of the previous name to the
+// attribute when upgrading resource state.
+type AttributeWithRenamedFrom interface {
+	Attribute
+
+	// GetRenamedFrom should return the previous name of the attribute, if
+	// any.
+	GetRenamedFrom() string
+}
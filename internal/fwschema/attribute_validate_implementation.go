@@ -30,6 +30,9 @@ type AttributeWithValidateImplementation interface {
 //
 // This logic currently:
 //   - Checks whether the given AttributeName in the path is a valid identifier
+//   - If the given Attribute implements the AttributeWithRenamedFrom
+//     interface, checks whether the previous name is a valid identifier
+//     which differs from the AttributeName
 //   - If the given Attribute implements the
 //     AttributeWithValidateImplementation interface, calls the method
 //   - If the given Attribute implements the NestedAttribute interface,
@@ -39,6 +42,16 @@ func ValidateAttributeImplementation(ctx context.Context, attribute Attribute, r
 
 	diags.Append(IsValidAttributeName(req.Name, req.Path)...)
 
+	if attributeWithRenamedFrom, ok := attribute.(AttributeWithRenamedFrom); ok && attributeWithRenamedFrom.GetRenamedFrom() != "" {
+		renamedFrom := attributeWithRenamedFrom.GetRenamedFrom()
+
+		diags.Append(IsValidAttributeName(renamedFrom, req.Path)...)
+
+		if renamedFrom == req.Name {
+			diags.Append(AttributeRenamedFromSelfDiag(req.Path))
+		}
+	}
+
 	if attributeWithValidateImplementation, ok := attribute.(AttributeWithValidateImplementation); ok {
 		resp := &ValidateImplementationResponse{}
 
@@ -61,7 +74,11 @@ func ValidateAttributeImplementation(ctx context.Context, attribute Attribute, r
 
 	nestingMode := nestedAttribute.GetNestingMode()
 
-	for nestedAttributeName, nestedAttribute := range nestedObject.GetAttributes() {
+	nestedAttributes := nestedObject.GetAttributes()
+
+	for _, nestedAttributeName := range SortedNames(nestedAttributes) {
+		nestedAttribute := nestedAttributes[nestedAttributeName]
+
 		var nestedAttributePath path.Path
 
 		// TODO: path.Path and path.PathExpression are intended to map onto
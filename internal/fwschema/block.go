@@ -97,7 +97,11 @@ func BlocksEqual(a, b Block) bool {
 func BlockPathExpressions(ctx context.Context, block Block, pathExpression path.Expression) path.Expressions {
 	result := path.Expressions{pathExpression}
 
-	for name, nestedBlock := range block.GetNestedObject().GetBlocks() {
+	nestedBlocks := block.GetNestedObject().GetBlocks()
+
+	for _, name := range SortedNames(nestedBlocks) {
+		nestedBlock := nestedBlocks[name]
+
 		nestingMode := block.GetNestingMode()
 
 		switch nestingMode {
@@ -55,7 +55,11 @@ func ValidateBlockImplementation(ctx context.Context, block Block, req ValidateI
 
 	nestingMode := block.GetNestingMode()
 
-	for nestedAttributeName, nestedAttribute := range nestedObject.GetAttributes() {
+	nestedAttributes := nestedObject.GetAttributes()
+
+	for _, nestedAttributeName := range SortedNames(nestedAttributes) {
+		nestedAttribute := nestedAttributes[nestedAttributeName]
+
 		var nestedAttributePath path.Path
 
 		// TODO: path.Path and path.PathExpression are intended to map onto
@@ -88,7 +92,11 @@ func ValidateBlockImplementation(ctx context.Context, block Block, req ValidateI
 		diags.Append(ValidateAttributeImplementation(ctx, nestedAttribute, nestedReq)...)
 	}
 
-	for nestedBlockName, nestedBlock := range nestedObject.GetBlocks() {
+	nestedBlocks := nestedObject.GetBlocks()
+
+	for _, nestedBlockName := range SortedNames(nestedBlocks) {
+		nestedBlock := nestedBlocks[nestedBlockName]
+
 		var nestedBlockPath path.Path
 
 		// TODO: path.Path and path.PathExpression are intended to map onto
@@ -45,6 +45,22 @@ func AttributeMissingElementTypeDiag(attributePath path.Path) diag.Diagnostic {
 	)
 }
 
+// AttributeRenamedFromSelfDiag returns an error diagnostic to provider
+// developers about an Attribute implementation with a RenamedFrom field value
+// matching the attribute name, which has no effect.
+func AttributeRenamedFromSelfDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q has a RenamedFrom field value matching the attribute name. ", attributePath)+
+			"RenamedFrom must be the previous name of the attribute in resource state.",
+	)
+}
+
 func AttributeDefaultElementTypeMismatchDiag(attributePath path.Path, expectedElementType attr.Type, actualElementType attr.Type) diag.Diagnostic {
 	return diag.NewErrorDiagnostic(
 		"Invalid Attribute Implementation",
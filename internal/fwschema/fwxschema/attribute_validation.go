@@ -115,3 +115,13 @@ type AttributeWithDynamicValidators interface {
 	// DynamicValidators should return a list of Dynamic validators.
 	DynamicValidators() []validator.Dynamic
 }
+
+// AttributeWithValidatorsUnknownPolicy is an optional interface on Attribute
+// which controls how validators are called with unknown configuration values.
+type AttributeWithValidatorsUnknownPolicy interface {
+	fwschema.Attribute
+
+	// GetValidatorsUnknownPolicy should return the policy for calling
+	// validators with unknown configuration values.
+	GetValidatorsUnknownPolicy() validator.UnknownPolicy
+}
@@ -158,7 +158,11 @@ func SchemaAttributeAtTerraformPath(ctx context.Context, s Schema, p *tftypes.At
 func SchemaBlockPathExpressions(ctx context.Context, s Schema) path.Expressions {
 	result := path.Expressions{}
 
-	for name, block := range s.GetBlocks() {
+	blocks := s.GetBlocks()
+
+	for _, name := range SortedNames(blocks) {
+		block := blocks[name]
+
 		result = append(result, BlockPathExpressions(ctx, block, path.MatchRoot(name))...)
 	}
 
@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+import (
+	"sort"
+)
+
+// SortedNames returns the names of the given attributes or blocks in
+// lexicographic order. Use this instead of ranging over the map directly
+// when the traversal order is observable, such as the order of diagnostics,
+// so results are deterministic.
+func SortedNames[M ~map[string]V, V any](m M) []string {
+	names := make([]string, 0, len(m))
+
+	for name := range m {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
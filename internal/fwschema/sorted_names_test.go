@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSortedNames(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes fwschema.UnderlyingAttributes
+		expected   []string
+	}{
+		"nil": {
+			expected: []string{},
+		},
+		"empty": {
+			attributes: fwschema.UnderlyingAttributes{},
+			expected:   []string{},
+		},
+		"multiple": {
+			attributes: fwschema.UnderlyingAttributes{
+				"c":   testschema.Attribute{Type: types.StringType},
+				"a":   testschema.Attribute{Type: types.StringType},
+				"b_2": testschema.Attribute{Type: types.StringType},
+				"b":   testschema.Attribute{Type: types.StringType},
+			},
+			expected: []string{"a", "b", "b_2", "c"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwschema.SortedNames(testCase.attributes)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// sensitiveValueString is the logged representation of sensitive attribute
+// values when attribute access logging is enabled.
+const sensitiveValueString = "(sensitive)"
+
+// logAttributeAccess emits a framework debug log with the value type and
+// value of an attribute read or written by the provider, if attribute access
+// logging is enabled. Values of sensitive attributes, attributes nested under
+// sensitive attributes, and values containing sensitive attributes are
+// redacted.
+func (d Data) logAttributeAccess(ctx context.Context, msg string, schemaPath path.Path, value attr.Value) {
+	if !logging.AttributeAccessLogging(ctx) {
+		return
+	}
+
+	valueString := sensitiveValueString
+
+	if !d.sensitiveAtPath(ctx, schemaPath) {
+		valueString = value.String()
+	}
+
+	logging.FrameworkDebug(
+		ctx,
+		msg,
+		map[string]interface{}{
+			logging.KeyAttributeValue: valueString,
+			logging.KeyValueType:      fmt.Sprintf("%T", value),
+		},
+	)
+}
+
+// sensitiveAtPath returns true if the attribute at the given path, any parent
+// attribute, or any nested attribute is sensitive. The value of an object,
+// block, or collection includes the values underneath it, so it must be
+// redacted if any nested attribute is sensitive.
+func (d Data) sensitiveAtPath(ctx context.Context, schemaPath path.Path) bool {
+	for current := schemaPath; len(current.Steps()) > 0; current = current.ParentPath() {
+		attribute, diags := d.Schema.AttributeAtPath(ctx, current)
+
+		// Paths to blocks and collection elements are not attributes.
+		if diags.HasError() {
+			continue
+		}
+
+		if attribute.IsSensitive() {
+			return true
+		}
+	}
+
+	for _, expression := range sensitivePathExpressions(path.MatchRelative(), d.Schema.GetAttributes(), d.Schema.GetBlocks()) {
+		if expression.MatchesParent(schemaPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sensitivePathExpressions recursively returns the path expressions of all
+// sensitive attributes in the given attributes and blocks. Attributes nested
+// under sensitive attributes are not included.
+func sensitivePathExpressions(expression path.Expression, attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) path.Expressions {
+	var result path.Expressions
+
+	for _, name := range fwschema.SortedNames(attributes) {
+		attribute := attributes[name]
+		attributeExpression := expression.AtName(name)
+
+		if attribute.IsSensitive() {
+			result = append(result, attributeExpression)
+
+			continue
+		}
+
+		nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+		if !ok {
+			continue
+		}
+
+		switch nestedAttribute.GetNestingMode() {
+		case fwschema.NestingModeList:
+			attributeExpression = attributeExpression.AtAnyListIndex()
+		case fwschema.NestingModeMap:
+			attributeExpression = attributeExpression.AtAnyMapKey()
+		case fwschema.NestingModeSet:
+			attributeExpression = attributeExpression.AtAnySetValue()
+		}
+
+		result = append(result, sensitivePathExpressions(attributeExpression, nestedAttribute.GetNestedObject().GetAttributes(), nil)...)
+	}
+
+	for _, name := range fwschema.SortedNames(blocks) {
+		block := blocks[name]
+		blockExpression := expression.AtName(name)
+
+		switch block.GetNestingMode() {
+		case fwschema.BlockNestingModeList:
+			blockExpression = blockExpression.AtAnyListIndex()
+		case fwschema.BlockNestingModeSet:
+			blockExpression = blockExpression.AtAnySetValue()
+		}
+
+		nestedObject := block.GetNestedObject()
+
+		result = append(result, sensitivePathExpressions(blockExpression, nestedObject.GetAttributes(), nestedObject.GetBlocks())...)
+	}
+
+	return result
+}
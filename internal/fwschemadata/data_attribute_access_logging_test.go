@@ -0,0 +1,349 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataAttributeAccessLogging(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test_string": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"test_sensitive": testschema.Attribute{
+				Optional:  true,
+				Sensitive: true,
+				Type:      types.StringType,
+			},
+			"test_nested": testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"test_nested_string": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeSingle,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"test_object": testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"test_object_secret": testschema.Attribute{
+							Optional:  true,
+							Sensitive: true,
+							Type:      types.StringType,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeSingle,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]fwschema.Block{
+			"test_block": testschema.Block{
+				NestedObject: testschema.NestedBlockObject{
+					Attributes: map[string]fwschema.Attribute{
+						"test_block_secret": testschema.Attribute{
+							Optional:  true,
+							Sensitive: true,
+							Type:      types.StringType,
+						},
+					},
+				},
+				NestingMode: fwschema.BlockNestingModeList,
+			},
+		},
+	}
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_nested_string": tftypes.String,
+		},
+	}
+
+	testObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_object_secret": tftypes.String,
+		},
+	}
+
+	testBlockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_block_secret": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_string":    tftypes.String,
+			"test_sensitive": tftypes.String,
+			"test_nested":    testNestedType,
+			"test_object":    testObjectType,
+			"test_block":     tftypes.List{ElementType: testBlockType},
+		},
+	}
+
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test_string":    tftypes.NewValue(tftypes.String, "test-value"),
+		"test_sensitive": tftypes.NewValue(tftypes.String, "test-secret"),
+		"test_nested": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+			"test_nested_string": tftypes.NewValue(tftypes.String, "test-nested-secret"),
+		}),
+		"test_object": tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+			"test_object_secret": tftypes.NewValue(tftypes.String, "test-object-secret"),
+		}),
+		"test_block": tftypes.NewValue(tftypes.List{ElementType: testBlockType}, []tftypes.Value{
+			tftypes.NewValue(testBlockType, map[string]tftypes.Value{
+				"test_block_secret": tftypes.NewValue(tftypes.String, "test-block-secret"),
+			}),
+		}),
+	})
+
+	testObjectAttrTypes := map[string]attr.Type{
+		"test_object_secret": types.StringType,
+	}
+
+	testCases := map[string]struct {
+		enabled         bool
+		path            path.Path
+		set             bool
+		target          any
+		value           attr.Value
+		expectedEntries []map[string]interface{}
+	}{
+		"get-disabled": {
+			path: path.Root("test_string"),
+		},
+		"get": {
+			enabled: true,
+			path:    path.Root("test_string"),
+			expectedEntries: []map[string]interface{}{
+				{
+					"@level":             "debug",
+					"@message":           "Provider read state attribute value",
+					"@module":            "sdk.framework",
+					"tf_attribute_path":  "test_string",
+					"tf_attribute_value": `"test-value"`,
+					"tf_value_type":      "basetypes.StringValue",
+				},
+			},
+		},
+		"get-sensitive": {
+			enabled: true,
+			path:    path.Root("test_sensitive"),
+			expectedEntries: []map[string]interface{}{
+				{
+					"@level":             "debug",
+					"@message":           "Provider read state attribute value",
+					"@module":            "sdk.framework",
+					"tf_attribute_path":  "test_sensitive",
+					"tf_attribute_value": "(sensitive)",
+					"tf_value_type":      "basetypes.StringValue",
+				},
+			},
+		},
+		"get-sensitive-parent": {
+			enabled: true,
+			path:    path.Root("test_nested").AtName("test_nested_string"),
+			expectedEntries: []map[string]interface{}{
+				{
+					"@level":             "debug",
+					"@message":           "Provider read state attribute value",
+					"@module":            "sdk.framework",
+					"tf_attribute_path":  "test_nested.test_nested_string",
+					"tf_attribute_value": "(sensitive)",
+					"tf_value_type":      "basetypes.StringValue",
+				},
+			},
+		},
+		"get-sensitive-child": {
+			enabled: true,
+			path:    path.Root("test_object"),
+			target:  &types.Object{},
+			expectedEntries: []map[string]interface{}{
+				{
+					"@level":             "debug",
+					"@message":           "Provider read state attribute value",
+					"@module":            "sdk.framework",
+					"tf_attribute_path":  "test_object",
+					"tf_attribute_value": "(sensitive)",
+					"tf_value_type":      "basetypes.ObjectValue",
+				},
+			},
+		},
+		"get-block-sensitive-child": {
+			enabled: true,
+			path:    path.Root("test_block"),
+			target:  &types.List{},
+			expectedEntries: []map[string]interface{}{
+				{
+					"@level":             "debug",
+					"@message":           "Provider read state attribute value",
+					"@module":            "sdk.framework",
+					"tf_attribute_path":  "test_block",
+					"tf_attribute_value": "(sensitive)",
+					"tf_value_type":      "basetypes.ListValue",
+				},
+			},
+		},
+		"get-block-element-sensitive-child": {
+			enabled: true,
+			path:    path.Root("test_block").AtListIndex(0),
+			target:  &types.Object{},
+			expectedEntries: []map[string]interface{}{
+				{
+					"@level":             "debug",
+					"@message":           "Provider read state attribute value",
+					"@module":            "sdk.framework",
+					"tf_attribute_path":  "test_block[0]",
+					"tf_attribute_value": "(sensitive)",
+					"tf_value_type":      "basetypes.ObjectValue",
+				},
+			},
+		},
+		"set-disabled": {
+			path: path.Root("test_string"),
+			set:  true,
+		},
+		"set": {
+			enabled: true,
+			path:    path.Root("test_string"),
+			set:     true,
+			expectedEntries: []map[string]interface{}{
+				{
+					"@level":             "debug",
+					"@message":           "Provider set state attribute value",
+					"@module":            "sdk.framework",
+					"tf_attribute_path":  "test_string",
+					"tf_attribute_value": `"new-value"`,
+					"tf_value_type":      "basetypes.StringValue",
+				},
+			},
+		},
+		"set-sensitive-child": {
+			enabled: true,
+			path:    path.Root("test_object"),
+			set:     true,
+			value: types.ObjectValueMust(testObjectAttrTypes, map[string]attr.Value{
+				"test_object_secret": types.StringValue("new-secret"),
+			}),
+			expectedEntries: []map[string]interface{}{
+				{
+					"@level":             "debug",
+					"@message":           "Provider set state attribute value",
+					"@module":            "sdk.framework",
+					"tf_attribute_path":  "test_object",
+					"tf_attribute_value": "(sensitive)",
+					"tf_value_type":      "basetypes.ObjectValue",
+				},
+			},
+		},
+		"set-sensitive": {
+			enabled: true,
+			path:    path.Root("test_sensitive"),
+			set:     true,
+			expectedEntries: []map[string]interface{}{
+				{
+					"@level":             "debug",
+					"@message":           "Provider set state attribute value",
+					"@module":            "sdk.framework",
+					"tf_attribute_path":  "test_sensitive",
+					"tf_attribute_value": "(sensitive)",
+					"tf_value_type":      "basetypes.StringValue",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+
+			ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+			ctx = logging.InitContext(ctx)
+
+			if testCase.enabled {
+				ctx = logging.WithAttributeAccessLogging(ctx)
+			}
+
+			data := fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionState,
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			}
+
+			if testCase.set {
+				var value attr.Value = types.StringValue("new-value")
+
+				if testCase.value != nil {
+					value = testCase.value
+				}
+
+				diags := data.SetAtPath(ctx, testCase.path, value)
+
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+			} else {
+				var target any = &types.String{}
+
+				if testCase.target != nil {
+					target = testCase.target
+				}
+
+				diags := data.GetAtPath(ctx, testCase.path, target)
+
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+			}
+
+			entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+			if err != nil {
+				t.Fatalf("unable to read multiple line JSON: %s", err)
+			}
+
+			// Only compare attribute access entries, not other framework
+			// trace logging.
+			var got []map[string]interface{}
+
+			for _, entry := range entries {
+				if _, ok := entry["tf_attribute_value"]; ok {
+					got = append(got, entry)
+				}
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedEntries); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
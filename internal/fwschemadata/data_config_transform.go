@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
+	"github.com/hashicorp/terraform-plugin-framework/schema/transformer"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// transformCacheKey is the context key for the transformed value cache.
+type transformCacheKey struct{}
+
+// WithTransformCache returns a new Context with a transformed value cache,
+// which is used by Get and GetAtPath to run configuration transformers once
+// for each configuration during a single RPC, rather than on every call. The
+// cache should only be added to request-scoped contexts.
+func WithTransformCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, transformCacheKey{}, &transformCache{})
+}
+
+// transformCacheFromContext returns the transformed value cache in the
+// Context, if any.
+func transformCacheFromContext(ctx context.Context) *transformCache {
+	cache, ok := ctx.Value(transformCacheKey{}).(*transformCache)
+
+	if !ok {
+		return nil
+	}
+
+	return cache
+}
+
+// transformCache stores transformed values by the data they were
+// transformed from.
+type transformCache struct {
+	entries []transformCacheEntry
+	mu      sync.Mutex
+}
+
+// transformCacheEntry is a single transformed value, the data it was
+// transformed from, and any transformer diagnostics.
+type transformCacheEntry struct {
+	description      DataDescription
+	terraformValue   tftypes.Value
+	transformedValue tftypes.Value
+	diags            diag.Diagnostics
+}
+
+// load returns the transformed value and diagnostics for the given data, if
+// it was previously stored.
+func (c *transformCache) load(d Data) (tftypes.Value, diag.Diagnostics, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, entry := range c.entries {
+		if entry.description != d.Description || !entry.terraformValue.Equal(d.TerraformValue) {
+			continue
+		}
+
+		return entry.transformedValue, slices.Clone(entry.diags), true
+	}
+
+	return tftypes.Value{}, nil, false
+}
+
+// store saves the transformed value and diagnostics for the given data.
+func (c *transformCache) store(d Data, transformedValue tftypes.Value, diags diag.Diagnostics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = append(c.entries, transformCacheEntry{
+		description:      d.Description,
+		terraformValue:   d.TerraformValue,
+		transformedValue: transformedValue,
+		diags:            slices.Clone(diags),
+	})
+}
+
+// transformValues returns a copy of configuration Data with all known,
+// non-null values of attributes implementing
+// fwschema.AttributeWithStringConfigTransformers transformed. Other Data,
+// such as plan and state, is returned unchanged so that values read from it
+// can be written back to Terraform as-is. If the context contains a
+// transformed value cache, as added by WithTransformCache, the transformers
+// run only once for the same data.
+func (d Data) transformValues(ctx context.Context) (Data, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if d.Description != DataDescriptionConfiguration {
+		return d, diags
+	}
+
+	if d.Schema == nil || d.TerraformValue.IsNull() || !d.TerraformValue.IsKnown() {
+		return d, diags
+	}
+
+	if !hasConfigTransformers(d.Schema.GetAttributes(), d.Schema.GetBlocks()) {
+		return d, diags
+	}
+
+	cache := transformCacheFromContext(ctx)
+
+	if cache != nil {
+		if transformedValue, cachedDiags, ok := cache.load(d); ok {
+			if !cachedDiags.HasError() {
+				d.TerraformValue = transformedValue
+			}
+
+			return d, cachedDiags
+		}
+	}
+
+	transformedValue, diags := d.transform(ctx)
+
+	if cache != nil {
+		cache.store(d, transformedValue, diags)
+	}
+
+	if diags.HasError() {
+		return d, diags
+	}
+
+	d.TerraformValue = transformedValue
+
+	return d, diags
+}
+
+// transform returns the TerraformValue of the Data with all configuration
+// transformers run.
+func (d Data) transform(ctx context.Context) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	transformedValue, err := tftypes.Transform(d.TerraformValue, func(tfPath *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if value.IsNull() || !value.IsKnown() || !value.Type().Is(tftypes.String) {
+			return value, nil
+		}
+
+		attribute, err := d.Schema.AttributeAtTerraformPath(ctx, tfPath)
+
+		// Values which are not attributes, such as collection elements, are
+		// not transformed.
+		if err != nil {
+			//nolint:nilerr // error is expected for non-attribute values
+			return value, nil
+		}
+
+		attributeWithTransformers, ok := attribute.(fwschema.AttributeWithStringConfigTransformers)
+
+		if !ok || len(attributeWithTransformers.StringConfigTransformers()) == 0 {
+			return value, nil
+		}
+
+		attributePath, attributePathDiags := fromtftypes.AttributePath(ctx, tfPath, d.Schema)
+
+		diags.Append(attributePathDiags...)
+
+		if attributePathDiags.HasError() {
+			return value, nil
+		}
+
+		var stringValue string
+
+		if err := value.As(&stringValue); err != nil {
+			return value, err
+		}
+
+		transformReq := transformer.StringRequest{
+			Path:        attributePath,
+			ConfigValue: types.StringValue(stringValue),
+		}
+
+		for _, configTransformer := range attributeWithTransformers.StringConfigTransformers() {
+			transformResp := &transformer.StringResponse{
+				Value: transformReq.ConfigValue,
+			}
+
+			logging.FrameworkTrace(
+				ctx,
+				"Calling provider defined transformer.String",
+				map[string]interface{}{
+					logging.KeyDescription: configTransformer.Description(ctx),
+				},
+			)
+
+			spanCtx, span := tracing.Start(ctx, "transformer.String", tracing.AttributePath(attributePath), tracing.Description(ctx, configTransformer))
+			configTransformer.TransformString(spanCtx, transformReq, transformResp)
+			span.End()
+
+			logging.FrameworkTrace(
+				ctx,
+				"Called provider defined transformer.String",
+				map[string]interface{}{
+					logging.KeyDescription: configTransformer.Description(ctx),
+				},
+			)
+
+			diags.Append(transformResp.Diagnostics...)
+
+			if transformResp.Diagnostics.HasError() {
+				return value, nil
+			}
+
+			transformReq.ConfigValue = transformResp.Value
+		}
+
+		if transformReq.ConfigValue.IsNull() || transformReq.ConfigValue.IsUnknown() {
+			return value, nil
+		}
+
+		return tftypes.NewValue(value.Type(), transformReq.ConfigValue.ValueString()), nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			d.Description.Title()+" Transformation Error",
+			"An unexpected error was encountered trying to transform "+d.Description.String()+" values. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				err.Error(),
+		)
+
+		return d.TerraformValue, diags
+	}
+
+	return transformedValue, diags
+}
+
+// hasConfigTransformers returns true if any of the given attributes, or any
+// attributes nested underneath the given attributes and blocks, implement
+// fwschema.AttributeWithStringConfigTransformers with transformers.
+func hasConfigTransformers(attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) bool {
+	for _, attribute := range attributes {
+		if attributeWithTransformers, ok := attribute.(fwschema.AttributeWithStringConfigTransformers); ok && len(attributeWithTransformers.StringConfigTransformers()) > 0 {
+			return true
+		}
+
+		nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+		if ok && nestedAttribute.GetNestedObject() != nil && hasConfigTransformers(nestedAttribute.GetNestedObject().GetAttributes(), nil) {
+			return true
+		}
+	}
+
+	for _, block := range blocks {
+		nestedObject := block.GetNestedObject()
+
+		if nestedObject != nil && hasConfigTransformers(nestedObject.GetAttributes(), nestedObject.GetBlocks()) {
+			return true
+		}
+	}
+
+	return false
+}
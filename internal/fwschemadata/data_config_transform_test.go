@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtransformer"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/transformer"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataGet_ConfigTransformers(t *testing.T) {
+	t.Parallel()
+
+	type testModel struct {
+		Path types.String `tfsdk:"path"`
+	}
+
+	testTransformerPrefix := testtransformer.String{
+		TransformStringMethod: func(_ context.Context, req transformer.StringRequest, resp *transformer.StringResponse) {
+			resp.Value = types.StringValue("/home/test" + strings.TrimPrefix(req.ConfigValue.ValueString(), "~"))
+		},
+	}
+	testTransformerUpper := testtransformer.String{
+		TransformStringMethod: func(_ context.Context, req transformer.StringRequest, resp *transformer.StringResponse) {
+			resp.Value = types.StringValue(strings.ToUpper(req.ConfigValue.ValueString()))
+		},
+	}
+	testTransformerError := testtransformer.String{
+		TransformStringMethod: func(_ context.Context, req transformer.StringRequest, resp *transformer.StringResponse) {
+			resp.Diagnostics.AddAttributeError(req.Path, "test summary", "test detail")
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"path": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		description   fwschemadata.DataDescription
+		transformers  []transformer.String
+		value         tftypes.Value
+		expected      testModel
+		expectedDiags diag.Diagnostics
+	}{
+		"configuration": {
+			description:  fwschemadata.DataDescriptionConfiguration,
+			transformers: []transformer.String{testTransformerPrefix},
+			value:        tftypes.NewValue(tftypes.String, "~/test"),
+			expected: testModel{
+				Path: types.StringValue("/home/test/test"),
+			},
+		},
+		"configuration-ordering": {
+			description:  fwschemadata.DataDescriptionConfiguration,
+			transformers: []transformer.String{testTransformerPrefix, testTransformerUpper},
+			value:        tftypes.NewValue(tftypes.String, "~/test"),
+			expected: testModel{
+				Path: types.StringValue("/HOME/TEST/TEST"),
+			},
+		},
+		"configuration-null": {
+			description:  fwschemadata.DataDescriptionConfiguration,
+			transformers: []transformer.String{testTransformerError},
+			value:        tftypes.NewValue(tftypes.String, nil),
+			expected: testModel{
+				Path: types.StringNull(),
+			},
+		},
+		"configuration-unknown": {
+			description:  fwschemadata.DataDescriptionConfiguration,
+			transformers: []transformer.String{testTransformerError},
+			value:        tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: testModel{
+				Path: types.StringUnknown(),
+			},
+		},
+		"configuration-diagnostics": {
+			description:  fwschemadata.DataDescriptionConfiguration,
+			transformers: []transformer.String{testTransformerError, testTransformerUpper},
+			value:        tftypes.NewValue(tftypes.String, "~/test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("path"), "test summary", "test detail"),
+			},
+		},
+		"plan": {
+			description:  fwschemadata.DataDescriptionPlan,
+			transformers: []transformer.String{testTransformerPrefix},
+			value:        tftypes.NewValue(tftypes.String, "~/test"),
+			expected: testModel{
+				Path: types.StringValue("~/test"),
+			},
+		},
+		"state": {
+			description:  fwschemadata.DataDescriptionState,
+			transformers: []transformer.String{testTransformerPrefix},
+			value:        tftypes.NewValue(tftypes.String, "~/test"),
+			expected: testModel{
+				Path: types.StringValue("~/test"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := fwschemadata.Data{
+				Description: testCase.description,
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"path": testschema.AttributeWithStringConfigTransformers{
+							Optional:           true,
+							ConfigTransformers: testCase.transformers,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"path": testCase.value,
+				}),
+			}
+
+			var got testModel
+
+			diags := data.Get(context.Background(), &got)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected Get difference: %s", diff)
+			}
+
+			var gotAttribute types.String
+
+			diags = data.GetAtPath(context.Background(), path.Root("path"), &gotAttribute)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotAttribute, testCase.expected.Path); diff != "" {
+				t.Errorf("unexpected GetAtPath difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDataGet_ConfigTransformersPlanToState(t *testing.T) {
+	t.Parallel()
+
+	type testModel struct {
+		Path types.String `tfsdk:"path"`
+	}
+
+	testTransformer := testtransformer.String{
+		TransformStringMethod: func(_ context.Context, req transformer.StringRequest, resp *transformer.StringResponse) {
+			resp.Value = types.StringValue("/home/test" + strings.TrimPrefix(req.ConfigValue.ValueString(), "~"))
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"path": testschema.AttributeWithStringConfigTransformers{
+				Optional:           true,
+				ConfigTransformers: []transformer.String{testTransformer},
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"path": tftypes.String,
+		},
+	}
+
+	plan := fwschemadata.Data{
+		Description: fwschemadata.DataDescriptionPlan,
+		Schema:      testSchema,
+		TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"path": tftypes.NewValue(tftypes.String, "~/test"),
+		}),
+	}
+
+	state := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         testSchema,
+		TerraformValue: tftypes.NewValue(testType, nil),
+	}
+
+	ctx := fwschemadata.WithTransformCache(context.Background())
+
+	var model testModel
+
+	diags := plan.Get(ctx, &model)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	diags = state.Set(ctx, model)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(state.TerraformValue, plan.TerraformValue); diff != "" {
+		t.Errorf("unexpected state difference from plan: %s", diff)
+	}
+}
+
+func TestDataGet_ConfigTransformersCache(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	testTransformer := testtransformer.String{
+		TransformStringMethod: func(_ context.Context, req transformer.StringRequest, resp *transformer.StringResponse) {
+			calls.Add(1)
+
+			resp.Value = types.StringValue(strings.ToUpper(req.ConfigValue.ValueString()))
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"path":  tftypes.String,
+			"other": tftypes.String,
+		},
+	}
+
+	data := fwschemadata.Data{
+		Description: fwschemadata.DataDescriptionConfiguration,
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"path": testschema.AttributeWithStringConfigTransformers{
+					Optional:           true,
+					ConfigTransformers: []transformer.String{testTransformer},
+				},
+				"other": testschema.Attribute{
+					Optional: true,
+					Type:     types.StringType,
+				},
+			},
+		},
+		TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"path":  tftypes.NewValue(tftypes.String, "test"),
+			"other": tftypes.NewValue(tftypes.String, "other"),
+		}),
+	}
+
+	ctx := fwschemadata.WithTransformCache(context.Background())
+
+	for i := 0; i < 3; i++ {
+		var got types.String
+
+		diags := data.GetAtPath(ctx, path.Root("path"), &got)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected error diagnostics: %v", diags)
+		}
+
+		if diff := cmp.Diff(got, types.StringValue("TEST")); diff != "" {
+			t.Errorf("unexpected GetAtPath difference: %s", diff)
+		}
+
+		diags = data.GetAtPath(ctx, path.Root("other"), &got)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected error diagnostics: %v", diags)
+		}
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected transformer to be called once, got %d", got)
+	}
+}
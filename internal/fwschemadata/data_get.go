@@ -5,13 +5,88 @@ package fwschemadata
 
 import (
 	"context"
+	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // Get populates the struct passed as `target` with the entire state.
+// Configuration values of attributes with configuration transformers are
+// transformed before decoding.
+//
+// Only the first mismatch between the schema and the target struct is
+// reported, unless enabled by WithReportAllMismatches.
+//
+// If the context contains a decoded model cache, as added by WithGetCache,
+// and the same data was previously decoded into the same target type, a copy
+// of the previously decoded model is used instead of decoding again.
 func (d Data) Get(ctx context.Context, target any) diag.Diagnostics {
-	return reflect.Into(ctx, d.Schema.Type(), d.TerraformValue, target, reflect.Options{}, path.Empty())
+	if diags := d.initializedDiagnostics("Read", false); diags.HasError() {
+		return diags
+	}
+
+	d, diags := d.transformValues(ctx)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(d.getWithCache(ctx, target)...)
+
+	return diags
+}
+
+// getWithCache decodes the entire state into `target`, using the decoded
+// model cache in the context, if any.
+func (d Data) getWithCache(ctx context.Context, target any) diag.Diagnostics {
+	cache := getCacheFromContext(ctx)
+	targetValue := reflect.ValueOf(target)
+
+	if cache == nil || targetValue.Kind() != reflect.Ptr || targetValue.IsNil() {
+		return d.get(ctx, target)
+	}
+
+	if cached, ok := cache.load(d, targetValue.Elem().Type()); ok {
+		logging.FrameworkTrace(ctx, "Using cached decoded model")
+
+		targetValue.Elem().Set(cached)
+
+		return nil
+	}
+
+	diags := d.get(ctx, target)
+
+	if len(diags) == 0 {
+		cache.store(d, targetValue.Elem())
+	}
+
+	return diags
+}
+
+// get decodes the entire state into `target`.
+func (d Data) get(ctx context.Context, target any) diag.Diagnostics {
+	return intreflect.Into(ctx, d.Schema.Type(), d.TerraformValue, target, intreflect.Options{
+		ReportAllMismatches: ReportAllMismatches(ctx),
+	}, path.Empty())
+}
+
+// reportAllMismatchesKey is the context key for reporting all mismatches.
+type reportAllMismatchesKey struct{}
+
+// WithReportAllMismatches returns a new Context which causes Get to report
+// every mismatch between the schema and the target struct at once, rather
+// than only the first mismatch.
+func WithReportAllMismatches(ctx context.Context) context.Context {
+	return context.WithValue(ctx, reportAllMismatchesKey{}, true)
+}
+
+// ReportAllMismatches returns true if Get should report every mismatch
+// between the schema and the target struct in the Context.
+func ReportAllMismatches(ctx context.Context) bool {
+	enabled, ok := ctx.Value(reportAllMismatchesKey{}).(bool)
+
+	return ok && enabled
 }
@@ -15,11 +15,24 @@ import (
 )
 
 // GetAtPath retrieves the attribute found at `path` and populates the
-// `target` with the value.
+// `target` with the value. Configuration values of attributes with
+// configuration transformers are transformed before retrieval.
 func (d Data) GetAtPath(ctx context.Context, schemaPath path.Path, target any) diag.Diagnostics {
+	if diags := d.initializedDiagnostics("Read", false); diags.HasError() {
+		return diags
+	}
+
 	ctx = logging.FrameworkWithAttributePath(ctx, schemaPath.String())
 
-	attrValue, diags := d.ValueAtPath(ctx, schemaPath)
+	d, diags := d.transformValues(ctx)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	attrValue, valueDiags := d.ValueAtPath(ctx, schemaPath)
+
+	diags.Append(valueDiags...)
 
 	if diags.HasError() {
 		return diags
@@ -35,10 +48,12 @@ func (d Data) GetAtPath(ctx context.Context, schemaPath path.Path, target any) d
 		return diags
 	}
 
+	d.logAttributeAccess(ctx, "Provider read "+d.Description.String()+" attribute value", schemaPath, attrValue)
+
 	if reflect.IsGenericAttrValue(ctx, target) {
 		//nolint:forcetypeassert // Type assertion is guaranteed by the above `reflect.IsGenericAttrValue` function
 		*(target.(*attr.Value)) = attrValue
-		return nil
+		return diags
 	}
 
 	raw, err := attrValue.ToTerraformValue(ctx)
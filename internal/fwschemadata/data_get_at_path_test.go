@@ -7400,3 +7400,73 @@ func TestDataGetAtPath(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkDataGetAtPath10(b *testing.B) {
+	benchmarkDataGetAtPath(b, 10)
+}
+
+func BenchmarkDataGetAtPath1000(b *testing.B) {
+	benchmarkDataGetAtPath(b, 1000)
+}
+
+func BenchmarkDataGetAtPath100000(b *testing.B) {
+	benchmarkDataGetAtPath(b, 100000)
+}
+
+// benchmarkDataGetAtPath verifies that retrieving a single nested attribute
+// value does not scale with the size of the surrounding data.
+func benchmarkDataGetAtPath(b *testing.B, elements int) {
+	ctx := context.Background()
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested_attr": tftypes.String,
+		},
+	}
+	elementValues := make([]tftypes.Value, elements)
+
+	for i := 0; i < elements; i++ {
+		elementValues[i] = tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"nested_attr": tftypes.NewValue(tftypes.String, fmt.Sprintf("value%d", i)),
+		})
+	}
+
+	data := fwschemadata.Data{
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"list": testschema.NestedAttribute{
+					NestedObject: testschema.NestedAttributeObject{
+						Attributes: map[string]fwschema.Attribute{
+							"nested_attr": testschema.Attribute{
+								Optional: true,
+								Type:     types.StringType,
+							},
+						},
+					},
+					NestingMode: fwschema.NestingModeList,
+					Optional:    true,
+				},
+			},
+		},
+		TerraformValue: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"list": tftypes.List{ElementType: objectType},
+				},
+			},
+			map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: objectType}, elementValues),
+			},
+		),
+	}
+	p := path.Root("list").AtListIndex(elements - 1).AtName("nested_attr")
+
+	for n := 0; n < b.N; n++ {
+		var target types.String
+
+		diags := data.GetAtPath(ctx, p, &target)
+
+		if diags.HasError() {
+			b.Fatalf("unexpected error: %s", diags)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// getCacheKey is the context key for the decoded model cache.
+type getCacheKey struct{}
+
+// WithGetCache returns a new Context with a decoded model cache, which is
+// used by Get to skip reflection decoding when the same data is decoded into
+// the same target type more than once, such as in multiple validators or
+// plan modifiers during a single RPC. The cache should only be added to
+// request-scoped contexts.
+func WithGetCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, getCacheKey{}, &getCache{
+		entries: make(map[reflect.Type][]getCacheEntry),
+	})
+}
+
+// getCacheFromContext returns the decoded model cache in the Context, if any.
+func getCacheFromContext(ctx context.Context) *getCache {
+	cache, ok := ctx.Value(getCacheKey{}).(*getCache)
+
+	if !ok {
+		return nil
+	}
+
+	return cache
+}
+
+// getCache stores decoded models by target type.
+type getCache struct {
+	entries map[reflect.Type][]getCacheEntry
+	mu      sync.Mutex
+}
+
+// getCacheEntry is a single decoded model and the data it was decoded from.
+type getCacheEntry struct {
+	schemaType     attr.Type
+	terraformValue tftypes.Value
+	value          reflect.Value
+}
+
+// load returns a copy of the decoded model for the given data and target
+// type, if it was previously stored.
+func (c *getCache) load(d Data, targetType reflect.Type) (reflect.Value, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, entry := range c.entries[targetType] {
+		if !entry.terraformValue.Equal(d.TerraformValue) {
+			continue
+		}
+
+		if !entry.schemaType.Equal(d.Schema.Type()) {
+			continue
+		}
+
+		return copyValue(entry.value), true
+	}
+
+	return reflect.Value{}, false
+}
+
+// store saves a copy of the decoded model for the given data.
+func (c *getCache) store(d Data, value reflect.Value) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[value.Type()] = append(c.entries[value.Type()], getCacheEntry{
+		schemaType:     d.Schema.Type(),
+		terraformValue: d.TerraformValue,
+		value:          copyValue(value),
+	})
+}
+
+// copyValue returns a deep copy of the given value, so modifications of a
+// decoded model by the provider do not affect the cached model. Exported
+// struct fields, pointers, slices, arrays, and maps are copied. Unexported
+// struct fields, such as the internals of attr.Value implementations, are
+// treated as immutable and copied as-is.
+func copyValue(value reflect.Value) reflect.Value {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return value
+		}
+
+		result := reflect.New(value.Type().Elem())
+		result.Elem().Set(copyValue(value.Elem()))
+
+		return result
+	case reflect.Struct:
+		result := reflect.New(value.Type()).Elem()
+		result.Set(value)
+
+		for i := 0; i < value.NumField(); i++ {
+			if !value.Type().Field(i).IsExported() {
+				continue
+			}
+
+			result.Field(i).Set(copyValue(value.Field(i)))
+		}
+
+		return result
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+
+		result := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+
+		for i := 0; i < value.Len(); i++ {
+			result.Index(i).Set(copyValue(value.Index(i)))
+		}
+
+		return result
+	case reflect.Array:
+		result := reflect.New(value.Type()).Elem()
+
+		for i := 0; i < value.Len(); i++ {
+			result.Index(i).Set(copyValue(value.Index(i)))
+		}
+
+		return result
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+
+		result := reflect.MakeMapWithSize(value.Type(), value.Len())
+		iter := value.MapRange()
+
+		for iter.Next() {
+			result.SetMapIndex(iter.Key(), copyValue(iter.Value()))
+		}
+
+		return result
+	default:
+		return value
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataGet_GetCache(t *testing.T) {
+	t.Parallel()
+
+	type testModel struct {
+		String types.String   `tfsdk:"string"`
+		List   []types.String `tfsdk:"list"`
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"string": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"list": testschema.Attribute{
+				Optional: true,
+				Type:     types.ListType{ElemType: types.StringType},
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"string": tftypes.String,
+			"list":   tftypes.List{ElementType: tftypes.String},
+		},
+	}
+
+	newData := func(value string) fwschemadata.Data {
+		return fwschemadata.Data{
+			Schema: testSchema,
+			TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, value),
+				"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, value),
+				}),
+			}),
+		}
+	}
+
+	ctx := fwschemadata.WithGetCache(context.Background())
+
+	var first testModel
+
+	if diags := newData("one").Get(ctx, &first); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// Modifications of a decoded model should not affect later Get calls.
+	first.String = types.StringValue("modified")
+	first.List[0] = types.StringValue("modified")
+
+	var second testModel
+
+	if diags := newData("one").Get(ctx, &second); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expected := testModel{
+		String: types.StringValue("one"),
+		List:   []types.String{types.StringValue("one")},
+	}
+
+	if diff := cmp.Diff(second, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	// Different data should not use the cached model.
+	var third testModel
+
+	if diags := newData("two").Get(ctx, &third); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expected = testModel{
+		String: types.StringValue("two"),
+		List:   []types.String{types.StringValue("two")},
+	}
+
+	if diff := cmp.Diff(third, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -8205,3 +8205,88 @@ func TestDataGet(t *testing.T) {
 		})
 	}
 }
+
+func TestDataGet_ReportAllMismatches(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"string": tftypes.String,
+		},
+	}
+	nestedValue := tftypes.NewValue(nestedType, map[string]tftypes.Value{
+		"string": tftypes.NewValue(tftypes.String, "test"),
+	})
+
+	data := fwschemadata.Data{
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"first": testschema.Attribute{
+					Optional: true,
+					Type: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"string": types.StringType,
+						},
+					},
+				},
+				"second": testschema.Attribute{
+					Optional: true,
+					Type: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"string": types.StringType,
+						},
+					},
+				},
+			},
+		},
+		TerraformValue: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"first":  nestedType,
+					"second": nestedType,
+				},
+			},
+			map[string]tftypes.Value{
+				"first":  nestedValue,
+				"second": nestedValue,
+			},
+		),
+	}
+
+	type nestedTarget struct {
+		Other types.String `tfsdk:"other"`
+	}
+
+	type target struct {
+		First  nestedTarget `tfsdk:"first"`
+		Second nestedTarget `tfsdk:"second"`
+	}
+
+	testCases := map[string]struct {
+		ctx                context.Context
+		expectedErrorCount int
+	}{
+		"default": {
+			ctx:                context.Background(),
+			expectedErrorCount: 1,
+		},
+		"enabled": {
+			ctx:                fwschemadata.WithReportAllMismatches(context.Background()),
+			expectedErrorCount: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := data.Get(testCase.ctx, new(target))
+
+			if got := diags.ErrorsCount(); got != testCase.expectedErrorCount {
+				t.Errorf("expected %d errors, got %d: %v", testCase.expectedErrorCount, got, diags)
+			}
+		})
+	}
+}
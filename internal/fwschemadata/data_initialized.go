@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// initializedDiagnostics returns an error diagnostic if the Data is missing
+// its Schema, such as a zero value tfsdk.State created outside the framework,
+// which would otherwise cause a nil pointer panic deep in schema or tftypes
+// handling. The operation, such as "Read" or "Write", is used in the summary.
+//
+// If requireValue is true, the TerraformValue must also have a type, which is
+// necessary to merge new values into the existing value. Replacing or reading
+// the entire value does not require it, as a zero value is treated as null.
+func (d Data) initializedDiagnostics(operation string, requireValue bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.Schema == nil {
+		diags.AddError(
+			d.Description.Title()+" "+operation+" Error",
+			"An unexpected error was encountered trying to "+strings.ToLower(operation)+" the "+d.Description.String()+". "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"The "+d.Description.String()+" has no schema. The framework sets the schema when it creates the "+d.Description.String()+" "+
+				"of a request or response. If the "+d.Description.String()+" was created outside the framework, such as in a unit test, "+
+				"set its Schema field before calling its methods.",
+		)
+
+		return diags
+	}
+
+	if requireValue && d.TerraformValue.Type() == nil {
+		diags.AddError(
+			d.Description.Title()+" "+operation+" Error",
+			"An unexpected error was encountered trying to "+strings.ToLower(operation)+" the "+d.Description.String()+". "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"The "+d.Description.String()+" has no value to update. The framework sets the value when it creates the "+d.Description.String()+" "+
+				"of a request or response. If the "+d.Description.String()+" was created outside the framework, such as in a unit test, "+
+				"set its Raw field to a value of the schema type, such as a null value, or call Set to write the entire "+d.Description.String()+" first.",
+		)
+	}
+
+	return diags
+}
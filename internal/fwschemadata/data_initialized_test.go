@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataSchemaMissing(t *testing.T) {
+	t.Parallel()
+
+	type testModel struct {
+		Test types.String `tfsdk:"test"`
+	}
+
+	expectedDiags := func(operation string) diag.Diagnostics {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Configuration "+operation+" Error",
+				"An unexpected error was encountered trying to "+strings.ToLower(operation)+" the configuration. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"The configuration has no schema. The framework sets the schema when it creates the configuration "+
+					"of a request or response. If the configuration was created outside the framework, such as in a unit test, "+
+					"set its Schema field before calling its methods.",
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		call          func(context.Context, *fwschemadata.Data) diag.Diagnostics
+		expectedDiags diag.Diagnostics
+	}{
+		"Get": {
+			call: func(ctx context.Context, data *fwschemadata.Data) diag.Diagnostics {
+				return data.Get(ctx, &testModel{})
+			},
+			expectedDiags: expectedDiags("Read"),
+		},
+		"GetAtPath": {
+			call: func(ctx context.Context, data *fwschemadata.Data) diag.Diagnostics {
+				var target types.String
+
+				return data.GetAtPath(ctx, path.Root("test"), &target)
+			},
+			expectedDiags: expectedDiags("Read"),
+		},
+		"PathMatches": {
+			call: func(ctx context.Context, data *fwschemadata.Data) diag.Diagnostics {
+				_, diags := data.PathMatches(ctx, path.MatchRoot("test"))
+
+				return diags
+			},
+			expectedDiags: expectedDiags("Read"),
+		},
+		"Set": {
+			call: func(ctx context.Context, data *fwschemadata.Data) diag.Diagnostics {
+				return data.Set(ctx, &testModel{})
+			},
+			expectedDiags: expectedDiags("Write"),
+		},
+		"SetAtPath": {
+			call: func(ctx context.Context, data *fwschemadata.Data) diag.Diagnostics {
+				return data.SetAtPath(ctx, path.Root("test"), types.StringValue("test"))
+			},
+			expectedDiags: expectedDiags("Write"),
+		},
+		"SetPartial": {
+			call: func(ctx context.Context, data *fwschemadata.Data) diag.Diagnostics {
+				return data.SetPartial(ctx, &testModel{})
+			},
+			expectedDiags: expectedDiags("Write"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionConfiguration,
+			}
+
+			got := testCase.call(context.Background(), data)
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
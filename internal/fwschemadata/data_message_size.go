@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// DefaultMessageSizeLimit is the default maximum size, in bytes, of encoded
+// data sent to Terraform. It matches the 64MB maximum message size Terraform
+// receives from providers, which is lower than the 256MB gRPC message size
+// limits of the provider server.
+const DefaultMessageSizeLimit = 64 << 20
+
+// messageSizeLimitLargestAttributes is the number of largest attributes
+// included in message size limit diagnostics.
+const messageSizeLimitLargestAttributes = 5
+
+// messageSizeLimitKey is the context key for the message size limit.
+type messageSizeLimitKey struct{}
+
+// WithMessageSizeLimit returns a new Context with the maximum size, in bytes,
+// of encoded data sent to Terraform. Only the diagnostic threshold changes;
+// a limit above DefaultMessageSizeLimit does not raise the limit of Terraform.
+func WithMessageSizeLimit(ctx context.Context, limit int) context.Context {
+	return context.WithValue(ctx, messageSizeLimitKey{}, limit)
+}
+
+// MessageSizeLimit returns the maximum size, in bytes, of encoded data sent to
+// Terraform in the Context, or DefaultMessageSizeLimit.
+func MessageSizeLimit(ctx context.Context) int {
+	limit, ok := ctx.Value(messageSizeLimitKey{}).(int)
+
+	if !ok || limit <= 0 {
+		return DefaultMessageSizeLimit
+	}
+
+	return limit
+}
+
+// MessageSizeDiagnostics returns an error diagnostic if the encoded size of
+// the data exceeds the message size limit in the Context. Otherwise Terraform
+// would only receive an opaque gRPC transport error. The diagnostic includes
+// the largest top level attributes and blocks, so they can be targeted for
+// reduction.
+//
+// Only the encoded data is checked, not the whole response. Other response
+// fields, such as private state and diagnostics, are not counted, so a
+// response with data just below the limit can still exceed it.
+func (d Data) MessageSizeDiagnostics(ctx context.Context, size int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	limit := MessageSizeLimit(ctx)
+
+	if size <= limit {
+		return diags
+	}
+
+	detail := fmt.Sprintf("The encoded %s is %d bytes, which exceeds the message size limit of %d bytes. ", d.Description.String(), size, limit) +
+		"Terraform would be unable to receive it and would only report a transport error. " +
+		"Reduce the amount of data in the largest attributes. " +
+		"Please report this to the provider developers."
+
+	if largest := d.largestAttributes(); len(largest) > 0 {
+		detail += "\n\nLargest Attributes:\n\n" + strings.Join(largest, "\n")
+	}
+
+	diags.AddError(
+		d.Description.Title()+" Exceeds Message Size Limit",
+		detail,
+	)
+
+	return diags
+}
+
+// largestAttributes returns a line with the name and encoded size of each of
+// the largest top level attributes and blocks, in descending size order.
+func (d Data) largestAttributes() []string {
+	if !d.TerraformValue.IsKnown() || d.TerraformValue.IsNull() {
+		return nil
+	}
+
+	var values map[string]tftypes.Value
+
+	if err := d.TerraformValue.As(&values); err != nil {
+		return nil
+	}
+
+	type attributeSize struct {
+		name string
+		size int
+	}
+
+	sizes := make([]attributeSize, 0, len(values))
+
+	for name, value := range values {
+		encoded, err := tfprotov6.NewDynamicValue(value.Type(), value)
+
+		if err != nil {
+			continue
+		}
+
+		sizes = append(sizes, attributeSize{
+			name: name,
+			size: len(encoded.MsgPack),
+		})
+	}
+
+	sort.Slice(sizes, func(i, j int) bool {
+		if sizes[i].size == sizes[j].size {
+			return sizes[i].name < sizes[j].name
+		}
+
+		return sizes[i].size > sizes[j].size
+	})
+
+	if len(sizes) > messageSizeLimitLargestAttributes {
+		sizes = sizes[:messageSizeLimitLargestAttributes]
+	}
+
+	lines := make([]string, 0, len(sizes))
+
+	for _, s := range sizes {
+		lines = append(lines, fmt.Sprintf("- %s: %d bytes", s.name, s.size))
+	}
+
+	return lines
+}
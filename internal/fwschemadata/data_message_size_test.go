@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMessageSizeLimit(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ctx      context.Context
+		expected int
+	}{
+		"default": {
+			ctx:      context.Background(),
+			expected: fwschemadata.DefaultMessageSizeLimit,
+		},
+		"limit": {
+			ctx:      fwschemadata.WithMessageSizeLimit(context.Background(), 1024),
+			expected: 1024,
+		},
+		"limit-zero": {
+			ctx:      fwschemadata.WithMessageSizeLimit(context.Background(), 0),
+			expected: fwschemadata.DefaultMessageSizeLimit,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwschemadata.MessageSizeLimit(testCase.ctx)
+
+			if got != testCase.expected {
+				t.Errorf("expected %d, got %d", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestDataMessageSizeDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"small": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"large": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testData := fwschemadata.Data{
+		Description: fwschemadata.DataDescriptionState,
+		Schema:      testSchema,
+		TerraformValue: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"small": tftypes.NewValue(tftypes.String, "a"),
+				"large": tftypes.NewValue(tftypes.String, strings.Repeat("a", 100)),
+			},
+		),
+	}
+
+	testCases := map[string]struct {
+		ctx      context.Context
+		size     int
+		expected diag.Diagnostics
+	}{
+		"under-default-limit": {
+			ctx:  context.Background(),
+			size: 1024,
+		},
+		"at-limit": {
+			ctx:  fwschemadata.WithMessageSizeLimit(context.Background(), 1024),
+			size: 1024,
+		},
+		"over-limit": {
+			ctx:  fwschemadata.WithMessageSizeLimit(context.Background(), 100),
+			size: 1024,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"State Exceeds Message Size Limit",
+					"The encoded state is 1024 bytes, which exceeds the message size limit of 100 bytes. "+
+						"Terraform would be unable to receive it and would only report a transport error. "+
+						"Reduce the amount of data in the largest attributes. "+
+						"Please report this to the provider developers.\n\n"+
+						"Largest Attributes:\n\n"+
+						"- large: 102 bytes\n"+
+						"- small: 2 bytes",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testData.MessageSizeDiagnostics(testCase.ctx, testCase.size)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,10 @@ import (
 // from matching, the parent path is returned rather than no match to prevent
 // false positives.
 func (d Data) PathMatches(ctx context.Context, pathExpr path.Expression) (path.Paths, diag.Diagnostics) {
+	if diags := d.initializedDiagnostics("Read", false); diags.HasError() {
+		return nil, diags
+	}
+
 	var diags diag.Diagnostics
 	var paths path.Paths
 
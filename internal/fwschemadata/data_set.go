@@ -15,7 +15,11 @@ import (
 // Set replaces the entire value. The value should be a struct whose fields
 // have one of the attr.Value types. Each field must have the tfsdk field tag.
 func (d *Data) Set(ctx context.Context, val any) diag.Diagnostics {
-	attrValue, diags := reflect.FromValue(ctx, d.Schema.Type(), val, path.Empty())
+	if diags := d.initializedDiagnostics("Write", false); diags.HasError() {
+		return diags
+	}
+
+	attrValue, diags := reflect.FromValue(ctx, d.Schema.Type(), val, reflect.Options{UntypedNilAsNull: true}, path.Empty())
 
 	if diags.HasError() {
 		return diags
@@ -27,6 +27,10 @@ import (
 //
 // Lists can only have the next element added according to the current length.
 func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) diag.Diagnostics {
+	if diags := d.initializedDiagnostics("Write", true); diags.HasError() {
+		return diags
+	}
+
 	var diags diag.Diagnostics
 
 	ctx = logging.FrameworkWithAttributePath(ctx, path.String())
@@ -53,13 +57,15 @@ func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) d
 
 	// MAINTAINER NOTE: The call to reflect.FromValue() checks for whether the type implements
 	// xattr.TypeWithValidate and calls Validate() if the type assertion succeeds.
-	newVal, newValDiags := reflect.FromValue(ctx, attrType, val, path)
+	newVal, newValDiags := reflect.FromValue(ctx, attrType, val, reflect.Options{UntypedNilAsNull: true}, path)
 	diags.Append(newValDiags...)
 
 	if diags.HasError() {
 		return diags
 	}
 
+	d.logAttributeAccess(ctx, "Provider set "+d.Description.String()+" attribute value", path, newVal)
+
 	tfVal, err := newVal.ToTerraformValue(ctx)
 
 	if err != nil {
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// SetPartial merges the fields of the given struct into the existing value.
+// The value should be a struct whose fields have one of the attr.Value types
+// and each field must have the tfsdk field tag of a root attribute or block.
+// Root attributes and blocks without a struct field keep their existing
+// value. If any field cannot be set, the existing value is left unchanged.
+func (d *Data) SetPartial(ctx context.Context, val any) diag.Diagnostics {
+	if diags := d.initializedDiagnostics("Write", true); diags.HasError() {
+		return diags
+	}
+
+	fields, diags := reflect.StructFieldValues(ctx, val, path.Empty())
+
+	if diags.HasError() {
+		return diags
+	}
+
+	names := make([]string, 0, len(fields))
+
+	for name := range fields {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	// Write into a copy, so an error partway through does not leave the
+	// existing value partially updated.
+	partial := *d
+
+	for _, name := range names {
+		diags.Append(partial.SetAtPath(ctx, path.Root(name), fields[name])...)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.TerraformValue = partial.TerraformValue
+
+	return diags
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataSetPartial(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"name": tftypes.String,
+			"tags": tftypes.List{ElementType: tftypes.String},
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"id": testschema.Attribute{
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": testschema.Attribute{
+				Type:     types.StringType,
+				Required: true,
+			},
+			"tags": testschema.Attribute{
+				Type:     types.ListType{ElemType: types.StringType},
+				Optional: true,
+			},
+		},
+	}
+
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "test-id"),
+		"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+		"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "oldtag"),
+		}),
+	})
+
+	type testCase struct {
+		data          fwschemadata.Data
+		val           any
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		"struct": {
+			data: fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionState,
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			},
+			val: struct {
+				Name types.String `tfsdk:"name"`
+			}{
+				Name: types.StringValue("newvalue"),
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "test-id"),
+				"name": tftypes.NewValue(tftypes.String, "newvalue"),
+				"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "oldtag"),
+				}),
+			}),
+		},
+		"struct-pointer": {
+			data: fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionState,
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			},
+			val: &struct {
+				Name string   `tfsdk:"name"`
+				Tags []string `tfsdk:"tags"`
+			}{
+				Name: "newvalue",
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "test-id"),
+				"name": tftypes.NewValue(tftypes.String, "newvalue"),
+				"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			}),
+		},
+		"null-value": {
+			data: fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionState,
+				Schema:         testSchema,
+				TerraformValue: tftypes.NewValue(testType, nil),
+			},
+			val: struct {
+				Name string `tfsdk:"name"`
+			}{
+				Name: "newvalue",
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, nil),
+				"name": tftypes.NewValue(tftypes.String, "newvalue"),
+				"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			}),
+		},
+		"not-struct": {
+			data: fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionState,
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			},
+			val:      "test",
+			expected: testValue,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert from struct value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"expected a struct or pointer to a struct, got: string",
+				),
+			},
+		},
+		"unchanged-on-error": {
+			data: fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionState,
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			},
+			val: struct {
+				Name    string `tfsdk:"name"`
+				Missing string `tfsdk:"missing"`
+			}{
+				Name:    "newvalue",
+				Missing: "test",
+			},
+			expected: testValue,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("missing"),
+					"State Write Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.data.SetPartial(context.Background(), tc.val)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.data.TerraformValue, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
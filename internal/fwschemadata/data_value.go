@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
@@ -80,6 +81,11 @@ func (d Data) ValueAtPath(ctx context.Context, schemaPath path.Path) (attr.Value
 	attrValue, err := attrType.ValueFromTerraform(ctx, tfValue)
 
 	if err != nil {
+		if conversionDiags, ok := intreflect.TypeConversionErrorDiags(ctx, attrType, tfValue, err, schemaPath); ok {
+			diags.Append(conversionDiags...)
+			return nil, diags
+		}
+
 		diags.AddAttributeError(
 			schemaPath,
 			d.Description.Title()+" Read Error",
@@ -2303,6 +2303,40 @@ func TestDataValueAtPath(t *testing.T) {
 			expected:      nil,
 			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("test"))},
 		},
+		"AttrTypeWithConversionError": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test":  tftypes.String,
+						"other": tftypes.Bool,
+					},
+				}, map[string]tftypes.Value{
+					"test":  tftypes.NewValue(tftypes.String, "value"),
+					"other": tftypes.NewValue(tftypes.Bool, nil),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type:     testtypes.StringTypeWithConversionError{},
+							Required: true,
+						},
+						"other": testschema.Attribute{
+							Type:     types.BoolType,
+							Optional: true,
+						},
+					},
+				},
+			},
+			path:     path.Root("test"),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid CIDR Value",
+					`Expected CIDR like 10.0.0.0/16, got: tftypes.String<"value">. Error: test conversion error`,
+				),
+			},
+		},
 		"AttrTypeWithValidateWarning": {
 			data: fwschemadata.Data{
 				TerraformValue: tftypes.NewValue(tftypes.Object{
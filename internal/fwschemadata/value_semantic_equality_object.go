@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -132,7 +133,9 @@ func ValueSemanticEqualityObjectAttributes(ctx context.Context, req ValueSemanti
 	// equality logic. This ensures that recursion will catch a further
 	// underlying element type has its semantic equality logic checked, even if
 	// the current element type does not implement the interface.
-	for name, proposedNewValueElement := range proposedNewValueAttributes {
+	for _, name := range fwschema.SortedNames(proposedNewValueAttributes) {
+		proposedNewValueElement := proposedNewValueAttributes[name]
+
 		// Ensure new value always contains all of proposed new value
 		newValueAttributes[name] = proposedNewValueElement
 
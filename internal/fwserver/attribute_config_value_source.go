@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// configValueSource returns where the planned value of an attribute, block,
+// or nested object came from, based on its configuration value and, for
+// attributes, whether the attribute has a Default or is Computed. The
+// attribute is nil for blocks and nested objects.
+func configValueSource(ctx context.Context, attribute fwschema.Attribute, configValue attr.Value) planmodifier.ValueSource {
+	if !configValueIsNull(ctx, configValue) {
+		return planmodifier.ValueSourceConfig
+	}
+
+	if attribute == nil {
+		return planmodifier.ValueSourceNull
+	}
+
+	if attributeHasDefault(attribute) {
+		return planmodifier.ValueSourceDefault
+	}
+
+	if attribute.IsComputed() {
+		return planmodifier.ValueSourceComputed
+	}
+
+	return planmodifier.ValueSourceNull
+}
+
+// configValueIsNull returns true if the configuration value is null. Dynamic
+// values can be known when only their type is known, so the underlying value
+// is checked, matching when defaults are applied.
+func configValueIsNull(ctx context.Context, configValue attr.Value) bool {
+	if configValue == nil || configValue.IsNull() {
+		return true
+	}
+
+	dynValuable, ok := configValue.(basetypes.DynamicValuable)
+
+	if !ok {
+		return false
+	}
+
+	dynConfigValue, diags := dynValuable.ToDynamicValue(ctx)
+
+	if diags.HasError() {
+		return false
+	}
+
+	return dynConfigValue.IsUnderlyingValueNull()
+}
+
+// attributeHasDefault returns true if the attribute has a Default.
+func attributeHasDefault(attribute fwschema.Attribute) bool {
+	switch a := attribute.(type) {
+	case fwschema.AttributeWithBoolDefaultValue:
+		return a.BoolDefaultValue() != nil
+	case fwschema.AttributeWithFloat32DefaultValue:
+		return a.Float32DefaultValue() != nil
+	case fwschema.AttributeWithFloat64DefaultValue:
+		return a.Float64DefaultValue() != nil
+	case fwschema.AttributeWithInt32DefaultValue:
+		return a.Int32DefaultValue() != nil
+	case fwschema.AttributeWithInt64DefaultValue:
+		return a.Int64DefaultValue() != nil
+	case fwschema.AttributeWithListDefaultValue:
+		return a.ListDefaultValue() != nil
+	case fwschema.AttributeWithMapDefaultValue:
+		return a.MapDefaultValue() != nil
+	case fwschema.AttributeWithNumberDefaultValue:
+		return a.NumberDefaultValue() != nil
+	case fwschema.AttributeWithObjectDefaultValue:
+		return a.ObjectDefaultValue() != nil
+	case fwschema.AttributeWithSetDefaultValue:
+		return a.SetDefaultValue() != nil
+	case fwschema.AttributeWithStringDefaultValue:
+		return a.StringDefaultValue() != nil
+	case fwschema.AttributeWithDynamicDefaultValue:
+		return a.DynamicDefaultValue() != nil
+	default:
+		return false
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -224,15 +225,16 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 			}
 
 			objectReq := planmodifier.ObjectRequest{
-				Config:         req.Config,
-				ConfigValue:    configObject,
-				Path:           attrPath,
-				PathExpression: attrPath.Expression(),
-				Plan:           req.Plan,
-				PlanValue:      planObject,
-				Private:        resp.Private,
-				State:          req.State,
-				StateValue:     stateObject,
+				Config:            req.Config,
+				ConfigValue:       configObject,
+				ConfigValueSource: configValueSource(ctx, nil, configObject),
+				Path:              attrPath,
+				PathExpression:    attrPath.Expression(),
+				Plan:              req.Plan,
+				PlanValue:         planObject,
+				Private:           resp.Private,
+				State:             req.State,
+				StateValue:        stateObject,
 			}
 			objectResp := &ModifyAttributePlanResponse{
 				AttributePlan: objectReq.PlanValue,
@@ -375,15 +377,16 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 			}
 
 			objectReq := planmodifier.ObjectRequest{
-				Config:         req.Config,
-				ConfigValue:    configObject,
-				Path:           attrPath,
-				PathExpression: attrPath.Expression(),
-				Plan:           req.Plan,
-				PlanValue:      planObject,
-				Private:        resp.Private,
-				State:          req.State,
-				StateValue:     stateObject,
+				Config:            req.Config,
+				ConfigValue:       configObject,
+				ConfigValueSource: configValueSource(ctx, nil, configObject),
+				Path:              attrPath,
+				PathExpression:    attrPath.Expression(),
+				Plan:              req.Plan,
+				PlanValue:         planObject,
+				Private:           resp.Private,
+				State:             req.State,
+				StateValue:        stateObject,
 			}
 			objectResp := &ModifyAttributePlanResponse{
 				AttributePlan: objectReq.PlanValue,
@@ -526,15 +529,16 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 			}
 
 			objectReq := planmodifier.ObjectRequest{
-				Config:         req.Config,
-				ConfigValue:    configObject,
-				Path:           attrPath,
-				PathExpression: attrPath.Expression(),
-				Plan:           req.Plan,
-				PlanValue:      planObject,
-				Private:        resp.Private,
-				State:          req.State,
-				StateValue:     stateObject,
+				Config:            req.Config,
+				ConfigValue:       configObject,
+				ConfigValueSource: configValueSource(ctx, nil, configObject),
+				Path:              attrPath,
+				PathExpression:    attrPath.Expression(),
+				Plan:              req.Plan,
+				PlanValue:         planObject,
+				Private:           resp.Private,
+				State:             req.State,
+				StateValue:        stateObject,
 			}
 			objectResp := &ModifyAttributePlanResponse{
 				AttributePlan: objectReq.PlanValue,
@@ -632,15 +636,16 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 		}
 
 		objectReq := planmodifier.ObjectRequest{
-			Config:         req.Config,
-			ConfigValue:    configObject,
-			Path:           req.AttributePath,
-			PathExpression: req.AttributePathExpression,
-			Plan:           req.Plan,
-			PlanValue:      planObject,
-			Private:        resp.Private,
-			State:          req.State,
-			StateValue:     stateObject,
+			Config:            req.Config,
+			ConfigValue:       configObject,
+			ConfigValueSource: configValueSource(ctx, nil, configObject),
+			Path:              req.AttributePath,
+			PathExpression:    req.AttributePathExpression,
+			Plan:              req.Plan,
+			PlanValue:         planObject,
+			Private:           resp.Private,
+			State:             req.State,
+			StateValue:        stateObject,
 		}
 		objectResp := &ModifyAttributePlanResponse{
 			AttributePlan: objectReq.PlanValue,
@@ -777,15 +782,17 @@ func AttributePlanModifyBool(ctx context.Context, attribute fwxschema.AttributeW
 	}
 
 	planModifyReq := planmodifier.BoolRequest{
-		Config:         req.Config,
-		ConfigValue:    configValue,
-		Path:           req.AttributePath,
-		PathExpression: req.AttributePathExpression,
-		Plan:           req.Plan,
-		PlanValue:      planValue,
-		Private:        req.Private,
-		State:          req.State,
-		StateValue:     stateValue,
+		Attribute:         attribute,
+		Config:            req.Config,
+		ConfigValue:       configValue,
+		ConfigValueSource: configValueSource(ctx, attribute, configValue),
+		Path:              req.AttributePath,
+		PathExpression:    req.AttributePathExpression,
+		Plan:              req.Plan,
+		PlanValue:         planValue,
+		Private:           req.Private,
+		State:             req.State,
+		StateValue:        stateValue,
 	}
 
 	for _, planModifier := range attribute.BoolPlanModifiers() {
@@ -804,7 +811,9 @@ func AttributePlanModifyBool(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
-		planModifier.PlanModifyBool(ctx, planModifyReq, planModifyResp)
+		spanCtx, span := tracing.Start(ctx, "planmodifier.Bool", tracing.AttributePath(planModifyReq.Path), tracing.Description(ctx, planModifier))
+		planModifier.PlanModifyBool(spanCtx, planModifyReq, planModifyResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -937,15 +946,17 @@ func AttributePlanModifyFloat32(ctx context.Context, attribute fwxschema.Attribu
 	}
 
 	planModifyReq := planmodifier.Float32Request{
-		Config:         req.Config,
-		ConfigValue:    configValue,
-		Path:           req.AttributePath,
-		PathExpression: req.AttributePathExpression,
-		Plan:           req.Plan,
-		PlanValue:      planValue,
-		Private:        req.Private,
-		State:          req.State,
-		StateValue:     stateValue,
+		Attribute:         attribute,
+		Config:            req.Config,
+		ConfigValue:       configValue,
+		ConfigValueSource: configValueSource(ctx, attribute, configValue),
+		Path:              req.AttributePath,
+		PathExpression:    req.AttributePathExpression,
+		Plan:              req.Plan,
+		PlanValue:         planValue,
+		Private:           req.Private,
+		State:             req.State,
+		StateValue:        stateValue,
 	}
 
 	for _, planModifier := range attribute.Float32PlanModifiers() {
@@ -964,7 +975,9 @@ func AttributePlanModifyFloat32(ctx context.Context, attribute fwxschema.Attribu
 			},
 		)
 
-		planModifier.PlanModifyFloat32(ctx, planModifyReq, planModifyResp)
+		spanCtx, span := tracing.Start(ctx, "planmodifier.Float32", tracing.AttributePath(planModifyReq.Path), tracing.Description(ctx, planModifier))
+		planModifier.PlanModifyFloat32(spanCtx, planModifyReq, planModifyResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -1097,15 +1110,17 @@ func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.Attribu
 	}
 
 	planModifyReq := planmodifier.Float64Request{
-		Config:         req.Config,
-		ConfigValue:    configValue,
-		Path:           req.AttributePath,
-		PathExpression: req.AttributePathExpression,
-		Plan:           req.Plan,
-		PlanValue:      planValue,
-		Private:        req.Private,
-		State:          req.State,
-		StateValue:     stateValue,
+		Attribute:         attribute,
+		Config:            req.Config,
+		ConfigValue:       configValue,
+		ConfigValueSource: configValueSource(ctx, attribute, configValue),
+		Path:              req.AttributePath,
+		PathExpression:    req.AttributePathExpression,
+		Plan:              req.Plan,
+		PlanValue:         planValue,
+		Private:           req.Private,
+		State:             req.State,
+		StateValue:        stateValue,
 	}
 
 	for _, planModifier := range attribute.Float64PlanModifiers() {
@@ -1124,7 +1139,9 @@ func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.Attribu
 			},
 		)
 
-		planModifier.PlanModifyFloat64(ctx, planModifyReq, planModifyResp)
+		spanCtx, span := tracing.Start(ctx, "planmodifier.Float64", tracing.AttributePath(planModifyReq.Path), tracing.Description(ctx, planModifier))
+		planModifier.PlanModifyFloat64(spanCtx, planModifyReq, planModifyResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -1257,15 +1274,17 @@ func AttributePlanModifyInt32(ctx context.Context, attribute fwxschema.Attribute
 	}
 
 	planModifyReq := planmodifier.Int32Request{
-		Config:         req.Config,
-		ConfigValue:    configValue,
-		Path:           req.AttributePath,
-		PathExpression: req.AttributePathExpression,
-		Plan:           req.Plan,
-		PlanValue:      planValue,
-		Private:        req.Private,
-		State:          req.State,
-		StateValue:     stateValue,
+		Attribute:         attribute,
+		Config:            req.Config,
+		ConfigValue:       configValue,
+		ConfigValueSource: configValueSource(ctx, attribute, configValue),
+		Path:              req.AttributePath,
+		PathExpression:    req.AttributePathExpression,
+		Plan:              req.Plan,
+		PlanValue:         planValue,
+		Private:           req.Private,
+		State:             req.State,
+		StateValue:        stateValue,
 	}
 
 	for _, planModifier := range attribute.Int32PlanModifiers() {
@@ -1284,7 +1303,9 @@ func AttributePlanModifyInt32(ctx context.Context, attribute fwxschema.Attribute
 			},
 		)
 
-		planModifier.PlanModifyInt32(ctx, planModifyReq, planModifyResp)
+		spanCtx, span := tracing.Start(ctx, "planmodifier.Int32", tracing.AttributePath(planModifyReq.Path), tracing.Description(ctx, planModifier))
+		planModifier.PlanModifyInt32(spanCtx, planModifyReq, planModifyResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -1417,15 +1438,17 @@ func AttributePlanModifyInt64(ctx context.Context, attribute fwxschema.Attribute
 	}
 
 	planModifyReq := planmodifier.Int64Request{
-		Config:         req.Config,
-		ConfigValue:    configValue,
-		Path:           req.AttributePath,
-		PathExpression: req.AttributePathExpression,
-		Plan:           req.Plan,
-		PlanValue:      planValue,
-		Private:        req.Private,
-		State:          req.State,
-		StateValue:     stateValue,
+		Attribute:         attribute,
+		Config:            req.Config,
+		ConfigValue:       configValue,
+		ConfigValueSource: configValueSource(ctx, attribute, configValue),
+		Path:              req.AttributePath,
+		PathExpression:    req.AttributePathExpression,
+		Plan:              req.Plan,
+		PlanValue:         planValue,
+		Private:           req.Private,
+		State:             req.State,
+		StateValue:        stateValue,
 	}
 
 	for _, planModifier := range attribute.Int64PlanModifiers() {
@@ -1444,7 +1467,9 @@ func AttributePlanModifyInt64(ctx context.Context, attribute fwxschema.Attribute
 			},
 		)
 
-		planModifier.PlanModifyInt64(ctx, planModifyReq, planModifyResp)
+		spanCtx, span := tracing.Start(ctx, "planmodifier.Int64", tracing.AttributePath(planModifyReq.Path), tracing.Description(ctx, planModifier))
+		planModifier.PlanModifyInt64(spanCtx, planModifyReq, planModifyResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -1577,15 +1602,17 @@ func AttributePlanModifyList(ctx context.Context, attribute fwxschema.AttributeW
 	}
 
 	planModifyReq := planmodifier.ListRequest{
-		Config:         req.Config,
-		ConfigValue:    configValue,
-		Path:           req.AttributePath,
-		PathExpression: req.AttributePathExpression,
-		Plan:           req.Plan,
-		PlanValue:      planValue,
-		Private:        req.Private,
-		State:          req.State,
-		StateValue:     stateValue,
+		Attribute:         attribute,
+		Config:            req.Config,
+		ConfigValue:       configValue,
+		ConfigValueSource: configValueSource(ctx, attribute, configValue),
+		Path:              req.AttributePath,
+		PathExpression:    req.AttributePathExpression,
+		Plan:              req.Plan,
+		PlanValue:         planValue,
+		Private:           req.Private,
+		State:             req.State,
+		StateValue:        stateValue,
 	}
 
 	for _, planModifier := range attribute.ListPlanModifiers() {
@@ -1604,7 +1631,9 @@ func AttributePlanModifyList(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
-		planModifier.PlanModifyList(ctx, planModifyReq, planModifyResp)
+		spanCtx, span := tracing.Start(ctx, "planmodifier.List", tracing.AttributePath(planModifyReq.Path), tracing.Description(ctx, planModifier))
+		planModifier.PlanModifyList(spanCtx, planModifyReq, planModifyResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -1737,15 +1766,17 @@ func AttributePlanModifyMap(ctx context.Context, attribute fwxschema.AttributeWi
 	}
 
 	planModifyReq := planmodifier.MapRequest{
-		Config:         req.Config,
-		ConfigValue:    configValue,
-		Path:           req.AttributePath,
-		PathExpression: req.AttributePathExpression,
-		Plan:           req.Plan,
-		PlanValue:      planValue,
-		Private:        req.Private,
-		State:          req.State,
-		StateValue:     stateValue,
+		Attribute:         attribute,
+		Config:            req.Config,
+		ConfigValue:       configValue,
+		ConfigValueSource: configValueSource(ctx, attribute, configValue),
+		Path:              req.AttributePath,
+		PathExpression:    req.AttributePathExpression,
+		Plan:              req.Plan,
+		PlanValue:         planValue,
+		Private:           req.Private,
+		State:             req.State,
+		StateValue:        stateValue,
 	}
 
 	for _, planModifier := range attribute.MapPlanModifiers() {
@@ -1764,7 +1795,9 @@ func AttributePlanModifyMap(ctx context.Context, attribute fwxschema.AttributeWi
 			},
 		)
 
-		planModifier.PlanModifyMap(ctx, planModifyReq, planModifyResp)
+		spanCtx, span := tracing.Start(ctx, "planmodifier.Map", tracing.AttributePath(planModifyReq.Path), tracing.Description(ctx, planModifier))
+		planModifier.PlanModifyMap(spanCtx, planModifyReq, planModifyResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -1897,15 +1930,17 @@ func AttributePlanModifyNumber(ctx context.Context, attribute fwxschema.Attribut
 	}
 
 	planModifyReq := planmodifier.NumberRequest{
-		Config:         req.Config,
-		ConfigValue:    configValue,
-		Path:           req.AttributePath,
-		PathExpression: req.AttributePathExpression,
-		Plan:           req.Plan,
-		PlanValue:      planValue,
-		Private:        req.Private,
-		State:          req.State,
-		StateValue:     stateValue,
+		Attribute:         attribute,
+		Config:            req.Config,
+		ConfigValue:       configValue,
+		ConfigValueSource: configValueSource(ctx, attribute, configValue),
+		Path:              req.AttributePath,
+		PathExpression:    req.AttributePathExpression,
+		Plan:              req.Plan,
+		PlanValue:         planValue,
+		Private:           req.Private,
+		State:             req.State,
+		StateValue:        stateValue,
 	}
 
 	for _, planModifier := range attribute.NumberPlanModifiers() {
@@ -1924,7 +1959,9 @@ func AttributePlanModifyNumber(ctx context.Context, attribute fwxschema.Attribut
 			},
 		)
 
-		planModifier.PlanModifyNumber(ctx, planModifyReq, planModifyResp)
+		spanCtx, span := tracing.Start(ctx, "planmodifier.Number", tracing.AttributePath(planModifyReq.Path), tracing.Description(ctx, planModifier))
+		planModifier.PlanModifyNumber(spanCtx, planModifyReq, planModifyResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -2057,15 +2094,17 @@ func AttributePlanModifyObject(ctx context.Context, attribute fwxschema.Attribut
 	}
 
 	planModifyReq := planmodifier.ObjectRequest{
-		Config:         req.Config,
-		ConfigValue:    configValue,
-		Path:           req.AttributePath,
-		PathExpression: req.AttributePathExpression,
-		Plan:           req.Plan,
-		PlanValue:      planValue,
-		Private:        req.Private,
-		State:          req.State,
-		StateValue:     stateValue,
+		Attribute:         attribute,
+		Config:            req.Config,
+		ConfigValue:       configValue,
+		ConfigValueSource: configValueSource(ctx, attribute, configValue),
+		Path:              req.AttributePath,
+		PathExpression:    req.AttributePathExpression,
+		Plan:              req.Plan,
+		PlanValue:         planValue,
+		Private:           req.Private,
+		State:             req.State,
+		StateValue:        stateValue,
 	}
 
 	for _, planModifier := range attribute.ObjectPlanModifiers() {
@@ -2084,7 +2123,9 @@ func AttributePlanModifyObject(ctx context.Context, attribute fwxschema.Attribut
 			},
 		)
 
-		planModifier.PlanModifyObject(ctx, planModifyReq, planModifyResp)
+		spanCtx, span := tracing.Start(ctx, "planmodifier.Object", tracing.AttributePath(planModifyReq.Path), tracing.Description(ctx, planModifier))
+		planModifier.PlanModifyObject(spanCtx, planModifyReq, planModifyResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -2217,15 +2258,17 @@ func AttributePlanModifySet(ctx context.Context, attribute fwxschema.AttributeWi
 	}
 
 	planModifyReq := planmodifier.SetRequest{
-		Config:         req.Config,
-		ConfigValue:    configValue,
-		Path:           req.AttributePath,
-		PathExpression: req.AttributePathExpression,
-		Plan:           req.Plan,
-		PlanValue:      planValue,
-		Private:        req.Private,
-		State:          req.State,
-		StateValue:     stateValue,
+		Attribute:         attribute,
+		Config:            req.Config,
+		ConfigValue:       configValue,
+		ConfigValueSource: configValueSource(ctx, attribute, configValue),
+		Path:              req.AttributePath,
+		PathExpression:    req.AttributePathExpression,
+		Plan:              req.Plan,
+		PlanValue:         planValue,
+		Private:           req.Private,
+		State:             req.State,
+		StateValue:        stateValue,
 	}
 
 	for _, planModifier := range attribute.SetPlanModifiers() {
@@ -2244,7 +2287,9 @@ func AttributePlanModifySet(ctx context.Context, attribute fwxschema.AttributeWi
 			},
 		)
 
-		planModifier.PlanModifySet(ctx, planModifyReq, planModifyResp)
+		spanCtx, span := tracing.Start(ctx, "planmodifier.Set", tracing.AttributePath(planModifyReq.Path), tracing.Description(ctx, planModifier))
+		planModifier.PlanModifySet(spanCtx, planModifyReq, planModifyResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -2377,15 +2422,17 @@ func AttributePlanModifyString(ctx context.Context, attribute fwxschema.Attribut
 	}
 
 	planModifyReq := planmodifier.StringRequest{
-		Config:         req.Config,
-		ConfigValue:    configValue,
-		Path:           req.AttributePath,
-		PathExpression: req.AttributePathExpression,
-		Plan:           req.Plan,
-		PlanValue:      planValue,
-		Private:        req.Private,
-		State:          req.State,
-		StateValue:     stateValue,
+		Attribute:         attribute,
+		Config:            req.Config,
+		ConfigValue:       configValue,
+		ConfigValueSource: configValueSource(ctx, attribute, configValue),
+		Path:              req.AttributePath,
+		PathExpression:    req.AttributePathExpression,
+		Plan:              req.Plan,
+		PlanValue:         planValue,
+		Private:           req.Private,
+		State:             req.State,
+		StateValue:        stateValue,
 	}
 
 	for _, planModifier := range attribute.StringPlanModifiers() {
@@ -2404,7 +2451,9 @@ func AttributePlanModifyString(ctx context.Context, attribute fwxschema.Attribut
 			},
 		)
 
-		planModifier.PlanModifyString(ctx, planModifyReq, planModifyResp)
+		spanCtx, span := tracing.Start(ctx, "planmodifier.String", tracing.AttributePath(planModifyReq.Path), tracing.Description(ctx, planModifier))
+		planModifier.PlanModifyString(spanCtx, planModifyReq, planModifyResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -2537,15 +2586,17 @@ func AttributePlanModifyDynamic(ctx context.Context, attribute fwxschema.Attribu
 	}
 
 	planModifyReq := planmodifier.DynamicRequest{
-		Config:         req.Config,
-		ConfigValue:    configValue,
-		Path:           req.AttributePath,
-		PathExpression: req.AttributePathExpression,
-		Plan:           req.Plan,
-		PlanValue:      planValue,
-		Private:        req.Private,
-		State:          req.State,
-		StateValue:     stateValue,
+		Attribute:         attribute,
+		Config:            req.Config,
+		ConfigValue:       configValue,
+		ConfigValueSource: configValueSource(ctx, attribute, configValue),
+		Path:              req.AttributePath,
+		PathExpression:    req.AttributePathExpression,
+		Plan:              req.Plan,
+		PlanValue:         planValue,
+		Private:           req.Private,
+		State:             req.State,
+		StateValue:        stateValue,
 	}
 
 	for _, planModifier := range attribute.DynamicPlanModifiers() {
@@ -2564,7 +2615,9 @@ func AttributePlanModifyDynamic(ctx context.Context, attribute fwxschema.Attribu
 			},
 		)
 
-		planModifier.PlanModifyDynamic(ctx, planModifyReq, planModifyResp)
+		spanCtx, span := tracing.Start(ctx, "planmodifier.Dynamic", tracing.AttributePath(planModifyReq.Path), tracing.Description(ctx, planModifier))
+		planModifier.PlanModifyDynamic(spanCtx, planModifyReq, planModifyResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -2623,7 +2676,9 @@ func NestedAttributeObjectPlanModify(ctx context.Context, o fwschema.NestedAttri
 				},
 			)
 
-			objectPlanModifier.PlanModifyObject(ctx, req, planModifyResp)
+			spanCtx, span := tracing.Start(ctx, "planmodifier.Object", tracing.AttributePath(req.Path), tracing.Description(ctx, objectPlanModifier))
+			objectPlanModifier.PlanModifyObject(spanCtx, req, planModifyResp)
+			span.End()
 
 			logging.FrameworkTrace(
 				ctx,
@@ -2662,7 +2717,11 @@ func NestedAttributeObjectPlanModify(ctx context.Context, o fwschema.NestedAttri
 
 	newPlanValueAttributes := req.PlanValue.Attributes()
 
-	for nestedName, nestedAttr := range o.GetAttributes() {
+	nestedAttributes := o.GetAttributes()
+
+	for _, nestedName := range fwschema.SortedNames(nestedAttributes) {
+		nestedAttr := nestedAttributes[nestedName]
+
 		nestedAttrConfig, diags := objectAttributeValue(ctx, req.ConfigValue, nestedName, fwschemadata.DataDescriptionConfiguration)
 
 		resp.Diagnostics.Append(diags...)
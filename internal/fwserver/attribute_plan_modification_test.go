@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -1506,6 +1507,114 @@ func TestAttributeModifyPlan(t *testing.T) {
 				Private: testProviderData,
 			},
 		},
+		"attribute-set-nested-nested-diagnostic-path": {
+			attribute: testschema.NestedAttributeWithSetPlanModifiers{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"nested_attr": testschema.AttributeWithStringPlanModifiers{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								testplanmodifier.String{
+									PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+										resp.Diagnostics.AddAttributeWarning(req.Path, "Warning Summary", "Warning Detail")
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: true,
+			},
+			req: ModifyAttributePlanRequest{
+				AttributeConfig: types.SetValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_attr": types.StringType,
+						},
+					},
+					[]attr.Value{
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_attr": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_attr": types.StringValue("testvalue"),
+							},
+						),
+					},
+				),
+				AttributePath: path.Root("test"),
+				AttributePlan: types.SetValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_attr": types.StringType,
+						},
+					},
+					[]attr.Value{
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_attr": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_attr": types.StringValue("testvalue"),
+							},
+						),
+					},
+				),
+				AttributeState: types.SetValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_attr": types.StringType,
+						},
+					},
+					[]attr.Value{
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_attr": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_attr": types.StringValue("testvalue"),
+							},
+						),
+					},
+				),
+			},
+			expectedResp: ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_attr": types.StringType,
+						},
+					},
+					[]attr.Value{
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_attr": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_attr": types.StringValue("testvalue"),
+							},
+						),
+					},
+				),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test").AtSetValue(
+							types.ObjectValueMust(
+								map[string]attr.Type{
+									"nested_attr": types.StringType,
+								},
+								map[string]attr.Value{
+									"nested_attr": types.StringValue("testvalue"),
+								},
+							),
+						).AtName("nested_attr"),
+						"Warning Summary",
+						"Warning Detail",
+					),
+				},
+			},
+		},
 		"attribute-set-nested-usestateforunknown": {
 			attribute: testschema.NestedAttributeWithSetPlanModifiers{
 				NestedObject: testschema.NestedAttributeObject{
@@ -10651,6 +10760,186 @@ func TestAttributePlanModifyString(t *testing.T) {
 				AttributePlan: types.StringValue("testvalue"),
 			},
 		},
+		"request-attribute": {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							if req.Attribute == nil || !req.Attribute.IsComputed() || !req.Attribute.IsOptional() || req.Attribute.IsRequired() {
+								resp.Diagnostics.AddError(
+									"Unexpected StringRequest.Attribute",
+									fmt.Sprintf("expected Computed and Optional attribute, got: %#v", req.Attribute),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringValue("testvalue"),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("testvalue"),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("testvalue"),
+			},
+		},
+		"request-configvaluesource-config": {
+			attribute: schema.StringAttribute{
+				Computed: true,
+				Default:  stringdefault.StaticString("testvalue"),
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							if req.ConfigValueSource != planmodifier.ValueSourceConfig {
+								resp.Diagnostics.AddError(
+									"Unexpected StringRequest.ConfigValueSource",
+									fmt.Sprintf("expected %s, got: %s", planmodifier.ValueSourceConfig, req.ConfigValueSource),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringNull(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("testvalue"),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("testvalue"),
+			},
+		},
+		"request-configvaluesource-config-unknown": {
+			attribute: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							if req.ConfigValueSource != planmodifier.ValueSourceConfig {
+								resp.Diagnostics.AddError(
+									"Unexpected StringRequest.ConfigValueSource",
+									fmt.Sprintf("expected %s, got: %s", planmodifier.ValueSourceConfig, req.ConfigValueSource),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringUnknown(),
+				AttributePlan:   types.StringUnknown(),
+				AttributeState:  types.StringNull(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringUnknown(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringUnknown(),
+			},
+		},
+		"request-configvaluesource-default": {
+			attribute: schema.StringAttribute{
+				Computed: true,
+				Default:  stringdefault.StaticString("testvalue"),
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							if req.ConfigValueSource != planmodifier.ValueSourceDefault {
+								resp.Diagnostics.AddError(
+									"Unexpected StringRequest.ConfigValueSource",
+									fmt.Sprintf("expected %s, got: %s", planmodifier.ValueSourceDefault, req.ConfigValueSource),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringNull(),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringNull(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("testvalue"),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("testvalue"),
+			},
+		},
+		"request-configvaluesource-computed": {
+			attribute: schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							if req.ConfigValueSource != planmodifier.ValueSourceComputed {
+								resp.Diagnostics.AddError(
+									"Unexpected StringRequest.ConfigValueSource",
+									fmt.Sprintf("expected %s, got: %s", planmodifier.ValueSourceComputed, req.ConfigValueSource),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringNull(),
+				AttributePlan:   types.StringUnknown(),
+				AttributeState:  types.StringNull(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringUnknown(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringUnknown(),
+			},
+		},
+		"request-configvaluesource-null": {
+			attribute: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							if req.ConfigValueSource != planmodifier.ValueSourceNull {
+								resp.Diagnostics.AddError(
+									"Unexpected StringRequest.ConfigValueSource",
+									fmt.Sprintf("expected %s, got: %s", planmodifier.ValueSourceNull, req.ConfigValueSource),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringNull(),
+				AttributePlan:   types.StringNull(),
+				AttributeState:  types.StringNull(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringNull(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringNull(),
+			},
+		},
 		"request-pathexpression": {
 			attribute: testschema.AttributeWithStringPlanModifiers{
 				PlanModifiers: []planmodifier.String{
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -108,6 +109,12 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 
 	req.AttributeConfig = attributeConfig
 
+	if !AttributeValidateUnknownPolicy(ctx, a, req, resp) {
+		AttributeValidateNestedAttributes(ctx, a, req, resp)
+
+		return
+	}
+
 	switch attributeWithValidators := a.(type) {
 	case fwxschema.AttributeWithBoolValidators:
 		AttributeValidateBool(ctx, attributeWithValidators, req, resp)
@@ -168,6 +175,50 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 	}
 }
 
+// AttributeValidateUnknownPolicy applies the validators unknown policy of the
+// attribute, if it implements fwxschema.AttributeWithValidatorsUnknownPolicy.
+// It returns false when the attribute validators should not be called.
+func AttributeValidateUnknownPolicy(ctx context.Context, a fwschema.Attribute, req ValidateAttributeRequest, resp *ValidateAttributeResponse) bool {
+	attributeWithPolicy, ok := a.(fwxschema.AttributeWithValidatorsUnknownPolicy)
+
+	if !ok || attributeWithPolicy.GetValidatorsUnknownPolicy() == validator.UnknownPolicyDefault {
+		return true
+	}
+
+	unknown := req.AttributeConfig.IsUnknown()
+
+	// Dynamic values can be known when only the type is known.
+	if dynamicValuable, ok := req.AttributeConfig.(basetypes.DynamicValuable); ok && !unknown {
+		dynamicConfigVal, diags := dynamicValuable.ToDynamicValue(ctx)
+
+		if !diags.HasError() {
+			unknown = dynamicConfigVal.IsUnderlyingValueUnknown()
+		}
+	}
+
+	if !unknown {
+		return true
+	}
+
+	switch attributeWithPolicy.GetValidatorsUnknownPolicy() {
+	case validator.UnknownPolicySkipWhenUnknown:
+		logging.FrameworkTrace(ctx, "Skipping attribute validators for unknown configuration value")
+	case validator.UnknownPolicyErrorWhenUnknown:
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Unknown Configuration Value",
+			"The provider requires this attribute value to be known when planning, however it is unknown. "+
+				"The value may reference a resource attribute which is only known after apply.\n\n"+
+				"Use a value which is known during planning, or apply the resources it depends on first, "+
+				"such as with the -target flag, and then apply the configuration again.",
+		)
+	default:
+		return true
+	}
+
+	return false
+}
+
 // AttributeValidateBool performs all types.Bool validation.
 func AttributeValidateBool(ctx context.Context, attribute fwxschema.AttributeWithBoolValidators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
 	// Use basetypes.BoolValuable until custom types cannot re-implement
@@ -200,6 +251,7 @@ func AttributeValidateBool(ctx context.Context, attribute fwxschema.AttributeWit
 	}
 
 	validateReq := validator.BoolRequest{
+		Attribute:      attribute,
 		Config:         req.Config,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
@@ -219,7 +271,9 @@ func AttributeValidateBool(ctx context.Context, attribute fwxschema.AttributeWit
 			},
 		)
 
-		attributeValidator.ValidateBool(ctx, validateReq, validateResp)
+		spanCtx, span := tracing.Start(ctx, "validator.Bool", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, attributeValidator))
+		attributeValidator.ValidateBool(spanCtx, validateReq, validateResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -265,6 +319,7 @@ func AttributeValidateFloat32(ctx context.Context, attribute fwxschema.Attribute
 	}
 
 	validateReq := validator.Float32Request{
+		Attribute:      attribute,
 		Config:         req.Config,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
@@ -284,7 +339,9 @@ func AttributeValidateFloat32(ctx context.Context, attribute fwxschema.Attribute
 			},
 		)
 
-		attributeValidator.ValidateFloat32(ctx, validateReq, validateResp)
+		spanCtx, span := tracing.Start(ctx, "validator.Float32", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, attributeValidator))
+		attributeValidator.ValidateFloat32(spanCtx, validateReq, validateResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -330,6 +387,7 @@ func AttributeValidateFloat64(ctx context.Context, attribute fwxschema.Attribute
 	}
 
 	validateReq := validator.Float64Request{
+		Attribute:      attribute,
 		Config:         req.Config,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
@@ -349,7 +407,9 @@ func AttributeValidateFloat64(ctx context.Context, attribute fwxschema.Attribute
 			},
 		)
 
-		attributeValidator.ValidateFloat64(ctx, validateReq, validateResp)
+		spanCtx, span := tracing.Start(ctx, "validator.Float64", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, attributeValidator))
+		attributeValidator.ValidateFloat64(spanCtx, validateReq, validateResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -395,6 +455,7 @@ func AttributeValidateInt32(ctx context.Context, attribute fwxschema.AttributeWi
 	}
 
 	validateReq := validator.Int32Request{
+		Attribute:      attribute,
 		Config:         req.Config,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
@@ -414,7 +475,9 @@ func AttributeValidateInt32(ctx context.Context, attribute fwxschema.AttributeWi
 			},
 		)
 
-		attributeValidator.ValidateInt32(ctx, validateReq, validateResp)
+		spanCtx, span := tracing.Start(ctx, "validator.Int32", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, attributeValidator))
+		attributeValidator.ValidateInt32(spanCtx, validateReq, validateResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -460,6 +523,7 @@ func AttributeValidateInt64(ctx context.Context, attribute fwxschema.AttributeWi
 	}
 
 	validateReq := validator.Int64Request{
+		Attribute:      attribute,
 		Config:         req.Config,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
@@ -479,7 +543,9 @@ func AttributeValidateInt64(ctx context.Context, attribute fwxschema.AttributeWi
 			},
 		)
 
-		attributeValidator.ValidateInt64(ctx, validateReq, validateResp)
+		spanCtx, span := tracing.Start(ctx, "validator.Int64", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, attributeValidator))
+		attributeValidator.ValidateInt64(spanCtx, validateReq, validateResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -525,6 +591,7 @@ func AttributeValidateList(ctx context.Context, attribute fwxschema.AttributeWit
 	}
 
 	validateReq := validator.ListRequest{
+		Attribute:      attribute,
 		Config:         req.Config,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
@@ -544,7 +611,9 @@ func AttributeValidateList(ctx context.Context, attribute fwxschema.AttributeWit
 			},
 		)
 
-		attributeValidator.ValidateList(ctx, validateReq, validateResp)
+		spanCtx, span := tracing.Start(ctx, "validator.List", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, attributeValidator))
+		attributeValidator.ValidateList(spanCtx, validateReq, validateResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -590,6 +659,7 @@ func AttributeValidateMap(ctx context.Context, attribute fwxschema.AttributeWith
 	}
 
 	validateReq := validator.MapRequest{
+		Attribute:      attribute,
 		Config:         req.Config,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
@@ -609,7 +679,9 @@ func AttributeValidateMap(ctx context.Context, attribute fwxschema.AttributeWith
 			},
 		)
 
-		attributeValidator.ValidateMap(ctx, validateReq, validateResp)
+		spanCtx, span := tracing.Start(ctx, "validator.Map", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, attributeValidator))
+		attributeValidator.ValidateMap(spanCtx, validateReq, validateResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -655,6 +727,7 @@ func AttributeValidateNumber(ctx context.Context, attribute fwxschema.AttributeW
 	}
 
 	validateReq := validator.NumberRequest{
+		Attribute:      attribute,
 		Config:         req.Config,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
@@ -674,7 +747,9 @@ func AttributeValidateNumber(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
-		attributeValidator.ValidateNumber(ctx, validateReq, validateResp)
+		spanCtx, span := tracing.Start(ctx, "validator.Number", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, attributeValidator))
+		attributeValidator.ValidateNumber(spanCtx, validateReq, validateResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -720,6 +795,7 @@ func AttributeValidateObject(ctx context.Context, attribute fwxschema.AttributeW
 	}
 
 	validateReq := validator.ObjectRequest{
+		Attribute:      attribute,
 		Config:         req.Config,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
@@ -739,7 +815,9 @@ func AttributeValidateObject(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
-		attributeValidator.ValidateObject(ctx, validateReq, validateResp)
+		spanCtx, span := tracing.Start(ctx, "validator.Object", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, attributeValidator))
+		attributeValidator.ValidateObject(spanCtx, validateReq, validateResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -785,6 +863,7 @@ func AttributeValidateSet(ctx context.Context, attribute fwxschema.AttributeWith
 	}
 
 	validateReq := validator.SetRequest{
+		Attribute:      attribute,
 		Config:         req.Config,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
@@ -804,7 +883,9 @@ func AttributeValidateSet(ctx context.Context, attribute fwxschema.AttributeWith
 			},
 		)
 
-		attributeValidator.ValidateSet(ctx, validateReq, validateResp)
+		spanCtx, span := tracing.Start(ctx, "validator.Set", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, attributeValidator))
+		attributeValidator.ValidateSet(spanCtx, validateReq, validateResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -850,6 +931,7 @@ func AttributeValidateString(ctx context.Context, attribute fwxschema.AttributeW
 	}
 
 	validateReq := validator.StringRequest{
+		Attribute:      attribute,
 		Config:         req.Config,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
@@ -869,7 +951,9 @@ func AttributeValidateString(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
-		attributeValidator.ValidateString(ctx, validateReq, validateResp)
+		spanCtx, span := tracing.Start(ctx, "validator.String", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, attributeValidator))
+		attributeValidator.ValidateString(spanCtx, validateReq, validateResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -915,6 +999,7 @@ func AttributeValidateDynamic(ctx context.Context, attribute fwxschema.Attribute
 	}
 
 	validateReq := validator.DynamicRequest{
+		Attribute:      attribute,
 		Config:         req.Config,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
@@ -934,7 +1019,9 @@ func AttributeValidateDynamic(ctx context.Context, attribute fwxschema.Attribute
 			},
 		)
 
-		attributeValidator.ValidateDynamic(ctx, validateReq, validateResp)
+		spanCtx, span := tracing.Start(ctx, "validator.Dynamic", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, attributeValidator))
+		attributeValidator.ValidateDynamic(spanCtx, validateReq, validateResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -1163,7 +1250,9 @@ func NestedAttributeObjectValidate(ctx context.Context, o fwschema.NestedAttribu
 				},
 			)
 
-			objectValidator.ValidateObject(ctx, validateReq, validateResp)
+			spanCtx, span := tracing.Start(ctx, "validator.Object", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, objectValidator))
+			objectValidator.ValidateObject(spanCtx, validateReq, validateResp)
+			span.End()
 
 			logging.FrameworkTrace(
 				ctx,
@@ -1177,7 +1266,11 @@ func NestedAttributeObjectValidate(ctx context.Context, o fwschema.NestedAttribu
 		}
 	}
 
-	for nestedName, nestedAttr := range o.GetAttributes() {
+	nestedAttributes := o.GetAttributes()
+
+	for _, nestedName := range fwschema.SortedNames(nestedAttributes) {
+		nestedAttr := nestedAttributes[nestedName]
+
 		nestedAttrReq := ValidateAttributeRequest{
 			AttributePath:           req.AttributePath.AtName(nestedName),
 			AttributePathExpression: req.AttributePathExpression.AtName(nestedName),
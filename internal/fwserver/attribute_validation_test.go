@@ -592,6 +592,111 @@ func TestAttributeValidate(t *testing.T) {
 				},
 			},
 		},
+		"validators-unknown-policy-default": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.AttributeWithStringValidators{
+								Required: true,
+								Validators: []validator.String{
+									testvalidator.String{
+										ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+											resp.Diagnostics.Append(testErrorDiagnostic1)
+										},
+									},
+								},
+								ValidatorsUnknownPolicy: validator.UnknownPolicyDefault,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					testErrorDiagnostic1,
+				},
+			},
+		},
+		"validators-unknown-policy-skip-when-unknown": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.AttributeWithStringValidators{
+								Required: true,
+								Validators: []validator.String{
+									testvalidator.String{
+										ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+											resp.Diagnostics.Append(testErrorDiagnostic1)
+										},
+									},
+								},
+								ValidatorsUnknownPolicy: validator.UnknownPolicySkipWhenUnknown,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{},
+		},
+		"validators-unknown-policy-error-when-unknown": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.AttributeWithStringValidators{
+								Required: true,
+								Validators: []validator.String{
+									testvalidator.String{
+										ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+											resp.Diagnostics.Append(testErrorDiagnostic1)
+										},
+									},
+								},
+								ValidatorsUnknownPolicy: validator.UnknownPolicyErrorWhenUnknown,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Unknown Configuration Value",
+						"The provider requires this attribute value to be known when planning, however it is unknown. "+
+							"The value may reference a resource attribute which is only known after apply.\n\n"+
+							"Use a value which is known during planning, or apply the resources it depends on first, "+
+							"such as with the -target flag, and then apply the configuration again.",
+					),
+				},
+			},
+		},
 		"type-with-validate-error": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
@@ -1401,6 +1506,89 @@ func TestAttributeValidate(t *testing.T) {
 				},
 			},
 		},
+		"nested-attr-set-validation-path": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Set{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.Set{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "testvalue"),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.NestedAttribute{
+								NestedObject: testschema.NestedAttributeObject{
+									Attributes: map[string]fwschema.Attribute{
+										"nested_attr": testschema.AttributeWithStringValidators{
+											Required: true,
+											Validators: []validator.String{
+												testvalidator.String{
+													ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+														resp.Diagnostics.AddAttributeError(req.Path, "Error Summary", "Error Detail")
+													},
+												},
+											},
+										},
+									},
+								},
+								NestingMode: fwschema.NestingModeSet,
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtSetValue(
+							types.ObjectValueMust(
+								map[string]attr.Type{
+									"nested_attr": types.StringType,
+								},
+								map[string]attr.Value{
+									"nested_attr": types.StringValue("testvalue"),
+								},
+							),
+						).AtName("nested_attr"),
+						"Error Summary",
+						"Error Detail",
+					),
+				},
+			},
+		},
 		"nested-custom-attr-set-validation": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
@@ -3906,6 +4094,29 @@ func TestAttributeValidateString(t *testing.T) {
 			response: &ValidateAttributeResponse{},
 			expected: &ValidateAttributeResponse{},
 		},
+		"request-attribute": {
+			attribute: testschema.AttributeWithStringValidators{
+				Required: true,
+				Validators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							if req.Attribute == nil || !req.Attribute.IsRequired() || req.Attribute.IsComputed() {
+								resp.Diagnostics.AddError(
+									"Unexpected StringRequest.Attribute",
+									fmt.Sprintf("expected Required attribute, got: %#v", req.Attribute),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringValue("test"),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
 		"request-pathexpression": {
 			attribute: testschema.AttributeWithStringValidators{
 				Validators: []validator.String{
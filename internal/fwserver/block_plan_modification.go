@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -138,15 +139,16 @@ func BlockModifyPlan(ctx context.Context, b fwschema.Block, req ModifyAttributeP
 			}
 
 			objectReq := planmodifier.ObjectRequest{
-				Config:         req.Config,
-				ConfigValue:    configObject,
-				Path:           attrPath,
-				PathExpression: attrPath.Expression(),
-				Plan:           req.Plan,
-				PlanValue:      planObject,
-				Private:        resp.Private,
-				State:          req.State,
-				StateValue:     stateObject,
+				Config:            req.Config,
+				ConfigValue:       configObject,
+				ConfigValueSource: configValueSource(ctx, nil, configObject),
+				Path:              attrPath,
+				PathExpression:    attrPath.Expression(),
+				Plan:              req.Plan,
+				PlanValue:         planObject,
+				Private:           resp.Private,
+				State:             req.State,
+				StateValue:        stateObject,
 			}
 			objectResp := &ModifyAttributePlanResponse{
 				AttributePlan: objectReq.PlanValue,
@@ -289,15 +291,16 @@ func BlockModifyPlan(ctx context.Context, b fwschema.Block, req ModifyAttributeP
 			}
 
 			objectReq := planmodifier.ObjectRequest{
-				Config:         req.Config,
-				ConfigValue:    configObject,
-				Path:           attrPath,
-				PathExpression: attrPath.Expression(),
-				Plan:           req.Plan,
-				PlanValue:      planObject,
-				Private:        resp.Private,
-				State:          req.State,
-				StateValue:     stateObject,
+				Config:            req.Config,
+				ConfigValue:       configObject,
+				ConfigValueSource: configValueSource(ctx, nil, configObject),
+				Path:              attrPath,
+				PathExpression:    attrPath.Expression(),
+				Plan:              req.Plan,
+				PlanValue:         planObject,
+				Private:           resp.Private,
+				State:             req.State,
+				StateValue:        stateObject,
 			}
 			objectResp := &ModifyAttributePlanResponse{
 				AttributePlan: objectReq.PlanValue,
@@ -395,15 +398,16 @@ func BlockModifyPlan(ctx context.Context, b fwschema.Block, req ModifyAttributeP
 		}
 
 		objectReq := planmodifier.ObjectRequest{
-			Config:         req.Config,
-			ConfigValue:    configObject,
-			Path:           req.AttributePath,
-			PathExpression: req.AttributePathExpression,
-			Plan:           req.Plan,
-			PlanValue:      planObject,
-			Private:        resp.Private,
-			State:          req.State,
-			StateValue:     stateObject,
+			Config:            req.Config,
+			ConfigValue:       configObject,
+			ConfigValueSource: configValueSource(ctx, nil, configObject),
+			Path:              req.AttributePath,
+			PathExpression:    req.AttributePathExpression,
+			Plan:              req.Plan,
+			PlanValue:         planObject,
+			Private:           resp.Private,
+			State:             req.State,
+			StateValue:        stateObject,
 		}
 		objectResp := &ModifyAttributePlanResponse{
 			AttributePlan: objectReq.PlanValue,
@@ -540,15 +544,16 @@ func BlockPlanModifyList(ctx context.Context, block fwxschema.BlockWithListPlanM
 	}
 
 	planModifyReq := planmodifier.ListRequest{
-		Config:         req.Config,
-		ConfigValue:    configValue,
-		Path:           req.AttributePath,
-		PathExpression: req.AttributePathExpression,
-		Plan:           req.Plan,
-		PlanValue:      planValue,
-		Private:        req.Private,
-		State:          req.State,
-		StateValue:     stateValue,
+		Config:            req.Config,
+		ConfigValue:       configValue,
+		ConfigValueSource: configValueSource(ctx, nil, configValue),
+		Path:              req.AttributePath,
+		PathExpression:    req.AttributePathExpression,
+		Plan:              req.Plan,
+		PlanValue:         planValue,
+		Private:           req.Private,
+		State:             req.State,
+		StateValue:        stateValue,
 	}
 
 	for _, planModifier := range block.ListPlanModifiers() {
@@ -567,7 +572,9 @@ func BlockPlanModifyList(ctx context.Context, block fwxschema.BlockWithListPlanM
 			},
 		)
 
-		planModifier.PlanModifyList(ctx, planModifyReq, planModifyResp)
+		spanCtx, span := tracing.Start(ctx, "planmodifier.List", tracing.AttributePath(planModifyReq.Path), tracing.Description(ctx, planModifier))
+		planModifier.PlanModifyList(spanCtx, planModifyReq, planModifyResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -700,15 +707,16 @@ func BlockPlanModifyObject(ctx context.Context, block fwxschema.BlockWithObjectP
 	}
 
 	planModifyReq := planmodifier.ObjectRequest{
-		Config:         req.Config,
-		ConfigValue:    configValue,
-		Path:           req.AttributePath,
-		PathExpression: req.AttributePathExpression,
-		Plan:           req.Plan,
-		PlanValue:      planValue,
-		Private:        req.Private,
-		State:          req.State,
-		StateValue:     stateValue,
+		Config:            req.Config,
+		ConfigValue:       configValue,
+		ConfigValueSource: configValueSource(ctx, nil, configValue),
+		Path:              req.AttributePath,
+		PathExpression:    req.AttributePathExpression,
+		Plan:              req.Plan,
+		PlanValue:         planValue,
+		Private:           req.Private,
+		State:             req.State,
+		StateValue:        stateValue,
 	}
 
 	for _, planModifier := range block.ObjectPlanModifiers() {
@@ -727,7 +735,9 @@ func BlockPlanModifyObject(ctx context.Context, block fwxschema.BlockWithObjectP
 			},
 		)
 
-		planModifier.PlanModifyObject(ctx, planModifyReq, planModifyResp)
+		spanCtx, span := tracing.Start(ctx, "planmodifier.Object", tracing.AttributePath(planModifyReq.Path), tracing.Description(ctx, planModifier))
+		planModifier.PlanModifyObject(spanCtx, planModifyReq, planModifyResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -860,15 +870,16 @@ func BlockPlanModifySet(ctx context.Context, block fwxschema.BlockWithSetPlanMod
 	}
 
 	planModifyReq := planmodifier.SetRequest{
-		Config:         req.Config,
-		ConfigValue:    configValue,
-		Path:           req.AttributePath,
-		PathExpression: req.AttributePathExpression,
-		Plan:           req.Plan,
-		PlanValue:      planValue,
-		Private:        req.Private,
-		State:          req.State,
-		StateValue:     stateValue,
+		Config:            req.Config,
+		ConfigValue:       configValue,
+		ConfigValueSource: configValueSource(ctx, nil, configValue),
+		Path:              req.AttributePath,
+		PathExpression:    req.AttributePathExpression,
+		Plan:              req.Plan,
+		PlanValue:         planValue,
+		Private:           req.Private,
+		State:             req.State,
+		StateValue:        stateValue,
 	}
 
 	for _, planModifier := range block.SetPlanModifiers() {
@@ -887,7 +898,9 @@ func BlockPlanModifySet(ctx context.Context, block fwxschema.BlockWithSetPlanMod
 			},
 		)
 
-		planModifier.PlanModifySet(ctx, planModifyReq, planModifyResp)
+		spanCtx, span := tracing.Start(ctx, "planmodifier.Set", tracing.AttributePath(planModifyReq.Path), tracing.Description(ctx, planModifier))
+		planModifier.PlanModifySet(spanCtx, planModifyReq, planModifyResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -946,7 +959,9 @@ func NestedBlockObjectPlanModify(ctx context.Context, o fwschema.NestedBlockObje
 				},
 			)
 
-			objectPlanModifier.PlanModifyObject(ctx, req, planModifyResp)
+			spanCtx, span := tracing.Start(ctx, "planmodifier.Object", tracing.AttributePath(req.Path), tracing.Description(ctx, objectPlanModifier))
+			objectPlanModifier.PlanModifyObject(spanCtx, req, planModifyResp)
+			span.End()
 
 			logging.FrameworkTrace(
 				ctx,
@@ -974,7 +989,11 @@ func NestedBlockObjectPlanModify(ctx context.Context, o fwschema.NestedBlockObje
 
 	newPlanValueAttributes := req.PlanValue.Attributes()
 
-	for nestedName, nestedAttr := range o.GetAttributes() {
+	nestedAttributes := o.GetAttributes()
+
+	for _, nestedName := range fwschema.SortedNames(nestedAttributes) {
+		nestedAttr := nestedAttributes[nestedName]
+
 		nestedAttrConfig, diags := objectAttributeValue(ctx, req.ConfigValue, nestedName, fwschemadata.DataDescriptionConfiguration)
 
 		resp.Diagnostics.Append(diags...)
@@ -1024,7 +1043,11 @@ func NestedBlockObjectPlanModify(ctx context.Context, o fwschema.NestedBlockObje
 		resp.RequiresReplace.Append(nestedAttrResp.RequiresReplace...)
 	}
 
-	for nestedName, nestedBlock := range o.GetBlocks() {
+	nestedBlocks := o.GetBlocks()
+
+	for _, nestedName := range fwschema.SortedNames(nestedBlocks) {
+		nestedBlock := nestedBlocks[nestedName]
+
 		nestedBlockConfig, diags := objectAttributeValue(ctx, req.ConfigValue, nestedName, fwschemadata.DataDescriptionConfiguration)
 
 		resp.Diagnostics.Append(diags...)
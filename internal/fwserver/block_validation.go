@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -222,7 +223,9 @@ func BlockValidateList(ctx context.Context, block fwxschema.BlockWithListValidat
 			},
 		)
 
-		blockValidator.ValidateList(ctx, validateReq, validateResp)
+		spanCtx, span := tracing.Start(ctx, "validator.List", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, blockValidator))
+		blockValidator.ValidateList(spanCtx, validateReq, validateResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -287,7 +290,9 @@ func BlockValidateObject(ctx context.Context, block fwxschema.BlockWithObjectVal
 			},
 		)
 
-		blockValidator.ValidateObject(ctx, validateReq, validateResp)
+		spanCtx, span := tracing.Start(ctx, "validator.Object", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, blockValidator))
+		blockValidator.ValidateObject(spanCtx, validateReq, validateResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -352,7 +357,9 @@ func BlockValidateSet(ctx context.Context, block fwxschema.BlockWithSetValidator
 			},
 		)
 
-		blockValidator.ValidateSet(ctx, validateReq, validateResp)
+		spanCtx, span := tracing.Start(ctx, "validator.Set", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, blockValidator))
+		blockValidator.ValidateSet(spanCtx, validateReq, validateResp)
+		span.End()
 
 		logging.FrameworkTrace(
 			ctx,
@@ -414,7 +421,9 @@ func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject
 				},
 			)
 
-			objectValidator.ValidateObject(ctx, validateReq, validateResp)
+			spanCtx, span := tracing.Start(ctx, "validator.Object", tracing.AttributePath(validateReq.Path), tracing.Description(ctx, objectValidator))
+			objectValidator.ValidateObject(spanCtx, validateReq, validateResp)
+			span.End()
 
 			logging.FrameworkTrace(
 				ctx,
@@ -428,7 +437,11 @@ func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject
 		}
 	}
 
-	for nestedName, nestedAttr := range o.GetAttributes() {
+	nestedAttributes := o.GetAttributes()
+
+	for _, nestedName := range fwschema.SortedNames(nestedAttributes) {
+		nestedAttr := nestedAttributes[nestedName]
+
 		nestedAttrReq := ValidateAttributeRequest{
 			AttributePath:           req.AttributePath.AtName(nestedName),
 			AttributePathExpression: req.AttributePathExpression.AtName(nestedName),
@@ -441,7 +454,11 @@ func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject
 		resp.Diagnostics.Append(nestedAttrResp.Diagnostics...)
 	}
 
-	for nestedName, nestedBlock := range o.GetBlocks() {
+	nestedBlocks := o.GetBlocks()
+
+	for _, nestedName := range fwschema.SortedNames(nestedBlocks) {
+		nestedBlock := nestedBlocks[nestedName]
+
 		nestedBlockReq := ValidateAttributeRequest{
 			AttributePath:           req.AttributePath.AtName(nestedName),
 			AttributePathExpression: req.AttributePathExpression.AtName(nestedName),
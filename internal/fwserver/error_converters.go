@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/errorconverters"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// ErrorConverters returns the error converters, if the provider implements
+// provider.ProviderWithErrorConverters. The results are cached on first use.
+func (s *Server) ErrorConverters(ctx context.Context) []diag.ErrorConverter {
+	logging.FrameworkTrace(ctx, "Checking ErrorConverters lock")
+	s.errorConvertersMutex.Lock()
+	defer s.errorConvertersMutex.Unlock()
+
+	if s.errorConverters != nil {
+		return s.errorConverters
+	}
+
+	s.errorConverters = []diag.ErrorConverter{}
+
+	providerWithErrorConverters, ok := s.Provider.(provider.ProviderWithErrorConverters)
+
+	if !ok {
+		return s.errorConverters
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Provider ErrorConverters")
+	s.errorConverters = append(s.errorConverters, providerWithErrorConverters.ErrorConverters(ctx)...)
+	logging.FrameworkTrace(ctx, "Called provider defined Provider ErrorConverters")
+
+	return s.errorConverters
+}
+
+// errorConvertersContext returns a new Context with the error converters,
+// for use by error-returning resource handlers such as
+// resource.CreateWithError.
+func (s *Server) errorConvertersContext(ctx context.Context) context.Context {
+	return errorconverters.WithConverters(ctx, s.ErrorConverters(ctx))
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ResourceInterceptors returns the Resource interceptors, if the provider
+// implements provider.ProviderWithResourceInterceptors. The results are
+// cached on first use.
+func (s *Server) ResourceInterceptors(ctx context.Context) []resource.Interceptor {
+	logging.FrameworkTrace(ctx, "Checking ResourceInterceptors lock")
+	s.resourceInterceptorsMutex.Lock()
+	defer s.resourceInterceptorsMutex.Unlock()
+
+	if s.resourceInterceptors != nil {
+		return s.resourceInterceptors
+	}
+
+	s.resourceInterceptors = []resource.Interceptor{}
+
+	providerWithResourceInterceptors, ok := s.Provider.(provider.ProviderWithResourceInterceptors)
+
+	if !ok {
+		return s.resourceInterceptors
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Provider ResourceInterceptors")
+	s.resourceInterceptors = append(s.resourceInterceptors, providerWithResourceInterceptors.ResourceInterceptors(ctx)...)
+	logging.FrameworkTrace(ctx, "Called provider defined Provider ResourceInterceptors")
+
+	return s.resourceInterceptors
+}
+
+// interceptedCreateFunc returns the given Create method wrapped by any
+// interceptors with a Create function. The first interceptor is outermost.
+func interceptedCreateFunc(interceptors []resource.Interceptor, info resource.InterceptorInfo, create resource.CreateFunc) resource.CreateFunc {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		intercept := interceptors[i].Create
+
+		if intercept == nil {
+			continue
+		}
+
+		next := create
+
+		create = func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+			intercept(ctx, info, req, resp, next)
+		}
+	}
+
+	return create
+}
+
+// interceptedReadFunc returns the given Read method wrapped by any
+// interceptors with a Read function. The first interceptor is outermost.
+func interceptedReadFunc(interceptors []resource.Interceptor, info resource.InterceptorInfo, read resource.ReadFunc) resource.ReadFunc {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		intercept := interceptors[i].Read
+
+		if intercept == nil {
+			continue
+		}
+
+		next := read
+
+		read = func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+			intercept(ctx, info, req, resp, next)
+		}
+	}
+
+	return read
+}
+
+// interceptedUpdateFunc returns the given Update method wrapped by any
+// interceptors with an Update function. The first interceptor is outermost.
+func interceptedUpdateFunc(interceptors []resource.Interceptor, info resource.InterceptorInfo, update resource.UpdateFunc) resource.UpdateFunc {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		intercept := interceptors[i].Update
+
+		if intercept == nil {
+			continue
+		}
+
+		next := update
+
+		update = func(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+			intercept(ctx, info, req, resp, next)
+		}
+	}
+
+	return update
+}
+
+// interceptedDeleteFunc returns the given Delete method wrapped by any
+// interceptors with a Delete function. The first interceptor is outermost.
+func interceptedDeleteFunc(interceptors []resource.Interceptor, info resource.InterceptorInfo, deleteFunc resource.DeleteFunc) resource.DeleteFunc {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		intercept := interceptors[i].Delete
+
+		if intercept == nil {
+			continue
+		}
+
+		next := deleteFunc
+
+		deleteFunc = func(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+			intercept(ctx, info, req, resp, next)
+		}
+	}
+
+	return deleteFunc
+}
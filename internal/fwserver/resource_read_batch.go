@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// resourceReadBatch is a pending batch of ReadResource RPCs for a resource
+// type implementing resource.ResourceWithBatchRead.
+type resourceReadBatch struct {
+	// ctx is the context of the first RPC of the batch without its
+	// cancellation or deadline, as BatchRead serves every RPC of the batch.
+	ctx context.Context
+
+	// done is closed after BatchRead returns and the responses are final.
+	done chan struct{}
+
+	// maxSize is the BatchReadSettings MaxSize of the batch.
+	maxSize int
+
+	// resource is the resource of the first RPC of the batch.
+	resource resource.ResourceWithBatchRead
+
+	// timer reads the batch when the BatchReadSettings Window elapses.
+	timer *time.Timer
+
+	requests []resource.ReadRequest
+
+	// responses are owned by the batch rather than the RPCs, so an RPC
+	// which stops waiting does not share its response with BatchRead.
+	responses []*resource.ReadResponse
+}
+
+// batchedReadFunc returns a resource.ReadFunc which adds the request to the
+// pending batch of the resource type and waits until the batch is read or the
+// context is done. If the context is done first, an error diagnostic is
+// returned and the result of BatchRead for the request is discarded.
+func (s *Server) batchedReadFunc(typeName string, r resource.ResourceWithBatchRead) resource.ReadFunc {
+	return func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+		batch, batchResp := s.addResourceReadBatch(ctx, typeName, r, req, resp)
+
+		select {
+		case <-batch.done:
+			*resp = *batchResp
+		case <-ctx.Done():
+			logging.FrameworkDebug(ctx, "Context done while waiting for Resource BatchRead")
+
+			resp.Diagnostics.Append(batchReadContextDoneDiagnostic(ctx))
+		}
+	}
+}
+
+// batchReadContextDoneDiagnostic returns the error diagnostic for an RPC
+// whose context is done before its batch is read.
+func batchReadContextDoneDiagnostic(ctx context.Context) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Resource Read Interrupted",
+		"The resource read was interrupted while waiting for other reads of the resource type to be batched together. "+
+			"Terraform may have been canceled or the read may have exceeded its timeout.\n\n"+
+			"Error: "+ctx.Err().Error(),
+	)
+}
+
+// addResourceReadBatch adds the request to the pending batch of the resource
+// type, creating a new batch if there is none, and returns the batch and the
+// response for the request, which is populated from `resp` and owned by the
+// batch.
+func (s *Server) addResourceReadBatch(ctx context.Context, typeName string, r resource.ResourceWithBatchRead, req resource.ReadRequest, resp *resource.ReadResponse) (*resourceReadBatch, *resource.ReadResponse) {
+	s.resourceReadBatchesMutex.Lock()
+	defer s.resourceReadBatchesMutex.Unlock()
+
+	if s.resourceReadBatches == nil {
+		s.resourceReadBatches = make(map[string]*resourceReadBatch)
+	}
+
+	batch, ok := s.resourceReadBatches[typeName]
+
+	if !ok {
+		logging.FrameworkTrace(ctx, "Calling provider defined Resource BatchReadSettings")
+		settings := r.BatchReadSettings(ctx)
+		logging.FrameworkTrace(ctx, "Called provider defined Resource BatchReadSettings")
+
+		window := settings.Window
+
+		if window <= 0 {
+			window = resource.DefaultBatchReadWindow
+		}
+
+		batch = &resourceReadBatch{
+			ctx:      context.WithoutCancel(ctx),
+			done:     make(chan struct{}),
+			maxSize:  settings.MaxSize,
+			resource: r,
+		}
+
+		s.resourceReadBatches[typeName] = batch
+
+		batch.timer = time.AfterFunc(window, func() {
+			if s.removeResourceReadBatch(typeName, batch) {
+				s.readResourceReadBatch(batch)
+			}
+		})
+	}
+
+	batchResp := &resource.ReadResponse{
+		State:       resp.State,
+		Private:     resp.Private.Clone(),
+		Diagnostics: append(diag.Diagnostics(nil), resp.Diagnostics...),
+		Deferred:    resp.Deferred,
+	}
+
+	batch.requests = append(batch.requests, req)
+	batch.responses = append(batch.responses, batchResp)
+
+	if batch.maxSize > 0 && len(batch.requests) >= batch.maxSize && batch.timer.Stop() {
+		delete(s.resourceReadBatches, typeName)
+
+		go s.readResourceReadBatch(batch)
+	}
+
+	return batch, batchResp
+}
+
+// removeResourceReadBatch removes the batch from the pending batches, if it
+// is still pending, and returns true if it was removed.
+func (s *Server) removeResourceReadBatch(typeName string, batch *resourceReadBatch) bool {
+	s.resourceReadBatchesMutex.Lock()
+	defer s.resourceReadBatchesMutex.Unlock()
+
+	if s.resourceReadBatches[typeName] != batch {
+		return false
+	}
+
+	delete(s.resourceReadBatches, typeName)
+
+	return true
+}
+
+// readResourceReadBatch calls the BatchRead method with the requests of the
+// batch, which must no longer be pending, and releases the waiting RPCs.
+func (s *Server) readResourceReadBatch(batch *resourceReadBatch) {
+	defer close(batch.done)
+
+	batchReq := resource.BatchReadRequest{
+		Requests: batch.requests,
+	}
+	batchResp := &resource.BatchReadResponse{
+		Responses: batch.responses,
+	}
+
+	logging.FrameworkTrace(batch.ctx, "Calling provider defined Resource BatchRead")
+	spanCtx, span := tracing.Start(batch.ctx, "Resource BatchRead")
+	batch.resource.BatchRead(spanCtx, batchReq, batchResp)
+	span.End()
+	logging.FrameworkTrace(batch.ctx, "Called provider defined Resource BatchRead")
+
+	for _, resp := range batch.responses {
+		resp.Diagnostics.Append(batchResp.Diagnostics...)
+	}
+}
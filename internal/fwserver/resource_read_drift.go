@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// readDriftSensitiveValueString is the logged representation of sensitive
+// attribute values when read drift logging is enabled.
+const readDriftSensitiveValueString = "(sensitive)"
+
+// logResourceReadDrift emits a framework debug log for each attribute value
+// which differs between the prior state and the refreshed state, if read
+// drift logging is enabled. Values of sensitive attributes, or attributes
+// nested under sensitive attributes, are redacted.
+func logResourceReadDrift(ctx context.Context, schema fwschema.Schema, priorRaw tftypes.Value, newRaw tftypes.Value) {
+	if !logging.ReadDriftLogging(ctx) {
+		return
+	}
+
+	if !priorRaw.IsNull() && newRaw.IsNull() {
+		logging.FrameworkDebug(ctx, "Detected resource drift during Read: resource removed from state")
+
+		return
+	}
+
+	if priorRaw.IsNull() || !priorRaw.Type().Equal(newRaw.Type()) {
+		return
+	}
+
+	diffs, err := priorRaw.Diff(newRaw)
+
+	if err != nil {
+		logging.FrameworkDebug(ctx, "Unable to detect resource drift during Read", map[string]interface{}{logging.KeyError: err.Error()})
+
+		return
+	}
+
+	// Diff order depends on map iteration, so sort for consistent output.
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Path.String() < diffs[j].Path.String()
+	})
+
+	for _, diff := range diffs {
+		attribute, err := schema.AttributeAtTerraformPath(ctx, diff.Path)
+
+		// Differences of the root object, blocks, and collection elements
+		// are also reported by the attribute containing them.
+		if err != nil {
+			continue
+		}
+
+		// Differences of nested attributes are reported by the underlying
+		// attributes.
+		if _, ok := attribute.(fwschema.NestedAttribute); ok {
+			continue
+		}
+
+		attributePath := diff.Path.String()
+
+		if fwPath, diags := fromtftypes.AttributePath(ctx, diff.Path, schema); !diags.HasError() {
+			attributePath = fwPath.String()
+		}
+
+		priorValue := readDriftValueString(diff.Value1)
+		newValue := readDriftValueString(diff.Value2)
+
+		if readDriftSensitive(ctx, schema, diff.Path) {
+			priorValue = readDriftSensitiveValueString
+			newValue = readDriftSensitiveValueString
+		}
+
+		logging.FrameworkDebug(
+			ctx,
+			"Detected resource drift during Read",
+			map[string]interface{}{
+				logging.KeyAttributePath:       attributePath,
+				logging.KeyAttributePriorValue: priorValue,
+				logging.KeyAttributeValue:      newValue,
+			},
+		)
+	}
+}
+
+// readDriftSensitive returns true if the attribute at the given path, or any
+// parent attribute, is sensitive.
+func readDriftSensitive(ctx context.Context, schema fwschema.Schema, tfPath *tftypes.AttributePath) bool {
+	for current := tfPath; len(current.Steps()) > 0; current = current.WithoutLastStep() {
+		attribute, err := schema.AttributeAtTerraformPath(ctx, current)
+
+		// Paths to blocks and collection elements are not attributes.
+		if err != nil {
+			continue
+		}
+
+		if attribute.IsSensitive() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// readDriftValueString returns the logged representation of a value from a
+// tftypes.ValueDiff, which is nil if the value is not present.
+func readDriftValueString(value *tftypes.Value) string {
+	if value == nil {
+		return "{no value set}"
+	}
+
+	return value.String()
+}
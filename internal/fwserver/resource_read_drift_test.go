@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLogResourceReadDrift(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test_string": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"test_sensitive": testschema.Attribute{
+				Optional:  true,
+				Sensitive: true,
+				Type:      types.StringType,
+			},
+			"test_list": testschema.Attribute{
+				Optional: true,
+				Type:     types.ListType{ElemType: types.StringType},
+			},
+			"test_nested": testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"test_nested_string": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeSingle,
+				Optional:    true,
+			},
+		},
+	}
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_nested_string": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_string":    tftypes.String,
+			"test_sensitive": tftypes.String,
+			"test_list":      tftypes.List{ElementType: tftypes.String},
+			"test_nested":    testNestedType,
+		},
+	}
+
+	testValue := func(str, sensitive string, list []string, nested string) tftypes.Value {
+		listValues := make([]tftypes.Value, 0, len(list))
+
+		for _, element := range list {
+			listValues = append(listValues, tftypes.NewValue(tftypes.String, element))
+		}
+
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_string":    tftypes.NewValue(tftypes.String, str),
+			"test_sensitive": tftypes.NewValue(tftypes.String, sensitive),
+			"test_list":      tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, listValues),
+			"test_nested": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+				"test_nested_string": tftypes.NewValue(tftypes.String, nested),
+			}),
+		})
+	}
+
+	testCases := map[string]struct {
+		enabled  bool
+		priorRaw tftypes.Value
+		newRaw   tftypes.Value
+		expected []map[string]interface{}
+	}{
+		"disabled": {
+			priorRaw: testValue("prior", "prior", []string{"a"}, "prior"),
+			newRaw:   testValue("new", "new", []string{"b"}, "new"),
+		},
+		"no-drift": {
+			enabled:  true,
+			priorRaw: testValue("prior", "prior", []string{"a"}, "prior"),
+			newRaw:   testValue("prior", "prior", []string{"a"}, "prior"),
+		},
+		"drift": {
+			enabled:  true,
+			priorRaw: testValue("prior", "prior", []string{"a"}, "prior"),
+			newRaw:   testValue("new", "new", []string{"a", "b"}, "new"),
+			expected: []map[string]interface{}{
+				{
+					"@level":                   "debug",
+					"@message":                 "Detected resource drift during Read",
+					"@module":                  "sdk.framework",
+					"tf_attribute_path":        "test_list",
+					"tf_attribute_prior_value": `tftypes.List[tftypes.String]<tftypes.String<"a">>`,
+					"tf_attribute_value":       `tftypes.List[tftypes.String]<tftypes.String<"a">, tftypes.String<"b">>`,
+				},
+				{
+					"@level":                   "debug",
+					"@message":                 "Detected resource drift during Read",
+					"@module":                  "sdk.framework",
+					"tf_attribute_path":        "test_nested.test_nested_string",
+					"tf_attribute_prior_value": `tftypes.String<"prior">`,
+					"tf_attribute_value":       `tftypes.String<"new">`,
+				},
+				{
+					"@level":                   "debug",
+					"@message":                 "Detected resource drift during Read",
+					"@module":                  "sdk.framework",
+					"tf_attribute_path":        "test_sensitive",
+					"tf_attribute_prior_value": "(sensitive)",
+					"tf_attribute_value":       "(sensitive)",
+				},
+				{
+					"@level":                   "debug",
+					"@message":                 "Detected resource drift during Read",
+					"@module":                  "sdk.framework",
+					"tf_attribute_path":        "test_string",
+					"tf_attribute_prior_value": `tftypes.String<"prior">`,
+					"tf_attribute_value":       `tftypes.String<"new">`,
+				},
+			},
+		},
+		"removed": {
+			enabled:  true,
+			priorRaw: testValue("prior", "prior", []string{"a"}, "prior"),
+			newRaw:   tftypes.NewValue(testType, nil),
+			expected: []map[string]interface{}{
+				{
+					"@level":   "debug",
+					"@message": "Detected resource drift during Read: resource removed from state",
+					"@module":  "sdk.framework",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+
+			ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+			ctx = logging.InitContext(ctx)
+
+			if testCase.enabled {
+				ctx = logging.WithReadDriftLogging(ctx)
+			}
+
+			logResourceReadDrift(ctx, testSchema, testCase.priorRaw, testCase.newRaw)
+
+			entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+			if err != nil {
+				t.Fatalf("unable to read multiple line JSON: %s", err)
+			}
+
+			if diff := cmp.Diff(entries, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// renameRawStateAttributes returns the given raw state with the JSON values
+// of previous attribute names, as declared by attributes implementing
+// fwschema.AttributeWithRenamedFrom, moved to the current attribute names.
+// Previous names are only moved if the current name is not present in the
+// raw state and the previous name is not otherwise defined in the schema.
+// Flatmap raw state is returned unchanged.
+func renameRawStateAttributes(ctx context.Context, schema fwschema.Schema, rawState *tfprotov6.RawState) (*tfprotov6.RawState, error) {
+	if rawState == nil || rawState.JSON == nil || !hasRenamedAttributes(schema.GetAttributes(), schema.GetBlocks()) {
+		return rawState, nil
+	}
+
+	var object map[string]any
+
+	decoder := json.NewDecoder(bytes.NewReader(rawState.JSON))
+
+	// Prevent loss of number precision when re-encoding the JSON.
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+
+	if !renameObjectAttributes(ctx, schema.GetAttributes(), schema.GetBlocks(), object) {
+		return rawState, nil
+	}
+
+	renamedJSON, err := json.Marshal(object)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &tfprotov6.RawState{
+		JSON:    renamedJSON,
+		Flatmap: rawState.Flatmap,
+	}, nil
+}
+
+// hasRenamedAttributes returns true if any of the given attributes, or any
+// attributes nested underneath the given attributes and blocks, declare a
+// previous name.
+func hasRenamedAttributes(attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) bool {
+	for _, attribute := range attributes {
+		if attributeWithRenamedFrom, ok := attribute.(fwschema.AttributeWithRenamedFrom); ok && attributeWithRenamedFrom.GetRenamedFrom() != "" {
+			return true
+		}
+
+		nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+		if ok && nestedAttribute.GetNestedObject() != nil && hasRenamedAttributes(nestedAttribute.GetNestedObject().GetAttributes(), nil) {
+			return true
+		}
+	}
+
+	for _, block := range blocks {
+		nestedObject := block.GetNestedObject()
+
+		if nestedObject != nil && hasRenamedAttributes(nestedObject.GetAttributes(), nestedObject.GetBlocks()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// renameObjectAttributes moves the values of previous attribute names to the
+// current attribute names in the given JSON object, recursing into nested
+// attributes and blocks. It returns true if the object was modified.
+func renameObjectAttributes(ctx context.Context, attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block, object map[string]any) bool {
+	var renamed bool
+
+	for name, attribute := range attributes {
+		attributeWithRenamedFrom, ok := attribute.(fwschema.AttributeWithRenamedFrom)
+
+		if ok && renameObjectAttribute(ctx, attributes, blocks, object, attributeWithRenamedFrom.GetRenamedFrom(), name) {
+			renamed = true
+		}
+
+		nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+		if !ok || nestedAttribute.GetNestedObject() == nil {
+			continue
+		}
+
+		nestedAttributes := nestedAttribute.GetNestedObject().GetAttributes()
+
+		for _, nestedObject := range nestedJSONObjects(object[name], nestedAttribute.GetNestingMode() == fwschema.NestingModeMap) {
+			if renameObjectAttributes(ctx, nestedAttributes, nil, nestedObject) {
+				renamed = true
+			}
+		}
+	}
+
+	for name, block := range blocks {
+		nestedObject := block.GetNestedObject()
+
+		if nestedObject == nil {
+			continue
+		}
+
+		for _, nestedJSONObject := range nestedJSONObjects(object[name], false) {
+			if renameObjectAttributes(ctx, nestedObject.GetAttributes(), nestedObject.GetBlocks(), nestedJSONObject) {
+				renamed = true
+			}
+		}
+	}
+
+	return renamed
+}
+
+// renameObjectAttribute moves the value of the previous attribute name to the
+// current attribute name in the given JSON object. It returns true if the
+// object was modified.
+func renameObjectAttribute(ctx context.Context, attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block, object map[string]any, previousName string, name string) bool {
+	if previousName == "" {
+		return false
+	}
+
+	// Prevent moving values of names which are still in the schema.
+	if _, ok := attributes[previousName]; ok {
+		return false
+	}
+
+	if _, ok := blocks[previousName]; ok {
+		return false
+	}
+
+	value, ok := object[previousName]
+
+	if !ok {
+		return false
+	}
+
+	// Prevent overwriting values of the current name.
+	if _, ok := object[name]; ok {
+		return false
+	}
+
+	logging.FrameworkDebug(ctx, fmt.Sprintf("Moving resource state value of renamed attribute %s to %s", previousName, name))
+
+	delete(object, previousName)
+	object[name] = value
+
+	return true
+}
+
+// nestedJSONObjects returns the JSON objects of the given nested attribute or
+// block JSON value, which is either a single object, an array of objects, or
+// for map nested attributes, an object of objects.
+func nestedJSONObjects(value any, isMap bool) []map[string]any {
+	var result []map[string]any
+
+	switch value := value.(type) {
+	case map[string]any:
+		if !isMap {
+			return []map[string]any{value}
+		}
+
+		for _, element := range value {
+			if object, ok := element.(map[string]any); ok {
+				result = append(result, object)
+			}
+		}
+	case []any:
+		for _, element := range value {
+			if object, ok := element.(map[string]any); ok {
+				result = append(result, object)
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// sensitiveValueTransformFunc transforms a single known, non-null sensitive
+// string value at the given path.
+type sensitiveValueTransformFunc func(context.Context, path.Path, *tftypes.AttributePath, types.String) (types.String, diag.Diagnostics)
+
+// resourceSensitiveValueDecode returns the given resource data with all
+// transformable sensitive values decoded, if the resource implements
+// resource.ResourceWithSensitiveValueTransform. Otherwise, the data is
+// returned unchanged.
+func resourceSensitiveValueDecode(ctx context.Context, r resource.Resource, schema fwschema.Schema, raw tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	resourceWithTransform, ok := r.(resource.ResourceWithSensitiveValueTransform)
+
+	if !ok {
+		return raw, nil
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithSensitiveValueTransform")
+
+	return transformSensitiveValues(ctx, schema, raw, func(ctx context.Context, attributePath path.Path, _ *tftypes.AttributePath, value types.String) (types.String, diag.Diagnostics) {
+		return decodeSensitiveValue(ctx, resourceWithTransform, attributePath, value)
+	})
+}
+
+// resourceSensitiveValueEncode returns the given resource data with all
+// transformable sensitive values encoded, if the resource implements
+// resource.ResourceWithSensitiveValueTransform. Otherwise, the data is
+// returned unchanged. If the decoded value at the same path in the encoded
+// prior data is equal to the value, the encoded prior value is kept.
+func resourceSensitiveValueEncode(ctx context.Context, r resource.Resource, schema fwschema.Schema, raw tftypes.Value, priorRaw tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	resourceWithTransform, ok := r.(resource.ResourceWithSensitiveValueTransform)
+
+	if !ok {
+		return raw, nil
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithSensitiveValueTransform")
+
+	return transformSensitiveValues(ctx, schema, raw, func(ctx context.Context, attributePath path.Path, tfPath *tftypes.AttributePath, value types.String) (types.String, diag.Diagnostics) {
+		priorValue, ok := knownStringAtTerraformPath(priorRaw, tfPath)
+
+		if ok {
+			decodedPriorValue, diags := decodeSensitiveValue(ctx, resourceWithTransform, attributePath, priorValue)
+
+			if !diags.HasError() && decodedPriorValue.Equal(value) {
+				return priorValue, nil
+			}
+		}
+
+		req := resource.SensitiveValueTransformRequest{
+			Path:  attributePath,
+			Value: value,
+		}
+		resp := resource.SensitiveValueTransformResponse{
+			Value: value,
+		}
+
+		logging.FrameworkTrace(ctx, "Calling provider defined Resource EncodeSensitiveValue")
+		spanCtx, span := tracing.Start(ctx, "Resource EncodeSensitiveValue", tracing.AttributePath(attributePath))
+		resourceWithTransform.EncodeSensitiveValue(spanCtx, req, &resp)
+		span.End()
+		logging.FrameworkTrace(ctx, "Called provider defined Resource EncodeSensitiveValue")
+
+		return resp.Value, resp.Diagnostics
+	})
+}
+
+// decodeSensitiveValue calls the provider defined DecodeSensitiveValue
+// method for a single value.
+func decodeSensitiveValue(ctx context.Context, r resource.ResourceWithSensitiveValueTransform, attributePath path.Path, value types.String) (types.String, diag.Diagnostics) {
+	req := resource.SensitiveValueTransformRequest{
+		Path:  attributePath,
+		Value: value,
+	}
+	resp := resource.SensitiveValueTransformResponse{
+		Value: value,
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Resource DecodeSensitiveValue")
+	spanCtx, span := tracing.Start(ctx, "Resource DecodeSensitiveValue", tracing.AttributePath(attributePath))
+	r.DecodeSensitiveValue(spanCtx, req, &resp)
+	span.End()
+	logging.FrameworkTrace(ctx, "Called provider defined Resource DecodeSensitiveValue")
+
+	return resp.Value, resp.Diagnostics
+}
+
+// transformSensitiveValues calls the given function for each known, non-null
+// value of a Computed, non-Optional, Sensitive string attribute and replaces
+// the value with the result.
+func transformSensitiveValues(ctx context.Context, schema fwschema.Schema, raw tftypes.Value, transform sensitiveValueTransformFunc) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if raw.IsNull() || !raw.IsKnown() {
+		return raw, diags
+	}
+
+	newRaw, err := tftypes.Transform(raw, func(tfPath *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if value.IsNull() || !value.IsKnown() || !value.Type().Is(tftypes.String) {
+			return value, nil
+		}
+
+		attribute, err := schema.AttributeAtTerraformPath(ctx, tfPath)
+
+		// Values which are not attributes, such as collection elements, are
+		// not transformed.
+		if err != nil {
+			//nolint:nilerr // error is expected for non-attribute values
+			return value, nil
+		}
+
+		if !attribute.IsSensitive() || !attribute.IsComputed() || attribute.IsOptional() {
+			return value, nil
+		}
+
+		attributePath, attributePathDiags := fromtftypes.AttributePath(ctx, tfPath, schema)
+
+		diags.Append(attributePathDiags...)
+
+		if attributePathDiags.HasError() {
+			return value, nil
+		}
+
+		var stringValue string
+
+		if err := value.As(&stringValue); err != nil {
+			return value, err
+		}
+
+		newValue, transformDiags := transform(ctx, attributePath, tfPath, types.StringValue(stringValue))
+
+		diags.Append(transformDiags...)
+
+		if transformDiags.HasError() || newValue.IsNull() || newValue.IsUnknown() {
+			return value, nil
+		}
+
+		return tftypes.NewValue(value.Type(), newValue.ValueString()), nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Sensitive Value Transformation Error",
+			"An unexpected error was encountered trying to transform sensitive resource values. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				err.Error(),
+		)
+
+		return raw, diags
+	}
+
+	return newRaw, diags
+}
+
+// knownStringAtTerraformPath returns the known, non-null string value at the
+// given path, if it exists.
+func knownStringAtTerraformPath(raw tftypes.Value, tfPath *tftypes.AttributePath) (types.String, bool) {
+	if raw.IsNull() || !raw.IsKnown() {
+		return types.StringNull(), false
+	}
+
+	rawValue, _, err := tftypes.WalkAttributePath(raw, tfPath)
+
+	if err != nil {
+		return types.StringNull(), false
+	}
+
+	value, ok := rawValue.(tftypes.Value)
+
+	if !ok || value.IsNull() || !value.IsKnown() || !value.Type().Is(tftypes.String) {
+		return types.StringNull(), false
+	}
+
+	var stringValue string
+
+	if err := value.As(&stringValue); err != nil {
+		return types.StringNull(), false
+	}
+
+	return types.StringValue(stringValue), true
+}
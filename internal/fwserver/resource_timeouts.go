@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// resourceTimeoutsAttributeName is the name of the attribute or block which
+// can override the default resource operation timeouts, matching the
+// terraform-plugin-framework-timeouts module.
+const resourceTimeoutsAttributeName = "timeouts"
+
+// resourceTimeout returns the effective timeout of the given resource
+// operation. A known, non-empty string value in the timeouts attribute or
+// block of the given data overrides the default timeout.
+func resourceTimeout(raw tftypes.Value, operation string, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfPath := tftypes.NewAttributePath().WithAttributeName(resourceTimeoutsAttributeName).WithAttributeName(operation)
+	value, ok := knownStringAtTerraformPath(raw, tfPath)
+
+	if !ok || value.ValueString() == "" {
+		return defaultTimeout, diags
+	}
+
+	timeout, err := time.ParseDuration(value.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(resourceTimeoutsAttributeName).AtName(operation),
+			"Invalid Timeout",
+			fmt.Sprintf("The %s timeout could not be parsed as a duration, such as \"30m\" or \"1h\": %s", operation, err),
+		)
+
+		return defaultTimeout, diags
+	}
+
+	return timeout, diags
+}
+
+// resourceTimeoutContext returns the given context with a deadline of the
+// effective timeout of the resource operation, if any. The returned cancel
+// function must always be called.
+func resourceTimeoutContext(ctx context.Context, raw tftypes.Value, operation string, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	timeout, diags := resourceTimeout(raw, operation, defaultTimeout)
+
+	if diags.HasError() || timeout <= 0 {
+		return ctx, func() {}, diags
+	}
+
+	logging.FrameworkDebug(ctx, fmt.Sprintf("Setting Resource %s timeout of %s", operation, timeout))
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	return ctx, cancel, diags
+}
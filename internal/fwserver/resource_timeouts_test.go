@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestResourceTimeout(t *testing.T) {
+	t.Parallel()
+
+	testTimeoutsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"create": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test":     tftypes.String,
+			"timeouts": testTimeoutsType,
+		},
+	}
+
+	testValue := func(create tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, "test-value"),
+			"timeouts": tftypes.NewValue(testTimeoutsType, map[string]tftypes.Value{
+				"create": create,
+			}),
+		})
+	}
+
+	testCases := map[string]struct {
+		raw            tftypes.Value
+		defaultTimeout time.Duration
+		expected       time.Duration
+		expectedDiags  diag.Diagnostics
+	}{
+		"null-data": {
+			raw:            tftypes.NewValue(testType, nil),
+			defaultTimeout: 20 * time.Minute,
+			expected:       20 * time.Minute,
+		},
+		"no-timeouts-attribute": {
+			raw: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "test-value"),
+			}),
+			defaultTimeout: 20 * time.Minute,
+			expected:       20 * time.Minute,
+		},
+		"null-timeouts": {
+			raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test":     tftypes.NewValue(tftypes.String, "test-value"),
+				"timeouts": tftypes.NewValue(testTimeoutsType, nil),
+			}),
+			defaultTimeout: 20 * time.Minute,
+			expected:       20 * time.Minute,
+		},
+		"null-operation": {
+			raw:            testValue(tftypes.NewValue(tftypes.String, nil)),
+			defaultTimeout: 20 * time.Minute,
+			expected:       20 * time.Minute,
+		},
+		"empty-operation": {
+			raw:            testValue(tftypes.NewValue(tftypes.String, "")),
+			defaultTimeout: 20 * time.Minute,
+			expected:       20 * time.Minute,
+		},
+		"operation": {
+			raw:            testValue(tftypes.NewValue(tftypes.String, "1h")),
+			defaultTimeout: 20 * time.Minute,
+			expected:       time.Hour,
+		},
+		"operation-no-default": {
+			raw:      testValue(tftypes.NewValue(tftypes.String, "1h")),
+			expected: time.Hour,
+		},
+		"operation-invalid": {
+			raw:            testValue(tftypes.NewValue(tftypes.String, "invalid")),
+			defaultTimeout: 20 * time.Minute,
+			expected:       20 * time.Minute,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("timeouts").AtName("create"),
+					"Invalid Timeout",
+					`The create timeout could not be parsed as a duration, such as "30m" or "1h": time: invalid duration "invalid"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := resourceTimeout(testCase.raw, "create", testCase.defaultTimeout)
+
+			if got != testCase.expected {
+				t.Errorf("unexpected timeout: got %s, expected %s", got, testCase.expected)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
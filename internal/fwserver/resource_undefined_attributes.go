@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// logUndefinedRawStateAttributes logs a warning listing the paths of JSON raw
+// state values which are not defined in the given schema type, as these are
+// silently dropped when unmarshalling the raw state with the
+// IgnoreUndefinedAttributes option. Errors decoding the raw state are ignored,
+// as unmarshalling the raw state will report them.
+func logUndefinedRawStateAttributes(ctx context.Context, schemaType tftypes.Type, rawState *tfprotov6.RawState) {
+	if rawState == nil || rawState.JSON == nil {
+		return
+	}
+
+	var value any
+
+	decoder := json.NewDecoder(bytes.NewReader(rawState.JSON))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&value); err != nil {
+		return
+	}
+
+	paths := undefinedJSONAttributePaths(schemaType, value, path.Empty())
+
+	if len(paths) == 0 {
+		return
+	}
+
+	droppedPaths := make([]string, 0, len(paths))
+
+	for _, p := range paths {
+		droppedPaths = append(droppedPaths, p.String())
+	}
+
+	sort.Strings(droppedPaths)
+
+	logging.FrameworkWarn(
+		ctx,
+		"Dropping prior resource state values of attributes which are not defined in the schema",
+		map[string]interface{}{
+			logging.KeyDroppedAttributePaths: droppedPaths,
+		},
+	)
+}
+
+// undefinedJSONAttributePaths returns the paths of JSON object keys which are
+// not attributes of the corresponding object type, recursing into collection
+// and object values. Set elements are identified by their index in the JSON
+// array, as set paths otherwise require the element value.
+func undefinedJSONAttributePaths(typ tftypes.Type, value any, p path.Path) path.Paths {
+	var result path.Paths
+
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		object, ok := value.(map[string]any)
+
+		if !ok {
+			return nil
+		}
+
+		for name, attributeValue := range object {
+			attributeType, ok := typ.AttributeTypes[name]
+
+			if !ok {
+				result = append(result, p.AtName(name))
+
+				continue
+			}
+
+			result = append(result, undefinedJSONAttributePaths(attributeType, attributeValue, p.AtName(name))...)
+		}
+	case tftypes.List:
+		elements, _ := value.([]any)
+
+		for i, element := range elements {
+			result = append(result, undefinedJSONAttributePaths(typ.ElementType, element, p.AtListIndex(i))...)
+		}
+	case tftypes.Set:
+		elements, _ := value.([]any)
+
+		for i, element := range elements {
+			result = append(result, undefinedJSONAttributePaths(typ.ElementType, element, p.AtListIndex(i))...)
+		}
+	case tftypes.Tuple:
+		elements, _ := value.([]any)
+
+		for i, element := range elements {
+			if i >= len(typ.ElementTypes) {
+				break
+			}
+
+			result = append(result, undefinedJSONAttributePaths(typ.ElementTypes[i], element, p.AtTupleIndex(i))...)
+		}
+	case tftypes.Map:
+		elements, _ := value.(map[string]any)
+
+		for key, element := range elements {
+			result = append(result, undefinedJSONAttributePaths(typ.ElementType, element, p.AtMapKey(key))...)
+		}
+	}
+
+	return result
+}
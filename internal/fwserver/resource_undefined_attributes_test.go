@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+func TestLogUndefinedRawStateAttributes(t *testing.T) {
+	t.Parallel()
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested_string": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":          tftypes.String,
+			"list_nested": tftypes.List{ElementType: testNestedType},
+			"map_nested":  tftypes.Map{ElementType: testNestedType},
+			"set_nested":  tftypes.Set{ElementType: testNestedType},
+			"single":      testNestedType,
+		},
+	}
+
+	testCases := map[string]struct {
+		rawState *tfprotov6.RawState
+		expected []map[string]interface{}
+	}{
+		"nil": {
+			rawState: nil,
+			expected: nil,
+		},
+		"flatmap": {
+			rawState: &tfprotov6.RawState{
+				Flatmap: map[string]string{"removed": "value"},
+			},
+			expected: nil,
+		},
+		"invalid-json": {
+			rawState: &tfprotov6.RawState{
+				JSON: []byte(`{`),
+			},
+			expected: nil,
+		},
+		"no-undefined": {
+			rawState: &tfprotov6.RawState{
+				JSON: []byte(`{"id":"test","list_nested":[{"nested_string":"a"}],"single":null}`),
+			},
+			expected: nil,
+		},
+		"undefined": {
+			rawState: &tfprotov6.RawState{
+				JSON: []byte(`{
+					"id": "test",
+					"removed": "value",
+					"list_nested": [{"nested_string": "a"}, {"nested_string": "b", "nested_removed": true}],
+					"map_nested": {"key": {"nested_removed": 1}},
+					"set_nested": [{"nested_removed": null}],
+					"single": {"nested_removed": {"deep": "value"}}
+				}`),
+			},
+			expected: []map[string]interface{}{
+				{
+					"@level":   "warn",
+					"@message": "Dropping prior resource state values of attributes which are not defined in the schema",
+					"@module":  "sdk.framework",
+					logging.KeyDroppedAttributePaths: []interface{}{
+						`list_nested[1].nested_removed`,
+						`map_nested["key"].nested_removed`,
+						`removed`,
+						`set_nested[0].nested_removed`,
+						`single.nested_removed`,
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+
+			ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+			ctx = logging.InitContext(ctx)
+
+			logUndefinedRawStateAttributes(ctx, testType, testCase.rawState)
+
+			entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+			if err != nil {
+				t.Fatalf("unable to read multiple line JSON: %s", err)
+			}
+
+			if diff := cmp.Diff(entries, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -81,12 +81,23 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 		TerraformValue: req.State.Raw,
 	}
 
-	for name, attribute := range s.GetAttributes() {
+	// Plan modifiers receive a copy of the request data, made once for all of
+	// them, so one which modifies it cannot affect the attribute values read
+	// above or the resulting plan.
+	modifierConfig := req.Config.Clone()
+	modifierPlan := req.Plan.Clone()
+	modifierState := req.State.Clone()
+
+	attributes := s.GetAttributes()
+
+	for _, name := range fwschema.SortedNames(attributes) {
+		attribute := attributes[name]
+
 		attrReq := ModifyAttributePlanRequest{
 			AttributePath: path.Root(name),
-			Config:        req.Config,
-			State:         req.State,
-			Plan:          req.Plan,
+			Config:        modifierConfig,
+			State:         modifierState,
+			Plan:          modifierPlan,
 			ProviderMeta:  req.ProviderMeta,
 			Private:       req.Private,
 		}
@@ -138,12 +149,16 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 		resp.Private = attrResp.Private
 	}
 
-	for name, block := range s.GetBlocks() {
+	blocks := s.GetBlocks()
+
+	for _, name := range fwschema.SortedNames(blocks) {
+		block := blocks[name]
+
 		blockReq := ModifyAttributePlanRequest{
 			AttributePath: path.Root(name),
-			Config:        req.Config,
-			State:         req.State,
-			Plan:          req.Plan,
+			Config:        modifierConfig,
+			State:         modifierState,
+			Plan:          modifierPlan,
 			ProviderMeta:  req.ProviderMeta,
 			Private:       req.Private,
 		}
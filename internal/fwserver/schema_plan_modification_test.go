@@ -32,10 +32,76 @@ func TestSchemaModifyPlan(t *testing.T) {
 
 	testProviderData := privatestate.MustProviderData(context.Background(), testProviderKeyValue)
 
+	testIsolationType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test1": tftypes.String,
+			"test2": tftypes.String,
+		},
+	}
+
+	testIsolationValue := func() tftypes.Value {
+		return tftypes.NewValue(testIsolationType, map[string]tftypes.Value{
+			"test1": tftypes.NewValue(tftypes.String, "testvalue"),
+			"test2": tftypes.NewValue(tftypes.String, "testvalue"),
+		})
+	}
+
+	testIsolationSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test1": testschema.AttributeWithStringPlanModifiers{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							// Misbehaving plan modifier mutating the request data.
+							for _, raw := range []tftypes.Value{req.Config.Raw, req.Plan.Raw, req.State.Raw} {
+								var attributes map[string]tftypes.Value
+
+								if err := raw.As(&attributes); err != nil {
+									resp.Diagnostics.AddError("Unexpected As Error", err.Error())
+
+									return
+								}
+
+								attributes["test2"] = tftypes.NewValue(tftypes.String, "mutated")
+							}
+						},
+					},
+				},
+			},
+			"test2": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
 	testCases := map[string]struct {
 		req          ModifySchemaPlanRequest
 		expectedResp ModifySchemaPlanResponse
 	}{
+		"request-data-isolation": {
+			req: ModifySchemaPlanRequest{
+				Config: tfsdk.Config{
+					Raw:    testIsolationValue(),
+					Schema: testIsolationSchema,
+				},
+				Plan: tfsdk.Plan{
+					Raw:    testIsolationValue(),
+					Schema: testIsolationSchema,
+				},
+				State: tfsdk.State{
+					Raw:    testIsolationValue(),
+					Schema: testIsolationSchema,
+				},
+			},
+			expectedResp: ModifySchemaPlanResponse{
+				Plan: tfsdk.Plan{
+					Raw:    testIsolationValue(),
+					Schema: testIsolationSchema,
+				},
+			},
+		},
 		"config-error": {
 			req: ModifySchemaPlanRequest{
 				Config: tfsdk.Config{
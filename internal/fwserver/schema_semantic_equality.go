@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
@@ -52,7 +53,7 @@ type SchemaSemanticEqualityResponse struct {
 func SchemaSemanticEquality(ctx context.Context, req SchemaSemanticEqualityRequest, resp *SchemaSemanticEqualityResponse) {
 	var diags diag.Diagnostics
 
-	for name := range req.ProposedNewData.Schema.GetAttributes() {
+	for _, name := range fwschema.SortedNames(req.ProposedNewData.Schema.GetAttributes()) {
 		valueReq := fwschemadata.ValueSemanticEqualityRequest{
 			Path: path.Root(name),
 		}
@@ -98,7 +99,7 @@ func SchemaSemanticEquality(ctx context.Context, req SchemaSemanticEqualityReque
 		}
 	}
 
-	for name := range req.ProposedNewData.Schema.GetBlocks() {
+	for _, name := range fwschema.SortedNames(req.ProposedNewData.Schema.GetBlocks()) {
 		valueReq := fwschemadata.ValueSemanticEqualityRequest{
 			Path: path.Root(name),
 		}
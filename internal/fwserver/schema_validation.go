@@ -20,6 +20,11 @@ type ValidateSchemaRequest struct {
 	// interpolation or other functionality that would prevent Terraform
 	// from knowing the value at request time.
 	Config tfsdk.Config
+
+	// DeprecationSummary is the warning diagnostic summary used when the
+	// schema is deprecated, such as "Resource Deprecated". If empty,
+	// "Deprecated" is used.
+	DeprecationSummary string
 }
 
 // ValidateSchemaResponse represents a response to a
@@ -37,7 +42,10 @@ type ValidateSchemaResponse struct {
 // package from the tfsdk package and not wanting to export the method.
 // Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/365
 func SchemaValidate(ctx context.Context, s fwschema.Schema, req ValidateSchemaRequest, resp *ValidateSchemaResponse) {
-	for name, attribute := range s.GetAttributes() {
+	attributes := s.GetAttributes()
+
+	for _, name := range fwschema.SortedNames(attributes) {
+		attribute := attributes[name]
 
 		attributeReq := ValidateAttributeRequest{
 			AttributePath:           path.Root(name),
@@ -53,7 +61,11 @@ func SchemaValidate(ctx context.Context, s fwschema.Schema, req ValidateSchemaRe
 		resp.Diagnostics.Append(attributeResp.Diagnostics...)
 	}
 
-	for name, block := range s.GetBlocks() {
+	blocks := s.GetBlocks()
+
+	for _, name := range fwschema.SortedNames(blocks) {
+		block := blocks[name]
+
 		attributeReq := ValidateAttributeRequest{
 			AttributePath:           path.Root(name),
 			AttributePathExpression: path.MatchRoot(name),
@@ -69,8 +81,14 @@ func SchemaValidate(ctx context.Context, s fwschema.Schema, req ValidateSchemaRe
 	}
 
 	if s.GetDeprecationMessage() != "" {
+		summary := req.DeprecationSummary
+
+		if summary == "" {
+			summary = "Deprecated"
+		}
+
 		resp.Diagnostics.AddWarning(
-			"Deprecated",
+			summary,
 			s.GetDeprecationMessage(),
 		)
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,6 +22,17 @@ import (
 func TestSchemaValidate(t *testing.T) {
 	t.Parallel()
 
+	testAttributeWithPathError := testschema.AttributeWithStringValidators{
+		Required: true,
+		Validators: []validator.String{
+			testvalidator.String{
+				ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+					resp.Diagnostics.AddAttributeError(req.Path, "Error Diagnostic", "This is an error.")
+				},
+			},
+		},
+	}
+
 	testCases := map[string]struct {
 		req  ValidateSchemaRequest
 		resp ValidateSchemaResponse
@@ -169,6 +181,41 @@ func TestSchemaValidate(t *testing.T) {
 				},
 			},
 		},
+		"errors-sorted": {
+			req: ValidateSchemaRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"attr_a": tftypes.String,
+							"attr_b": tftypes.String,
+							"attr_c": tftypes.String,
+							"attr_d": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"attr_a": tftypes.NewValue(tftypes.String, "attr_a_value"),
+						"attr_b": tftypes.NewValue(tftypes.String, "attr_b_value"),
+						"attr_c": tftypes.NewValue(tftypes.String, "attr_c_value"),
+						"attr_d": tftypes.NewValue(tftypes.String, "attr_d_value"),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"attr_d": testAttributeWithPathError,
+							"attr_c": testAttributeWithPathError,
+							"attr_b": testAttributeWithPathError,
+							"attr_a": testAttributeWithPathError,
+						},
+					},
+				},
+			},
+			resp: ValidateSchemaResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(path.Root("attr_a"), "Error Diagnostic", "This is an error."),
+					diag.NewAttributeErrorDiagnostic(path.Root("attr_b"), "Error Diagnostic", "This is an error."),
+					diag.NewAttributeErrorDiagnostic(path.Root("attr_c"), "Error Diagnostic", "This is an error."),
+					diag.NewAttributeErrorDiagnostic(path.Root("attr_d"), "Error Diagnostic", "This is an error."),
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -6,6 +6,7 @@ package fwserver
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -33,6 +34,10 @@ type Server struct {
 	// to [resource.ConfigureRequest.ProviderData].
 	ResourceConfigureData any
 
+	// ResourceTimeouts is the [provider.ConfigureResponse.ResourceTimeouts]
+	// field value which sets the default deadlines of resource operations.
+	ResourceTimeouts resource.Timeouts
+
 	// dataSourceSchemas is the cached DataSource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the DataSourceType.GetSchema() method.
@@ -61,6 +66,15 @@ type Server struct {
 	// ImportResourceState, and ReadDataSource RPCs.
 	deferred *provider.Deferred
 
+	// errorConverters is the cached error converters for RPCs that call
+	// resource Create, Read, Update, or Delete methods. If not found, it will
+	// be fetched from the Provider.ErrorConverters() method.
+	errorConverters []diag.ErrorConverter
+
+	// errorConvertersMutex is a mutex to protect concurrent errorConverters
+	// access from race conditions.
+	errorConvertersMutex sync.Mutex
+
 	// functionDefinitions is the cached Function Definitions for RPCs that need to
 	// convert data from the protocol. If not found, it will be fetched from the
 	// Function.Definition() method.
@@ -112,14 +126,41 @@ type Server struct {
 	// access from race conditions.
 	providerMetaSchemaMutex sync.Mutex
 
+	// providerBehavior is the cached behavior of the provider, if the
+	// provider implemented the Metadata method. Access this field with the
+	// Provider.ProviderBehavior() method.
+	providerBehavior provider.ProviderBehavior
+
+	// providerMetadataCalled is true when the provider Metadata method was
+	// called, so providerBehavior does not need to be fetched again.
+	providerMetadataCalled bool
+
 	// providerTypeName is the cached type name of the provider, if the provider
 	// implemented the Metadata method. Access this field with the Provider.ProviderTypeName() method.
 	providerTypeName string
 
 	// providerTypeNameMutex is a mutex to protect concurrent providerTypeName
-	// access from race conditions.
+	// and providerBehavior access from race conditions.
 	providerTypeNameMutex sync.Mutex
 
+	// resourceInterceptors is the cached Resource interceptors for RPCs that
+	// call resource Create, Read, Update, or Delete methods. If not found, it
+	// will be fetched from the Provider.ResourceInterceptors() method.
+	resourceInterceptors []resource.Interceptor
+
+	// resourceInterceptorsMutex is a mutex to protect concurrent
+	// resourceInterceptors access from race conditions.
+	resourceInterceptorsMutex sync.Mutex
+
+	// resourceReadBatches contains the pending ReadResource RPC batches of
+	// resources implementing resource.ResourceWithBatchRead, keyed by the
+	// resource type name.
+	resourceReadBatches map[string]*resourceReadBatch
+
+	// resourceReadBatchesMutex is a mutex to protect concurrent
+	// resourceReadBatches access from race conditions.
+	resourceReadBatchesMutex sync.Mutex
+
 	// resourceSchemas is the cached Resource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the ResourceType.GetSchema() method.
@@ -155,6 +196,10 @@ type Server struct {
 	// resourceBehaviorsMutex is a mutex to protect concurrent resourceBehaviors
 	// access from race conditions.
 	resourceBehaviorsMutex sync.Mutex
+
+	// shutdownOnce ensures the Provider.Shutdown() method is only called
+	// once, regardless of how many times Shutdown is called.
+	shutdownOnce sync.Once
 }
 
 // DataSource returns the DataSource for a given type name.
@@ -342,6 +387,30 @@ func (s *Server) ProviderTypeName(ctx context.Context) string {
 		return s.providerTypeName
 	}
 
+	s.providerMetadata(ctx)
+
+	return s.providerTypeName
+}
+
+// ProviderBehavior returns the ProviderBehavior associated with the Provider.
+// The ProviderBehavior is cached on first use.
+func (s *Server) ProviderBehavior(ctx context.Context) provider.ProviderBehavior {
+	logging.FrameworkTrace(ctx, "Checking ProviderBehavior lock")
+	s.providerTypeNameMutex.Lock()
+	defer s.providerTypeNameMutex.Unlock()
+
+	if s.providerMetadataCalled {
+		return s.providerBehavior
+	}
+
+	s.providerMetadata(ctx)
+
+	return s.providerBehavior
+}
+
+// providerMetadata calls the provider Metadata method and caches its
+// response. The caller must hold providerTypeNameMutex.
+func (s *Server) providerMetadata(ctx context.Context) {
 	metadataReq := provider.MetadataRequest{}
 	metadataResp := provider.MetadataResponse{}
 
@@ -349,9 +418,9 @@ func (s *Server) ProviderTypeName(ctx context.Context) string {
 	s.Provider.Metadata(ctx, metadataReq, &metadataResp)
 	logging.FrameworkTrace(ctx, "Called provider defined Provider Metadata")
 
+	s.providerBehavior = metadataResp.ProviderBehavior
+	s.providerMetadataCalled = true
 	s.providerTypeName = metadataResp.TypeName
-
-	return s.providerTypeName
 }
 
 // ProviderSchema returns the Schema associated with the Provider. The Schema
@@ -655,8 +724,44 @@ func (s *Server) ResourceSchemas(ctx context.Context) (map[string]fwschema.Schem
 			continue
 		}
 
+		if resourceWithUpgradeState, ok := r.(resource.ResourceWithUpgradeState); ok {
+			diags.Append(resourceStateUpgraderVersionDiags(typeName, schemaResp.Schema.GetVersion(), resourceWithUpgradeState.UpgradeState(ctx))...)
+		}
+
 		resourceSchemas[typeName] = schemaResp.Schema
 	}
 
 	return resourceSchemas, diags
 }
+
+// resourceStateUpgraderVersionDiags returns warning diagnostics for any state
+// upgraders which will never be called, since their prior version is not less
+// than the current schema version. This typically means the schema version
+// was not incremented when adding the state upgrader.
+func resourceStateUpgraderVersionDiags(typeName string, schemaVersion int64, stateUpgraders map[int64]resource.StateUpgrader) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var versions []int64
+
+	for version := range stateUpgraders {
+		if version >= schemaVersion {
+			versions = append(versions, version)
+		}
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i] < versions[j]
+	})
+
+	for _, version := range versions {
+		diags.AddWarning(
+			"Unused Resource State Upgrader",
+			"When validating the resource state upgraders, an implementation issue was found. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("The %q resource type has a state upgrader for prior version %d, but the schema version is %d. ", typeName, version, schemaVersion)+
+				"State upgraders are only called for prior versions less than the schema version. "+
+				"The schema version may need to be incremented.",
+		)
+	}
+
+	return diags
+}
@@ -24,6 +24,10 @@ type ApplyResourceChangeRequest struct {
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema fwschema.Schema
 	Resource       resource.Resource
+
+	// TypeName is the resource type name, which is passed to resource
+	// interceptors.
+	TypeName string
 }
 
 // ApplyResourceChangeResponse is the framework server response for the
@@ -32,6 +36,10 @@ type ApplyResourceChangeResponse struct {
 	Diagnostics diag.Diagnostics
 	NewState    *tfsdk.State
 	Private     *privatestate.Data
+
+	// UnsafeToUseLegacyTypeSystem is enabled by the provider
+	// LegacyTypeSystemCompatibility behavior.
+	UnsafeToUseLegacyTypeSystem bool
 }
 
 // ApplyResourceChange implements the framework server ApplyResourceChange RPC.
@@ -40,6 +48,8 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 		return
 	}
 
+	resp.UnsafeToUseLegacyTypeSystem = s.ProviderBehavior(ctx).LegacyTypeSystemCompatibility
+
 	// If PriorState is missing/null, its a Create request.
 	if req.PriorState == nil || req.PriorState.Raw.IsNull() {
 		logging.FrameworkTrace(ctx, "ApplyResourceChange received no PriorState, running CreateResource")
@@ -51,6 +61,7 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 			ProviderMeta:   req.ProviderMeta,
 			ResourceSchema: req.ResourceSchema,
 			Resource:       req.Resource,
+			TypeName:       req.TypeName,
 		}
 		createResp := &CreateResourceResponse{}
 
@@ -73,6 +84,7 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 			ProviderMeta:   req.ProviderMeta,
 			ResourceSchema: req.ResourceSchema,
 			Resource:       req.Resource,
+			TypeName:       req.TypeName,
 		}
 		deleteResp := &DeleteResourceResponse{}
 
@@ -96,6 +108,7 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 		ProviderMeta:   req.ProviderMeta,
 		ResourceSchema: req.ResourceSchema,
 		Resource:       req.Resource,
+		TypeName:       req.TypeName,
 	}
 	updateResp := &UpdateResourceResponse{}
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 )
 
 // CallFunctionRequest is the framework server request for the
@@ -47,7 +48,9 @@ func (s *Server) CallFunction(ctx context.Context, req *CallFunctionRequest, res
 	}
 
 	logging.FrameworkTrace(ctx, "Calling provider defined Function Run")
-	req.Function.Run(ctx, runReq, &runResp)
+	spanCtx, span := tracing.Start(ctx, "Function Run")
+	req.Function.Run(spanCtx, runReq, &runResp)
+	span.End()
 	logging.FrameworkTrace(ctx, "Called provider defined Function Run")
 
 	resp.Error = function.ConcatFuncErrors(resp.Error, runResp.Error)
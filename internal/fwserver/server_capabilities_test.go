@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+)
+
+func TestServerServerCapabilities(t *testing.T) {
+	t.Parallel()
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{},
+	}
+
+	expected := &fwserver.ServerCapabilities{
+		GetProviderSchemaOptional: true,
+		MoveResourceState:         true,
+		// Resource ModifyPlan is called on destroy plans, such as to
+		// return warnings, only when PlanDestroy is enabled.
+		PlanDestroy: true,
+	}
+
+	if diff := cmp.Diff(server.ServerCapabilities(), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
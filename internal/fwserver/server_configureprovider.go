@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 )
 
@@ -14,12 +15,16 @@ import (
 func (s *Server) ConfigureProvider(ctx context.Context, req *provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	logging.FrameworkTrace(ctx, "Calling provider defined Provider Configure")
 
+	spanCtx, span := tracing.Start(ctx, "Provider Configure")
+
 	if req != nil {
-		s.Provider.Configure(ctx, *req, resp)
+		s.Provider.Configure(spanCtx, *req, resp)
 	} else {
-		s.Provider.Configure(ctx, provider.ConfigureRequest{}, resp)
+		s.Provider.Configure(spanCtx, provider.ConfigureRequest{}, resp)
 	}
 
+	span.End()
+
 	logging.FrameworkTrace(ctx, "Called provider defined Provider Configure")
 
 	if resp.Deferred != nil {
@@ -37,4 +42,5 @@ func (s *Server) ConfigureProvider(ctx context.Context, req *provider.ConfigureR
 	s.deferred = resp.Deferred
 	s.DataSourceConfigureData = resp.DataSourceData
 	s.ResourceConfigureData = resp.ResourceData
+	s.ResourceTimeouts = resp.ResourceTimeouts
 }
@@ -6,6 +6,7 @@ package fwserver_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -213,6 +215,24 @@ func TestServerConfigureProvider(t *testing.T) {
 				ResourceData: "test-provider-configure-value",
 			},
 		},
+		"response-resourcetimeouts": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {},
+					ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+						resp.ResourceTimeouts = resource.Timeouts{
+							Create: 20 * time.Minute,
+						}
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{},
+			expectedResponse: &provider.ConfigureResponse{
+				ResourceTimeouts: resource.Timeouts{
+					Create: 20 * time.Minute,
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -235,6 +255,10 @@ func TestServerConfigureProvider(t *testing.T) {
 			if diff := cmp.Diff(testCase.server.ResourceConfigureData, testCase.expectedResponse.ResourceData); diff != "" {
 				t.Errorf("unexpected server.ResourceConfigureData difference: %s", diff)
 			}
+
+			if diff := cmp.Diff(testCase.server.ResourceTimeouts, testCase.expectedResponse.ResourceTimeouts); diff != "" {
+				t.Errorf("unexpected server.ResourceTimeouts difference: %s", diff)
+			}
 		})
 	}
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -26,6 +27,10 @@ type CreateResourceRequest struct {
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema fwschema.Schema
 	Resource       resource.Resource
+
+	// TypeName is the resource type name, which is passed to resource
+	// interceptors.
+	TypeName string
 }
 
 // CreateResourceResponse is the framework server response for a create request
@@ -52,7 +57,9 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		spanCtx, span := tracing.Start(ctx, "Resource Configure")
+		resourceWithConfigure.Configure(spanCtx, configureReq, &configureResp)
+		span.End()
 		logging.FrameworkTrace(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -97,13 +104,31 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		createReq.ProviderMeta = *req.ProviderMeta
 	}
 
+	timeoutCtx, cancel, timeoutDiags := resourceTimeoutContext(ctx, createReq.Plan.Raw, "create", s.ResourceTimeouts.Create)
+	defer cancel()
+
+	resp.Diagnostics.Append(timeoutDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	create := interceptedCreateFunc(s.ResourceInterceptors(ctx), resource.InterceptorInfo{TypeName: req.TypeName}, req.Resource.Create)
+
 	logging.FrameworkTrace(ctx, "Calling provider defined Resource Create")
-	req.Resource.Create(ctx, createReq, &createResp)
+	spanCtx, span := tracing.Start(s.errorConvertersContext(timeoutCtx), "Resource Create")
+	create(spanCtx, createReq, &createResp)
+	span.End()
 	logging.FrameworkTrace(ctx, "Called provider defined Resource Create")
 
 	resp.Diagnostics = createResp.Diagnostics
 	resp.NewState = &createResp.State
 
+	newStateRaw, diags := resourceSensitiveValueEncode(ctx, req.Resource, resp.NewState.Schema, resp.NewState.Raw, nullSchemaData)
+
+	resp.Diagnostics.Append(diags...)
+	resp.NewState.Raw = newStateRaw
+
 	if !resp.Diagnostics.HasError() && createResp.State.Raw.Equal(nullSchemaData) {
 		detail := "The Terraform Provider unexpectedly returned no resource state after having no errors in the resource creation. " +
 			"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
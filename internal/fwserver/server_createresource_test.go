@@ -5,8 +5,10 @@ package fwserver_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -506,6 +509,272 @@ func TestServerCreateResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"request-ResourceTimeouts": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+				ResourceTimeouts: resource.Timeouts{
+					Create: time.Hour,
+				},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						deadline, ok := ctx.Deadline()
+
+						if !ok {
+							resp.Diagnostics.AddError("Missing Deadline", "expected context deadline")
+						}
+
+						if remaining := time.Until(deadline); remaining <= 0 || remaining > time.Hour {
+							resp.Diagnostics.AddError("Incorrect Deadline", "expected deadline within 1h, got: "+remaining.String())
+						}
+
+						var data testSchemaData
+
+						resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"request-ProviderWithResourceInterceptors": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithResourceInterceptors{
+					Provider: &testprovider.Provider{},
+					ResourceInterceptorsMethod: func(_ context.Context) []resource.Interceptor {
+						return []resource.Interceptor{
+							{
+								Create: func(ctx context.Context, info resource.InterceptorInfo, req resource.CreateRequest, resp *resource.CreateResponse, next resource.CreateFunc) {
+									resp.Diagnostics.AddWarning("interceptor 1", "before "+info.TypeName)
+									next(ctx, req, resp)
+									resp.Diagnostics.AddWarning("interceptor 1", "after")
+								},
+							},
+							{
+								// Interceptors without a Create function are skipped.
+								Read: func(ctx context.Context, _ resource.InterceptorInfo, req resource.ReadRequest, resp *resource.ReadResponse, next resource.ReadFunc) {
+									resp.Diagnostics.AddError("interceptor 2", "should not be called")
+								},
+							},
+							{
+								Create: func(ctx context.Context, _ resource.InterceptorInfo, req resource.CreateRequest, resp *resource.CreateResponse, next resource.CreateFunc) {
+									resp.Diagnostics.AddWarning("interceptor 3", "before")
+									next(ctx, req, resp)
+									resp.Diagnostics.AddWarning("interceptor 3", "after")
+								},
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						var data testSchemaData
+
+						resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+						resp.Diagnostics.AddWarning("resource", "create")
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("interceptor 1", "before test_resource"),
+					diag.NewWarningDiagnostic("interceptor 3", "before"),
+					diag.NewWarningDiagnostic("resource", "create"),
+					diag.NewWarningDiagnostic("interceptor 3", "after"),
+					diag.NewWarningDiagnostic("interceptor 1", "after"),
+				},
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"request-ProviderWithResourceInterceptors-next-not-called": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithResourceInterceptors{
+					Provider: &testprovider.Provider{},
+					ResourceInterceptorsMethod: func(_ context.Context) []resource.Interceptor {
+						return []resource.Interceptor{
+							{
+								Create: func(ctx context.Context, _ resource.InterceptorInfo, req resource.CreateRequest, resp *resource.CreateResponse, next resource.CreateFunc) {
+									resp.Diagnostics.AddError("interceptor", "next not called")
+								},
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						resp.Diagnostics.AddError("resource", "should not be called")
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("interceptor", "next not called"),
+				},
+				NewState: testEmptyState,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"request-CreateModel": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						resource.CreateModel(ctx, req, resp, func(_ context.Context, plan testSchemaData) (testSchemaData, error) {
+							plan.TestComputed = types.StringValue("test-computed-value")
+
+							return plan, nil
+						})
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"request-ProviderWithErrorConverters": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithErrorConverters{
+					Provider: &testprovider.Provider{},
+					ErrorConvertersMethod: func(_ context.Context) []diag.ErrorConverter {
+						return []diag.ErrorConverter{
+							diag.ErrorAsConverter(func(_ context.Context, err *testAPIError) diag.Diagnostics {
+								return diag.Diagnostics{
+									diag.NewAttributeErrorDiagnostic(path.Root("test_required"), "API Error", err.Code),
+								}
+							}),
+						}
+					},
+				},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						resource.CreateWithError(ctx, req, resp, func(_ context.Context, _ resource.CreateRequest, _ *resource.CreateResponse) error {
+							return fmt.Errorf("creating: %w", &testAPIError{Code: "InvalidName"})
+						})
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(path.Root("test_required"), "API Error", "InvalidName"),
+				},
+				NewState: testEmptyState,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"request-ProviderWithErrorConverters-unhandled": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithErrorConverters{
+					Provider: &testprovider.Provider{},
+					ErrorConvertersMethod: func(_ context.Context) []diag.ErrorConverter {
+						return []diag.ErrorConverter{
+							diag.ErrorAsConverter(func(_ context.Context, err *testAPIError) diag.Diagnostics {
+								return diag.Diagnostics{
+									diag.NewErrorDiagnostic("API Error", err.Code),
+								}
+							}),
+						}
+					},
+				},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						resource.CreateWithError(ctx, req, resp, func(_ context.Context, _ resource.CreateRequest, _ *resource.CreateResponse) error {
+							return errors.New("connection refused")
+						})
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("Error Creating Resource", "connection refused"),
+				},
+				NewState: testEmptyState,
+				Private:  testEmptyPrivate,
+			},
+		},
 		"response-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -562,3 +831,12 @@ func TestServerCreateResource(t *testing.T) {
 		})
 	}
 }
+
+// testAPIError is an API client error type for error converter testing.
+type testAPIError struct {
+	Code string
+}
+
+func (e *testAPIError) Error() string {
+	return "API error: " + e.Code
+}
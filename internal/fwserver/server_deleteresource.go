@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -24,6 +25,10 @@ type DeleteResourceRequest struct {
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema fwschema.Schema
 	Resource       resource.Resource
+
+	// TypeName is the resource type name, which is passed to resource
+	// interceptors.
+	TypeName string
 }
 
 // DeleteResourceResponse is the framework server response for a delete request
@@ -50,7 +55,9 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		spanCtx, span := tracing.Start(ctx, "Resource Configure")
+		resourceWithConfigure.Configure(spanCtx, configureReq, &configureResp)
+		span.End()
 		logging.FrameworkTrace(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -73,9 +80,24 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 		},
 	}
 
+	encodedPriorStateRaw := deleteReq.State.Raw
+
 	if req.PriorState != nil {
-		deleteReq.State = *req.PriorState
-		deleteResp.State = *req.PriorState
+		encodedPriorStateRaw = req.PriorState.Raw
+
+		priorStateRaw, diags := resourceSensitiveValueDecode(ctx, req.Resource, req.PriorState.Schema, req.PriorState.Raw)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		deleteReq.State = tfsdk.State{
+			Schema: req.PriorState.Schema,
+			Raw:    priorStateRaw,
+		}
+		deleteResp.State = deleteReq.State
 	}
 
 	if req.ProviderMeta != nil {
@@ -96,8 +118,21 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 		resp.Private = req.PlannedPrivate
 	}
 
+	timeoutCtx, cancel, timeoutDiags := resourceTimeoutContext(ctx, deleteReq.State.Raw, "delete", s.ResourceTimeouts.Delete)
+	defer cancel()
+
+	resp.Diagnostics.Append(timeoutDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteFunc := interceptedDeleteFunc(s.ResourceInterceptors(ctx), resource.InterceptorInfo{TypeName: req.TypeName}, req.Resource.Delete)
+
 	logging.FrameworkTrace(ctx, "Calling provider defined Resource Delete")
-	req.Resource.Delete(ctx, deleteReq, &deleteResp)
+	spanCtx, span := tracing.Start(s.errorConvertersContext(timeoutCtx), "Resource Delete")
+	deleteFunc(spanCtx, deleteReq, &deleteResp)
+	span.End()
 	logging.FrameworkTrace(ctx, "Called provider defined Resource Delete")
 
 	if !deleteResp.Diagnostics.HasError() {
@@ -113,6 +148,11 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 	resp.Diagnostics = deleteResp.Diagnostics
 	resp.NewState = &deleteResp.State
 
+	newStateRaw, diags := resourceSensitiveValueEncode(ctx, req.Resource, resp.NewState.Schema, resp.NewState.Raw, encodedPriorStateRaw)
+
+	resp.Diagnostics.Append(diags...)
+	resp.NewState.Raw = newStateRaw
+
 	if deleteResp.Private != nil {
 		if resp.Private == nil {
 			resp.Private = &privatestate.Data{}
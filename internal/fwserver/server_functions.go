@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwfunction"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 )
@@ -160,6 +161,16 @@ func (s *Server) FunctionFuncs(ctx context.Context) (map[string]func() function.
 			continue
 		}
 
+		if !fwfunction.ValidNameRegex.MatchString(metadataResp.Name) {
+			s.functionFuncsDiags.AddError(
+				"Invalid Function Name",
+				fmt.Sprintf("The %T Function returned an invalid name %q from the Metadata method. ", functionImpl, metadataResp.Name)+
+					"Function names must begin with an alphabet character (a-z, A-Z) or underscore (_) and must only contain alphanumeric characters (a-z, A-Z, 0-9), underscores (_), and hyphens (-). "+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			)
+			continue
+		}
+
 		logging.FrameworkTrace(ctx, "Found function", map[string]interface{}{logging.KeyFunctionName: metadataResp.Name})
 
 		if _, ok := s.functionFuncs[metadataResp.Name]; ok {
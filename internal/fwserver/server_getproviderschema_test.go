@@ -722,6 +722,72 @@ func TestServerGetProviderSchema(t *testing.T) {
 				},
 			},
 		},
+		"resourceschemas-upgradestate-unused-version": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.ResourceWithUpgradeState{
+									Resource: &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = resourceschema.Schema{
+												Attributes: map[string]resourceschema.Attribute{
+													"test1": resourceschema.StringAttribute{
+														Required: true,
+													},
+												},
+												Version: 1,
+											}
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource1"
+										},
+									},
+									UpgradeStateMethod: func(_ context.Context) map[int64]resource.StateUpgrader {
+										return map[int64]resource.StateUpgrader{
+											0: {},
+											1: {},
+										}
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas:   map[string]fwschema.Schema{},
+				FunctionDefinitions: map[string]function.Definition{},
+				Provider:            providerschema.Schema{},
+				ResourceSchemas: map[string]fwschema.Schema{
+					"test_resource1": resourceschema.Schema{
+						Attributes: map[string]resourceschema.Attribute{
+							"test1": resourceschema.StringAttribute{
+								Required: true,
+							},
+						},
+						Version: 1,
+					},
+				},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Unused Resource State Upgrader",
+						"When validating the resource state upgraders, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"The \"test_resource1\" resource type has a state upgrader for prior version 1, but the schema version is 1. "+
+							"State upgraders are only called for prior versions less than the schema version. "+
+							"The schema version may need to be incremented.",
+					),
+				},
+			},
+		},
 		"resourceschemas-invalid-attribute-name": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -87,7 +88,9 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		spanCtx, span := tracing.Start(ctx, "Resource Configure")
+		resourceWithConfigure.Configure(spanCtx, configureReq, &configureResp)
+		span.End()
 		logging.FrameworkTrace(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -133,7 +136,9 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 	}
 
 	logging.FrameworkTrace(ctx, "Calling provider defined Resource ImportState")
-	resourceWithImportState.ImportState(ctx, importReq, &importResp)
+	spanCtx, span := tracing.Start(ctx, "Resource ImportState")
+	resourceWithImportState.ImportState(spanCtx, importReq, &importResp)
+	span.End()
 	logging.FrameworkTrace(ctx, "Called provider defined Resource ImportState")
 
 	resp.Diagnostics.Append(importResp.Diagnostics...)
@@ -151,6 +156,18 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 		return
 	}
 
+	nullSchemaData := tftypes.NewValue(req.EmptyState.Schema.Type().TerraformType(ctx), nil)
+
+	importedStateRaw, diags := resourceSensitiveValueEncode(ctx, req.Resource, importResp.State.Schema, importResp.State.Raw, nullSchemaData)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importResp.State.Raw = importedStateRaw
+
 	private := &privatestate.Data{}
 
 	if importResp.Private != nil {
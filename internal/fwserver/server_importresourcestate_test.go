@@ -6,6 +6,7 @@ package fwserver_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func TestServerImportResourceState(t *testing.T) {
@@ -47,6 +49,12 @@ func TestServerImportResourceState(t *testing.T) {
 		"required": tftypes.NewValue(tftypes.String, nil),
 	})
 
+	testMultipartStateValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, nil),
+		"optional": tftypes.NewValue(tftypes.String, "test-optional"),
+		"required": tftypes.NewValue(tftypes.String, "test-required"),
+	})
+
 	testSchema := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -76,6 +84,11 @@ func TestServerImportResourceState(t *testing.T) {
 		Schema: testSchema,
 	}
 
+	testMultipartState := &tfsdk.State{
+		Raw:    testMultipartStateValue,
+		Schema: testSchema,
+	}
+
 	testProviderKeyValue := privatestate.MustMarshalToJson(map[string][]byte{
 		"providerKeyOne": []byte(`{"pKeyOne": {"k0": "zero", "k1": 1}}`),
 	})
@@ -168,6 +181,80 @@ func TestServerImportResourceState(t *testing.T) {
 				},
 			},
 		},
+		"request-id-multipart": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-required/test-optional",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resource.ImportStatePassthroughMultipartID(ctx, "/", []path.Path{path.Root("required"), path.Root("optional")}, req, resp)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				ImportedResources: []fwserver.ImportedResource{
+					{
+						State:    *testMultipartState,
+						TypeName: "test_resource",
+						Private:  testEmptyPrivate,
+					},
+				},
+			},
+		},
+		"request-id-multipart-invalid-parts": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-required",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resource.ImportStatePassthroughMultipartID(ctx, "/", []path.Path{path.Root("required"), path.Root("optional")}, req, resp)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unexpected Import Identifier",
+						`Expected import identifier with format: required/optional. Got: "test-required"`,
+					),
+				},
+			},
+		},
+		"request-id-multipart-invalid-empty-part": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-required/",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resource.ImportStatePassthroughMultipartID(ctx, "/", []path.Path{path.Root("required"), path.Root("optional")}, req, resp)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unexpected Import Identifier",
+						`Expected import identifier with format: required/optional. Got: "test-required/"`+"\n\n"+
+							"The optional part of the import identifier must not be empty.",
+					),
+				},
+			},
+		},
 		"request-resourcetype-importstate-not-implemented": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -438,3 +525,110 @@ func TestServerImportResourceState(t *testing.T) {
 		})
 	}
 }
+
+func TestServerImportResourceState_SensitiveValueTransform(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":     tftypes.String,
+			"secret": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"secret": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+
+	testEmptyState := tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"id":     tftypes.NewValue(tftypes.String, nil),
+			"secret": tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testSchema,
+	}
+
+	testImportedState := tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"id":     tftypes.NewValue(tftypes.String, "test-id"),
+			"secret": tftypes.NewValue(tftypes.String, "encoded-test-secret"),
+		}),
+		Schema: testSchema,
+	}
+
+	testResource := &testprovider.ResourceWithImportStateAndSensitiveValueTransform{
+		Resource: &testprovider.Resource{
+			ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+				var secret types.String
+
+				resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("secret"), &secret)...)
+
+				if secret.ValueString() != "test-secret" {
+					resp.Diagnostics.AddError("unexpected req.State secret value", secret.ValueString())
+				}
+			},
+		},
+		ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("secret"), "test-secret")...)
+		},
+		DecodeSensitiveValueMethod: func(_ context.Context, req resource.SensitiveValueTransformRequest, resp *resource.SensitiveValueTransformResponse) {
+			value, ok := strings.CutPrefix(req.Value.ValueString(), "encoded-")
+
+			if !ok {
+				resp.Diagnostics.AddAttributeError(req.Path, "unexpected decode value", req.Value.ValueString())
+				return
+			}
+
+			resp.Value = types.StringValue(value)
+		},
+		EncodeSensitiveValueMethod: func(_ context.Context, req resource.SensitiveValueTransformRequest, resp *resource.SensitiveValueTransformResponse) {
+			resp.Value = types.StringValue("encoded-" + req.Value.ValueString())
+		},
+	}
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{},
+	}
+
+	importResp := &fwserver.ImportResourceStateResponse{}
+
+	server.ImportResourceState(context.Background(), &fwserver.ImportResourceStateRequest{
+		EmptyState: testEmptyState,
+		ID:         "test-id",
+		Resource:   testResource,
+		TypeName:   "test_resource",
+	}, importResp)
+
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected import error diagnostics: %v", importResp.Diagnostics)
+	}
+
+	if diff := cmp.Diff(importResp.ImportedResources[0].State, testImportedState); diff != "" {
+		t.Errorf("unexpected imported state difference: %s", diff)
+	}
+
+	readResp := &fwserver.ReadResourceResponse{}
+
+	server.ReadResource(context.Background(), &fwserver.ReadResourceRequest{
+		CurrentState: &importResp.ImportedResources[0].State,
+		Resource:     testResource,
+		TypeName:     "test_resource",
+	}, readResp)
+
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read error diagnostics: %v", readResp.Diagnostics)
+	}
+
+	if diff := cmp.Diff(*readResp.NewState, testImportedState); diff != "" {
+		t.Errorf("unexpected read new state difference: %s", diff)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -193,7 +194,9 @@ func (s *Server) MoveResourceState(ctx context.Context, req *MoveResourceStateRe
 		}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined Resource StateMover")
-		resourceStateMover.StateMover(ctx, moveStateReq, &moveStateResp)
+		spanCtx, span := tracing.Start(ctx, "Resource StateMover")
+		resourceStateMover.StateMover(spanCtx, moveStateReq, &moveStateResp)
+		span.End()
 		logging.FrameworkTrace(ctx, "Called provider defined Resource StateMover")
 
 		resp.Diagnostics.Append(moveStateResp.Diagnostics...)
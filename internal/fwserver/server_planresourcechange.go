@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -45,6 +46,10 @@ type PlanResourceChangeResponse struct {
 	PlannedPrivate  *privatestate.Data
 	PlannedState    *tfsdk.State
 	RequiresReplace path.Paths
+
+	// UnsafeToUseLegacyTypeSystem is enabled by the provider
+	// LegacyTypeSystemCompatibility behavior.
+	UnsafeToUseLegacyTypeSystem bool
 }
 
 // PlanResourceChange implements the framework server PlanResourceChange RPC.
@@ -53,6 +58,9 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		return
 	}
 
+	providerBehavior := s.ProviderBehavior(ctx)
+	resp.UnsafeToUseLegacyTypeSystem = providerBehavior.LegacyTypeSystemCompatibility
+
 	// Skip ModifyPlan for automatic deferrals with proposed new state as a best effort for PlannedState
 	// unless ProviderDeferredBehavior.EnablePlanModification is true.
 	if s.deferred != nil && !req.ResourceBehavior.ProviderDeferred.EnablePlanModification {
@@ -80,7 +88,9 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		spanCtx, span := tracing.Start(ctx, "Resource Configure")
+		resourceWithConfigure.Configure(spanCtx, configureReq, &configureResp)
+		span.End()
 		logging.FrameworkTrace(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -155,6 +165,26 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		resp.PlannedState.Raw = data.TerraformValue
 	}
 
+	// Plan empty and null string values as the prior state value for
+	// resources migrated from terraform-plugin-sdk/v2, which did not
+	// differentiate between them, so they are not detected as changes below.
+	if providerBehavior.LegacyTypeSystemCompatibility && !resp.PlannedState.Raw.IsNull() && !req.PriorState.Raw.IsNull() {
+		logging.FrameworkDebug(ctx, "Provider behavior enables legacy type system compatibility, planning empty and null string values as prior state values")
+
+		modifiedPlan, err := tftypes.Transform(resp.PlannedState.Raw, NormalizeLegacyEmptyStrings(ctx, req.PriorState.Raw))
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error modifying plan",
+				"There was an unexpected error updating the plan. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+
+			return
+		}
+
+		resp.PlannedState.Raw = modifiedPlan
+	}
+
 	// After ensuring there are proposed changes, mark any computed attributes
 	// that are null in the config as unknown in the plan, so providers have
 	// the choice to update them.
@@ -172,11 +202,11 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		if !req.PriorState.Raw.IsNull() {
 			var allPaths, changedPaths path.Paths
 
-			for attrName := range resp.PlannedState.Schema.GetAttributes() {
+			for _, attrName := range fwschema.SortedNames(resp.PlannedState.Schema.GetAttributes()) {
 				allPaths.Append(path.Root(attrName))
 			}
 
-			for blockName := range resp.PlannedState.Schema.GetBlocks() {
+			for _, blockName := range fwschema.SortedNames(resp.PlannedState.Schema.GetBlocks()) {
 				allPaths.Append(path.Root(blockName))
 			}
 
@@ -214,24 +244,45 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 			}
 		}
 
-		logging.FrameworkDebug(ctx, "Marking Computed attributes with null configuration values as unknown (known after apply) in the plan to prevent potential Terraform errors")
+		if req.ResourceBehavior.PlanNormalization.DisableComputedUnknownMarking {
+			logging.FrameworkDebug(ctx, "Resource behavior disables marking Computed attributes with null configuration values as unknown, skipping")
+		} else {
+			logging.FrameworkDebug(ctx, "Marking Computed attributes with null configuration values as unknown (known after apply) in the plan to prevent potential Terraform errors")
 
-		modifiedPlan, err := tftypes.Transform(resp.PlannedState.Raw, MarkComputedNilsAsUnknown(ctx, req.Config.Raw, req.ResourceSchema))
+			modifiedPlan, err := tftypes.Transform(resp.PlannedState.Raw, MarkComputedNilsAsUnknown(ctx, req.Config.Raw, req.ResourceSchema))
 
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error modifying plan",
-				"There was an unexpected error updating the plan. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
-			)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error modifying plan",
+					"There was an unexpected error updating the plan. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				)
 
-			return
-		}
+				return
+			}
 
-		if !resp.PlannedState.Raw.Equal(modifiedPlan) {
-			logging.FrameworkTrace(ctx, "At least one Computed null Config value was changed to unknown")
-		}
+			if !resp.PlannedState.Raw.Equal(modifiedPlan) {
+				logging.FrameworkTrace(ctx, "At least one Computed null Config value was changed to unknown")
+			}
 
-		resp.PlannedState.Raw = modifiedPlan
+			resp.PlannedState.Raw = modifiedPlan
+
+			if req.PriorState.Raw.IsNull() && req.ResourceBehavior.PlanNormalization.EnableComputedNestedObjectUnknownChildren {
+				logging.FrameworkDebug(ctx, "Marking attributes of Computed single nested attributes with null configuration values as unknown (known after apply) in the plan")
+
+				modifiedPlan, err := tftypes.Transform(resp.PlannedState.Raw, MarkComputedNestedObjectChildrenAsUnknown(ctx, req.Config.Raw, req.ResourceSchema))
+
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Error modifying plan",
+						"There was an unexpected error updating the plan. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+					)
+
+					return
+				}
+
+				resp.PlannedState.Raw = modifiedPlan
+			}
+		}
 	}
 
 	// Execute any schema-based plan modifiers. This allows overwriting
@@ -300,7 +351,9 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined Resource ModifyPlan")
-		resourceWithModifyPlan.ModifyPlan(ctx, modifyPlanReq, &modifyPlanResp)
+		spanCtx, span := tracing.Start(ctx, "Resource ModifyPlan")
+		resourceWithModifyPlan.ModifyPlan(spanCtx, modifyPlanReq, &modifyPlanResp)
+		span.End()
 		logging.FrameworkTrace(ctx, "Called provider defined Resource ModifyPlan")
 
 		resp.Diagnostics = modifyPlanResp.Diagnostics
@@ -470,6 +523,79 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 	}
 }
 
+// MarkComputedNestedObjectChildrenAsUnknown returns a tftypes.Transform
+// callback which replaces unknown values of Computed single nested
+// attributes, which are null in the configuration, with known objects whose
+// attribute values are unknown. Nested single nested attributes are replaced
+// the same way. It is intended to run after MarkComputedNilsAsUnknown.
+func MarkComputedNestedObjectChildrenAsUnknown(ctx context.Context, config tftypes.Value, resourceSchema fwschema.Schema) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
+	return func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
+		if len(path.Steps()) < 1 || val.IsKnown() {
+			return val, nil
+		}
+
+		attribute, err := resourceSchema.AttributeAtTerraformPath(ctx, path)
+
+		// Only attributes can be Computed single nested attributes.
+		if err != nil {
+			//nolint:nilerr // error is expected for non-attribute values
+			return val, nil
+		}
+
+		nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+		if !ok || nestedAttribute.GetNestingMode() != fwschema.NestingModeSingle || !attribute.IsComputed() {
+			return val, nil
+		}
+
+		configValIface, _, err := tftypes.WalkAttributePath(config, path)
+
+		if err != nil && err != tftypes.ErrInvalidStep {
+			return val, fmt.Errorf("error walking attribute path during nested object unknown marking: %w", err)
+		}
+
+		if configVal, ok := configValIface.(tftypes.Value); ok && !configVal.IsNull() {
+			return val, nil
+		}
+
+		objectType, ok := val.Type().(tftypes.Object)
+
+		if !ok {
+			return val, nil
+		}
+
+		logging.FrameworkDebug(
+			logging.FrameworkWithAttributePath(ctx, path.String()),
+			"marking attributes of computed nested object that is null in the config as unknown",
+		)
+
+		return unknownChildrenObject(nestedAttribute.GetNestedObject(), objectType), nil
+	}
+}
+
+// unknownChildrenObject returns a known object of the given type, where each
+// attribute value is unknown, except single nested attributes, which are
+// also known objects with unknown attribute values.
+func unknownChildrenObject(nestedObject fwschema.NestedAttributeObject, objectType tftypes.Object) tftypes.Value {
+	attributes := nestedObject.GetAttributes()
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	for name, attributeType := range objectType.AttributeTypes {
+		nestedAttribute, ok := attributes[name].(fwschema.NestedAttribute)
+		nestedObjectType, isObject := attributeType.(tftypes.Object)
+
+		if ok && isObject && nestedAttribute.GetNestingMode() == fwschema.NestingModeSingle {
+			values[name] = unknownChildrenObject(nestedAttribute.GetNestedObject(), nestedObjectType)
+
+			continue
+		}
+
+		values[name] = tftypes.NewValue(attributeType, tftypes.UnknownValue)
+	}
+
+	return tftypes.NewValue(objectType, values)
+}
+
 // NormaliseRequiresReplace sorts and deduplicates the slice of AttributePaths
 // used in the RequiresReplace response field.
 // Sorting is lexical based on the string representation of each AttributePath.
@@ -515,3 +641,53 @@ func stateToPlan(state tfsdk.State) tfsdk.Plan {
 		Schema: state.Schema,
 	}
 }
+
+// NormalizeLegacyEmptyStrings returns a tftypes.Transform function which
+// replaces empty string values with null prior state values, and null string
+// values with empty prior state values, as terraform-plugin-sdk/v2 did not
+// differentiate between them.
+func NormalizeLegacyEmptyStrings(ctx context.Context, priorState tftypes.Value) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
+	return func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
+		if len(path.Steps()) < 1 || !val.Type().Is(tftypes.String) || !val.IsKnown() {
+			return val, nil
+		}
+
+		priorRaw, _, err := tftypes.WalkAttributePath(priorState, path)
+
+		// Prior state values may not exist at the path, such as new list
+		// elements, which have nothing to normalize against.
+		if err != nil {
+			return val, nil //nolint:nilerr // Missing prior state values are expected
+		}
+
+		priorVal, ok := priorRaw.(tftypes.Value)
+
+		if !ok || !priorVal.Type().Is(tftypes.String) || !priorVal.IsKnown() {
+			return val, nil
+		}
+
+		if isLegacyEmptyString(val) && isLegacyEmptyString(priorVal) && !val.Equal(priorVal) {
+			logging.FrameworkTrace(logging.FrameworkWithAttributePath(ctx, path.String()), "Planning empty or null string value as prior state value")
+
+			return priorVal, nil
+		}
+
+		return val, nil
+	}
+}
+
+// isLegacyEmptyString returns true if the known string value is null or
+// empty.
+func isLegacyEmptyString(val tftypes.Value) bool {
+	if val.IsNull() {
+		return true
+	}
+
+	var s string
+
+	if err := val.As(&s); err != nil {
+		return false
+	}
+
+	return s == ""
+}
@@ -1267,6 +1267,51 @@ func TestServerPlanResourceChange(t *testing.T) {
 		DeferralAllowed: true,
 	}
 
+	testSchemaTypeComputedNestedObject := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_nested": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_nested_list": tftypes.List{ElementType: tftypes.String},
+					"test_nested_object": tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_nested_string": tftypes.String,
+						},
+					},
+					"test_nested_string": tftypes.String,
+				},
+			},
+			"test_required": tftypes.String,
+		},
+	}
+
+	testSchemaComputedNestedObject := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_nested": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test_nested_list": schema.ListAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"test_nested_object": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"test_nested_string": schema.StringAttribute{
+								Computed: true,
+							},
+						},
+						Computed: true,
+					},
+					"test_nested_string": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
 	testCases := map[string]struct {
 		server               *fwserver.Server
 		request              *fwserver.PlanResourceChangeRequest
@@ -1368,6 +1413,134 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"create-mark-computed-config-nils-as-unknown-disabled": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource:       &testprovider.Resource{},
+				ResourceBehavior: resource.ResourceBehavior{
+					PlanNormalization: resource.PlanNormalizationBehavior{
+						DisableComputedUnknownMarking: true,
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-mark-computed-nested-object-children-unknown": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaTypeComputedNestedObject, map[string]tftypes.Value{
+						"test_nested":   tftypes.NewValue(testSchemaTypeComputedNestedObject.AttributeTypes["test_nested"], nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaComputedNestedObject,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaTypeComputedNestedObject, map[string]tftypes.Value{
+						"test_nested":   tftypes.NewValue(testSchemaTypeComputedNestedObject.AttributeTypes["test_nested"], nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaComputedNestedObject,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaTypeComputedNestedObject, nil),
+					Schema: testSchemaComputedNestedObject,
+				},
+				ResourceSchema: testSchemaComputedNestedObject,
+				Resource:       &testprovider.Resource{},
+				ResourceBehavior: resource.ResourceBehavior{
+					PlanNormalization: resource.PlanNormalizationBehavior{
+						EnableComputedNestedObjectUnknownChildren: true,
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeComputedNestedObject, map[string]tftypes.Value{
+						"test_nested": tftypes.NewValue(testSchemaTypeComputedNestedObject.AttributeTypes["test_nested"], map[string]tftypes.Value{
+							"test_nested_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+							"test_nested_object": tftypes.NewValue(tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_nested_string": tftypes.String,
+								},
+							}, map[string]tftypes.Value{
+								"test_nested_string": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+							}),
+							"test_nested_string": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						}),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaComputedNestedObject,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-mark-computed-nested-object-children-unknown-disabled": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaTypeComputedNestedObject, map[string]tftypes.Value{
+						"test_nested":   tftypes.NewValue(testSchemaTypeComputedNestedObject.AttributeTypes["test_nested"], nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaComputedNestedObject,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaTypeComputedNestedObject, map[string]tftypes.Value{
+						"test_nested":   tftypes.NewValue(testSchemaTypeComputedNestedObject.AttributeTypes["test_nested"], nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaComputedNestedObject,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaTypeComputedNestedObject, nil),
+					Schema: testSchemaComputedNestedObject,
+				},
+				ResourceSchema: testSchemaComputedNestedObject,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeComputedNestedObject, map[string]tftypes.Value{
+						"test_nested":   tftypes.NewValue(testSchemaTypeComputedNestedObject.AttributeTypes["test_nested"], tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaComputedNestedObject,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"create-set-default-values": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -2916,6 +3089,50 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"create-resourcewithmodifyplan-request-iscreate-isdestroy": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						if req.IsCreate() != true {
+							resp.Diagnostics.AddError("unexpected req.IsCreate value", "expected true")
+						}
+
+						if req.IsDestroy() {
+							resp.Diagnostics.AddError("unexpected req.IsDestroy value", "expected false, got true")
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"create-resourcewithmodifyplan-request-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -3686,6 +3903,51 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"delete-resourcewithmodifyplan-request-isdestroy": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: testEmptyPlan,
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-state-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						if req.IsCreate() {
+							resp.Diagnostics.AddError("unexpected req.IsCreate value", "expected false, got true")
+						}
+
+						if !req.IsDestroy() {
+							resp.Diagnostics.AddError("unexpected req.IsDestroy value", "expected true, got false")
+
+							return
+						}
+
+						resp.Diagnostics.AddWarning("Final Snapshot", "A final snapshot will not be taken.")
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("Final Snapshot", "A final snapshot will not be taken."),
+				},
+				PlannedState:   testEmptyState,
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"delete-resourcewithmodifyplan-response-plannedstate": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -3884,6 +4146,91 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"update-legacy-type-system-compatibility": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+						resp.ProviderBehavior.LegacyTypeSystemCompatibility = true
+					},
+				},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, ""),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, ""),
+					}),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate:              testEmptyPrivate,
+				UnsafeToUseLegacyTypeSystem: true,
+			},
+		},
+		"update-legacy-type-system-compatibility-disabled": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, ""),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, ""),
+					}),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, ""),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"update-set-default-values": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -5869,6 +6216,56 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"update-resourcewithmodifyplan-request-iscreate-isdestroy": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						if req.IsCreate() != false {
+							resp.Diagnostics.AddError("unexpected req.IsCreate value", "expected false")
+						}
+
+						if req.IsDestroy() {
+							resp.Diagnostics.AddError("unexpected req.IsDestroy value", "expected false, got true")
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"update-resourcewithmodifyplan-request-config-nil-block": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
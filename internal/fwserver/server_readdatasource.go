@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -68,7 +69,9 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 		configureResp := datasource.ConfigureResponse{}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined DataSource Configure")
-		dataSourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		spanCtx, span := tracing.Start(ctx, "DataSource Configure")
+		dataSourceWithConfigure.Configure(spanCtx, configureReq, &configureResp)
+		span.End()
 		logging.FrameworkTrace(ctx, "Called provider defined DataSource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -100,12 +103,30 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 	}
 
 	logging.FrameworkTrace(ctx, "Calling provider defined DataSource Read")
-	req.DataSource.Read(ctx, readReq, &readResp)
+	spanCtx, span := tracing.Start(ctx, "DataSource Read")
+	req.DataSource.Read(spanCtx, readReq, &readResp)
+	span.End()
 	logging.FrameworkTrace(ctx, "Called provider defined DataSource Read")
 
 	resp.Diagnostics = readResp.Diagnostics
 	resp.State = &readResp.State
-	resp.Deferred = readResp.Deferred
+
+	if readResp.Deferred != nil {
+		if !req.ClientCapabilities.DeferralAllowed {
+			resp.Diagnostics.AddError("Invalid Deferred Data Source Response",
+				"Data source configured a deferred response but the Terraform request "+
+					"did not indicate support for deferred actions. This is an issue with the provider and should be reported to the provider developers.")
+			return
+		}
+
+		logging.FrameworkDebug(ctx, "Data source has configured a deferred response",
+			map[string]interface{}{
+				logging.KeyDeferredReason: readResp.Deferred.Reason.String(),
+			},
+		)
+
+		resp.Deferred = readResp.Deferred
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
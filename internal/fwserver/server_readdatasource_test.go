@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -107,6 +108,27 @@ func TestServerReadDataSource(t *testing.T) {
 		Schema: testSchema,
 	}
 
+	testConfigUnknown := &tfsdk.Config{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_computed": tftypes.NewValue(tftypes.String, nil),
+			"test_required": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		}),
+		Schema: testSchema,
+	}
+
+	testStateConfigUnknown := &tfsdk.State{
+		Raw:    testConfigUnknown.Raw,
+		Schema: testSchema,
+	}
+
+	testRequireKnownConfigValuesRead := func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+		if !datasource.RequireKnownConfigValues(ctx, req, resp, path.MatchRoot("test_required")) {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), "test-state-value")...)
+	}
+
 	testDeferralAllowed := datasource.ReadClientCapabilities{
 		DeferralAllowed: true,
 	}
@@ -304,6 +326,87 @@ func TestServerReadDataSource(t *testing.T) {
 				Deferred: &datasource.Deferred{Reason: datasource.DeferredReasonAbsentPrereq},
 			},
 		},
+		"response-deferral-manual-not-allowed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+						resp.Deferred = &datasource.Deferred{Reason: datasource.DeferredReasonAbsentPrereq}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Deferred Data Source Response",
+						"Data source configured a deferred response but the Terraform request "+
+							"did not indicate support for deferred actions. This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				State: testStateUnchanged,
+			},
+		},
+		"response-require-known-config-values": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: testRequireKnownConfigValuesRead,
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State: testState,
+			},
+		},
+		"response-require-known-config-values-unknown": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfigUnknown,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: testRequireKnownConfigValuesRead,
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_required"),
+						"Unknown Configuration Value",
+						"The data source cannot be read because this configuration value is unknown. "+
+							"It may depend on a resource which has not been created or updated yet.\n\n"+
+							"Use a value which is known during planning, or apply the resources it depends on first, "+
+							"such as with the -target flag, and then apply the configuration again.",
+					),
+				},
+				State: testStateConfigUnknown,
+			},
+		},
+		"response-require-known-config-values-unknown-deferral-allowed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				ClientCapabilities: testDeferralAllowed,
+				Config:             testConfigUnknown,
+				DataSourceSchema:   testSchema,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: testRequireKnownConfigValuesRead,
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Deferred: &datasource.Deferred{Reason: datasource.DeferredReasonDataSourceConfigUnknown},
+				State:    testStateConfigUnknown,
+			},
+		},
 		"response-diagnostics": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -22,6 +23,10 @@ type ReadResourceRequest struct {
 	Resource           resource.Resource
 	Private            *privatestate.Data
 	ProviderMeta       *tfsdk.Config
+
+	// TypeName is the resource type name, which is necessary for aggregating
+	// the RPCs of resources implementing resource.ResourceWithBatchRead.
+	TypeName string
 }
 
 // ReadResourceResponse is the framework server response for the
@@ -71,7 +76,9 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		spanCtx, span := tracing.Start(ctx, "Resource Configure")
+		resourceWithConfigure.Configure(spanCtx, configureReq, &configureResp)
+		span.End()
 		logging.FrameworkTrace(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -81,17 +88,25 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		}
 	}
 
+	currentStateRaw, diags := resourceSensitiveValueDecode(ctx, req.Resource, req.CurrentState.Schema, req.CurrentState.Raw)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	readReq := resource.ReadRequest{
 		ClientCapabilities: req.ClientCapabilities,
 		State: tfsdk.State{
 			Schema: req.CurrentState.Schema,
-			Raw:    req.CurrentState.Raw.Copy(),
+			Raw:    currentStateRaw.Copy(),
 		},
 	}
 	readResp := resource.ReadResponse{
 		State: tfsdk.State{
 			Schema: req.CurrentState.Schema,
-			Raw:    req.CurrentState.Raw.Copy(),
+			Raw:    currentStateRaw.Copy(),
 		},
 	}
 
@@ -113,14 +128,40 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		resp.Private = req.Private
 	}
 
+	timeoutCtx, cancel, timeoutDiags := resourceTimeoutContext(ctx, readReq.State.Raw, "read", s.ResourceTimeouts.Read)
+	defer cancel()
+
+	resp.Diagnostics.Append(timeoutDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readFunc := req.Resource.Read
+
+	if resourceWithBatchRead, ok := req.Resource.(resource.ResourceWithBatchRead); ok && req.TypeName != "" {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithBatchRead")
+
+		readFunc = s.batchedReadFunc(req.TypeName, resourceWithBatchRead)
+	}
+
+	read := interceptedReadFunc(s.ResourceInterceptors(ctx), resource.InterceptorInfo{TypeName: req.TypeName}, readFunc)
+
 	logging.FrameworkTrace(ctx, "Calling provider defined Resource Read")
-	req.Resource.Read(ctx, readReq, &readResp)
+	spanCtx, span := tracing.Start(s.errorConvertersContext(timeoutCtx), "Resource Read")
+	read(spanCtx, readReq, &readResp)
+	span.End()
 	logging.FrameworkTrace(ctx, "Called provider defined Resource Read")
 
 	resp.Diagnostics = readResp.Diagnostics
 	resp.NewState = &readResp.State
 	resp.Deferred = readResp.Deferred
 
+	newStateRaw, diags := resourceSensitiveValueEncode(ctx, req.Resource, resp.NewState.Schema, resp.NewState.Raw, req.CurrentState.Raw)
+
+	resp.Diagnostics.Append(diags...)
+	resp.NewState.Raw = newStateRaw
+
 	if readResp.Private != nil {
 		if resp.Private == nil {
 			resp.Private = &privatestate.Data{}
@@ -157,11 +198,11 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		return
 	}
 
-	if semanticEqualityResp.NewData.TerraformValue.Equal(resp.NewState.Raw) {
-		return
-	}
+	if !semanticEqualityResp.NewData.TerraformValue.Equal(resp.NewState.Raw) {
+		logging.FrameworkDebug(ctx, "State updated due to semantic equality")
 
-	logging.FrameworkDebug(ctx, "State updated due to semantic equality")
+		resp.NewState.Raw = semanticEqualityResp.NewData.TerraformValue
+	}
 
-	resp.NewState.Raw = semanticEqualityResp.NewData.TerraformValue
+	logResourceReadDrift(ctx, req.CurrentState.Schema, req.CurrentState.Raw, resp.NewState.Raw)
 }
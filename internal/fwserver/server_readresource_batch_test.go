@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestServerReadResource_BatchRead(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":    tftypes.String,
+			"value": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required: true,
+			},
+			"value": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	type testModel struct {
+		ID    types.String `tfsdk:"id"`
+		Value types.String `tfsdk:"value"`
+	}
+
+	testCases := map[string]struct {
+		settings           resource.BatchReadSettings
+		requests           int
+		batchDiagnostics   diag.Diagnostics
+		expectedBatchSizes []int
+	}{
+		"max-size": {
+			settings: resource.BatchReadSettings{
+				Window:  time.Minute,
+				MaxSize: 3,
+			},
+			requests:           6,
+			expectedBatchSizes: []int{3, 3},
+		},
+		"window": {
+			settings: resource.BatchReadSettings{
+				Window: 10 * time.Millisecond,
+			},
+			requests:           1,
+			expectedBatchSizes: []int{1},
+		},
+		"batch-diagnostics": {
+			settings: resource.BatchReadSettings{
+				Window:  time.Minute,
+				MaxSize: 2,
+			},
+			requests: 2,
+			batchDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic("API Error", "The remote system API call failed."),
+			},
+			expectedBatchSizes: []int{2},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var batchSizes []int
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+
+			newResource := func() resource.Resource {
+				return &testprovider.ResourceWithBatchRead{
+					Resource: &testprovider.Resource{
+						ReadMethod: func(_ context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.AddError("Unexpected Read", "Read should not be called.")
+						},
+					},
+					BatchReadSettingsMethod: func(_ context.Context) resource.BatchReadSettings {
+						return testCase.settings
+					},
+					BatchReadMethod: func(ctx context.Context, req resource.BatchReadRequest, resp *resource.BatchReadResponse) {
+						mu.Lock()
+						batchSizes = append(batchSizes, len(req.Requests))
+						mu.Unlock()
+
+						if len(req.Requests) != len(resp.Responses) {
+							t.Errorf("expected %d responses, got %d", len(req.Requests), len(resp.Responses))
+						}
+
+						resp.Diagnostics = testCase.batchDiagnostics
+
+						for i, readReq := range req.Requests {
+							var data testModel
+
+							resp.Responses[i].Diagnostics.Append(readReq.State.Get(ctx, &data)...)
+
+							data.Value = types.StringValue("read-" + data.ID.ValueString())
+
+							resp.Responses[i].Diagnostics.Append(resp.Responses[i].State.Set(ctx, &data)...)
+						}
+					},
+				}
+			}
+
+			responses := make([]*fwserver.ReadResourceResponse, testCase.requests)
+
+			var wg sync.WaitGroup
+
+			for i := 0; i < testCase.requests; i++ {
+				wg.Add(1)
+
+				go func(i int) {
+					defer wg.Done()
+
+					id := string(rune('a' + i))
+
+					req := &fwserver.ReadResourceRequest{
+						CurrentState: &tfsdk.State{
+							Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+								"id":    tftypes.NewValue(tftypes.String, id),
+								"value": tftypes.NewValue(tftypes.String, nil),
+							}),
+							Schema: testSchema,
+						},
+						Resource: newResource(),
+						TypeName: "test_resource",
+					}
+
+					responses[i] = &fwserver.ReadResourceResponse{}
+
+					server.ReadResource(context.Background(), req, responses[i])
+				}(i)
+			}
+
+			wg.Wait()
+
+			if diff := cmp.Diff(batchSizes, testCase.expectedBatchSizes); diff != "" {
+				t.Errorf("unexpected batch sizes difference: %s", diff)
+			}
+
+			for i, resp := range responses {
+				id := string(rune('a' + i))
+
+				if diff := cmp.Diff(resp.Diagnostics, testCase.batchDiagnostics); diff != "" {
+					t.Errorf("unexpected diagnostics difference for %s: %s", id, diff)
+				}
+
+				if resp.NewState == nil {
+					t.Fatalf("expected new state for %s", id)
+				}
+
+				expectedState := tftypes.NewValue(testType, map[string]tftypes.Value{
+					"id":    tftypes.NewValue(tftypes.String, id),
+					"value": tftypes.NewValue(tftypes.String, "read-"+id),
+				})
+
+				if !testCase.batchDiagnostics.HasError() && !resp.NewState.Raw.Equal(expectedState) {
+					t.Errorf("unexpected new state for %s: %s", id, resp.NewState.Raw)
+				}
+			}
+		})
+	}
+}
+
+func TestServerReadResource_BatchReadContextDone(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	batchReadCtxErrs := make(chan error, 1)
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{},
+	}
+
+	newRequest := func(id string) *fwserver.ReadResourceRequest {
+		return &fwserver.ReadResourceRequest{
+			CurrentState: &tfsdk.State{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, id),
+				}),
+				Schema: testSchema,
+			},
+			Resource: &testprovider.ResourceWithBatchRead{
+				Resource: &testprovider.Resource{},
+				BatchReadSettingsMethod: func(_ context.Context) resource.BatchReadSettings {
+					return resource.BatchReadSettings{
+						Window:  time.Minute,
+						MaxSize: 2,
+					}
+				},
+				BatchReadMethod: func(ctx context.Context, req resource.BatchReadRequest, resp *resource.BatchReadResponse) {
+					batchReadCtxErrs <- ctx.Err()
+
+					for i := range req.Requests {
+						resp.Responses[i].Diagnostics.AddWarning("Batch Read", "Read in batch.")
+					}
+				},
+			},
+			TypeName: "test_resource",
+		}
+	}
+
+	// The first RPC starts the batch, then is canceled while waiting for it.
+	ctx, cancel := context.WithCancel(context.Background())
+	canceledResp := &fwserver.ReadResourceResponse{}
+	canceledDone := make(chan struct{})
+
+	go func() {
+		defer close(canceledDone)
+
+		server.ReadResource(ctx, newRequest("a"), canceledResp)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-canceledDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected canceled RPC to stop waiting for its batch")
+	}
+
+	expectedCanceledDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Resource Read Interrupted",
+			"The resource read was interrupted while waiting for other reads of the resource type to be batched together. "+
+				"Terraform may have been canceled or the read may have exceeded its timeout.\n\n"+
+				"Error: context canceled",
+		),
+	}
+
+	if diff := cmp.Diff(canceledResp.Diagnostics, expectedCanceledDiags); diff != "" {
+		t.Errorf("unexpected canceled RPC diagnostics difference: %s", diff)
+	}
+
+	// The second RPC completes the batch, which must not be canceled with
+	// the first RPC.
+	resp := &fwserver.ReadResourceResponse{}
+
+	server.ReadResource(context.Background(), newRequest("b"), resp)
+
+	if err := <-batchReadCtxErrs; err != nil {
+		t.Errorf("unexpected BatchRead context error: %s", err)
+	}
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewWarningDiagnostic("Batch Read", "Read in batch."),
+	}
+
+	if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(canceledResp.Diagnostics, expectedCanceledDiags); diff != "" {
+		t.Errorf("unexpected canceled RPC diagnostics difference after BatchRead: %s", diff)
+	}
+}
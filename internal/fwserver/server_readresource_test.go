@@ -6,7 +6,9 @@ package fwserver_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -17,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -87,6 +90,42 @@ func TestServerReadResource(t *testing.T) {
 		},
 	}
 
+	testSchemaSensitive := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+
+	testSensitiveValueDecode := func(_ context.Context, req resource.SensitiveValueTransformRequest, resp *resource.SensitiveValueTransformResponse) {
+		value, ok := strings.CutPrefix(req.Value.ValueString(), "encoded-")
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(req.Path, "unexpected decode value", req.Value.ValueString())
+			return
+		}
+
+		resp.Value = types.StringValue(value)
+	}
+
+	testSensitiveValueEncode := func(_ context.Context, req resource.SensitiveValueTransformRequest, resp *resource.SensitiveValueTransformResponse) {
+		resp.Value = types.StringValue("encoded-" + req.Value.ValueString())
+	}
+
+	testCurrentStateSensitive := &tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_computed": tftypes.NewValue(tftypes.String, "encoded-test-currentstate-value"),
+			"test_required": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+		}),
+		Schema: testSchemaSensitive,
+	}
+
 	testConfig := &tfsdk.Config{
 		Raw:    testCurrentStateValue,
 		Schema: testSchema,
@@ -220,6 +259,133 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testEmptyPrivate,
 			},
 		},
+		"request-currentstate-ProviderWithResourceInterceptors": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithResourceInterceptors{
+					Provider: &testprovider.Provider{},
+					ResourceInterceptorsMethod: func(_ context.Context) []resource.Interceptor {
+						return []resource.Interceptor{
+							{
+								Read: func(ctx context.Context, info resource.InterceptorInfo, req resource.ReadRequest, resp *resource.ReadResponse, next resource.ReadFunc) {
+									resp.Diagnostics.AddWarning("interceptor", "before "+info.TypeName)
+									next(ctx, req, resp)
+									resp.Diagnostics.AddWarning("interceptor", "after")
+								},
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.AddWarning("resource", "read")
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("interceptor", "before test_resource"),
+					diag.NewWarningDiagnostic("resource", "read"),
+					diag.NewWarningDiagnostic("interceptor", "after"),
+				},
+				NewState: testCurrentState,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"request-currentstate-ResourceWithSensitiveValueTransform-unchanged": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentStateSensitive,
+				Resource: &testprovider.ResourceWithSensitiveValueTransform{
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							var data struct {
+								TestComputed types.String `tfsdk:"test_computed"`
+								TestRequired types.String `tfsdk:"test_required"`
+							}
+
+							resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+							if data.TestComputed.ValueString() != "test-currentstate-value" {
+								resp.Diagnostics.AddError("unexpected req.State value", data.TestComputed.ValueString())
+							}
+
+							resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+						},
+					},
+					DecodeSensitiveValueMethod: testSensitiveValueDecode,
+					EncodeSensitiveValueMethod: func(_ context.Context, req resource.SensitiveValueTransformRequest, resp *resource.SensitiveValueTransformResponse) {
+						resp.Diagnostics.AddError("unexpected EncodeSensitiveValue call", "unchanged values should keep the encoded prior state value")
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testCurrentStateSensitive,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"request-currentstate-ResourceWithSensitiveValueTransform-changed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentStateSensitive,
+				Resource: &testprovider.ResourceWithSensitiveValueTransform{
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), "test-newstate-value")...)
+						},
+					},
+					DecodeSensitiveValueMethod: testSensitiveValueDecode,
+					EncodeSensitiveValueMethod: testSensitiveValueEncode,
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "encoded-test-newstate-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+					}),
+					Schema: testSchemaSensitive,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"request-currentstate-ResourceWithSensitiveValueTransform-decode-diagnostic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw:    testCurrentStateSensitive.Raw,
+					Schema: testSchemaSensitive,
+				},
+				Resource: &testprovider.ResourceWithSensitiveValueTransform{
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.AddError("unexpected Read call", "decode errors should prevent Read")
+						},
+					},
+					DecodeSensitiveValueMethod: func(_ context.Context, req resource.SensitiveValueTransformRequest, resp *resource.SensitiveValueTransformResponse) {
+						resp.Diagnostics.AddAttributeError(req.Path, "test summary", "test detail")
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_computed"),
+						"test summary",
+						"test detail",
+					),
+				},
+			},
+		},
 		"request-providermeta": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -520,6 +686,83 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testEmptyPrivate,
 			},
 		},
+		"response-state-ReadModel": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						type model struct {
+							TestComputed types.String `tfsdk:"test_computed"`
+							TestRequired types.String `tfsdk:"test_required"`
+						}
+
+						resource.ReadModel(ctx, req, resp, func(_ context.Context, state model) (*model, error) {
+							state.TestComputed = types.StringValue("test-newstate-value")
+
+							return &state, nil
+						})
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testNewState,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"response-state-ReadModel-nil": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						type model struct {
+							TestComputed types.String `tfsdk:"test_computed"`
+							TestRequired types.String `tfsdk:"test_required"`
+						}
+
+						resource.ReadModel(ctx, req, resp, func(_ context.Context, _ model) (*model, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testNewStateRemoved,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"response-state-ReadModel-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						type model struct {
+							TestComputed types.String `tfsdk:"test_computed"`
+							TestRequired types.String `tfsdk:"test_required"`
+						}
+
+						resource.ReadModel(ctx, req, resp, func(_ context.Context, _ model) (*model, error) {
+							return nil, errors.New("test error")
+						})
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("Error Reading Resource", "test error"),
+				},
+				NewState: testCurrentState,
+				Private:  testEmptyPrivate,
+			},
+		},
 		"response-state-semantic-equality": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
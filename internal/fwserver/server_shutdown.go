@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// Shutdown calls the Provider.Shutdown() method, if implemented. The method is
+// only called once; later calls return no diagnostics. As there is no RPC
+// response to include them in, any diagnostics are also logged.
+func (s *Server) Shutdown(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	s.shutdownOnce.Do(func() {
+		providerWithShutdown, ok := s.Provider.(provider.ProviderWithShutdown)
+
+		if !ok {
+			return
+		}
+
+		logging.FrameworkTrace(ctx, "Provider implements ProviderWithShutdown")
+
+		resp := &provider.ShutdownResponse{}
+
+		logging.FrameworkTrace(ctx, "Calling provider defined Provider Shutdown")
+		providerWithShutdown.Shutdown(ctx, provider.ShutdownRequest{}, resp)
+		logging.FrameworkTrace(ctx, "Called provider defined Provider Shutdown")
+
+		for _, d := range resp.Diagnostics {
+			switch d.Severity() {
+			case diag.SeverityError:
+				logging.FrameworkError(ctx, "Provider Shutdown error: "+d.Summary()+": "+d.Detail())
+			default:
+				logging.FrameworkWarn(ctx, "Provider Shutdown warning: "+d.Summary()+": "+d.Detail())
+			}
+		}
+
+		diags = resp.Diagnostics
+	})
+
+	return diags
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+func TestServerShutdown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server        *fwserver.Server
+		expectedDiags diag.Diagnostics
+	}{
+		"provider": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+		},
+		"providerwithshutdown": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithShutdown{
+					ShutdownMethod: func(_ context.Context, _ provider.ShutdownRequest, resp *provider.ShutdownResponse) {
+						resp.Diagnostics.AddWarning("warning summary", "warning detail")
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("warning summary", "warning detail"),
+				diag.NewErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.server.Shutdown(context.Background())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerShutdown_once(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+
+	server := &fwserver.Server{
+		Provider: &testprovider.ProviderWithShutdown{
+			ShutdownMethod: func(_ context.Context, _ provider.ShutdownRequest, _ *provider.ShutdownResponse) {
+				calls++
+			},
+		},
+	}
+
+	server.Shutdown(context.Background())
+	server.Shutdown(context.Background())
+
+	if calls != 1 {
+		t.Errorf("expected 1 Shutdown call, got %d", calls)
+	}
+}
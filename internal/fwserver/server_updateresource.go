@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -27,6 +28,10 @@ type UpdateResourceRequest struct {
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema fwschema.Schema
 	Resource       resource.Resource
+
+	// TypeName is the resource type name, which is passed to resource
+	// interceptors.
+	TypeName string
 }
 
 // UpdateResourceResponse is the framework server response for an update request
@@ -53,7 +58,9 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		spanCtx, span := tracing.Start(ctx, "Resource Configure")
+		resourceWithConfigure.Configure(spanCtx, configureReq, &configureResp)
+		span.End()
 		logging.FrameworkTrace(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -91,13 +98,35 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 	}
 
 	if req.PlannedState != nil {
-		updateReq.Plan = *req.PlannedState
+		plannedStateRaw, diags := resourceSensitiveValueDecode(ctx, req.Resource, req.PlannedState.Schema, req.PlannedState.Raw)
+
+		resp.Diagnostics.Append(diags...)
+
+		updateReq.Plan = tfsdk.Plan{
+			Schema: req.PlannedState.Schema,
+			Raw:    plannedStateRaw,
+		}
 	}
 
+	encodedPriorStateRaw := nullSchemaData
+
 	if req.PriorState != nil {
-		updateReq.State = *req.PriorState
+		encodedPriorStateRaw = req.PriorState.Raw
+
+		priorStateRaw, diags := resourceSensitiveValueDecode(ctx, req.Resource, req.PriorState.Schema, req.PriorState.Raw)
+
+		resp.Diagnostics.Append(diags...)
+
+		updateReq.State = tfsdk.State{
+			Schema: req.PriorState.Schema,
+			Raw:    priorStateRaw,
+		}
 		// Require explicit provider updates for tracking successful updates.
-		updateResp.State = *req.PriorState
+		updateResp.State = updateReq.State
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if req.ProviderMeta != nil {
@@ -118,13 +147,31 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		resp.Private = req.PlannedPrivate
 	}
 
+	timeoutCtx, cancel, timeoutDiags := resourceTimeoutContext(ctx, updateReq.Plan.Raw, "update", s.ResourceTimeouts.Update)
+	defer cancel()
+
+	resp.Diagnostics.Append(timeoutDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	update := interceptedUpdateFunc(s.ResourceInterceptors(ctx), resource.InterceptorInfo{TypeName: req.TypeName}, req.Resource.Update)
+
 	logging.FrameworkTrace(ctx, "Calling provider defined Resource Update")
-	req.Resource.Update(ctx, updateReq, &updateResp)
+	spanCtx, span := tracing.Start(s.errorConvertersContext(timeoutCtx), "Resource Update")
+	update(spanCtx, updateReq, &updateResp)
+	span.End()
 	logging.FrameworkTrace(ctx, "Called provider defined Resource Update")
 
 	resp.Diagnostics = updateResp.Diagnostics
 	resp.NewState = &updateResp.State
 
+	newStateRaw, diags := resourceSensitiveValueEncode(ctx, req.Resource, resp.NewState.Schema, resp.NewState.Raw, encodedPriorStateRaw)
+
+	resp.Diagnostics.Append(diags...)
+	resp.NewState.Raw = newStateRaw
+
 	if !resp.Diagnostics.HasError() && updateResp.State.Raw.Equal(nullSchemaData) {
 		resp.Diagnostics.AddError(
 			"Missing Resource State After Update",
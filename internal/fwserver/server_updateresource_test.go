@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -45,6 +46,18 @@ func TestServerUpdateResource(t *testing.T) {
 		},
 	}
 
+	testSchemaSensitive := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
 	testSchemaWithSemanticEquals := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"test_computed": schema.StringAttribute{
@@ -250,6 +263,68 @@ func TestServerUpdateResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"request-plannedstate-ResourceWithSensitiveValueTransform": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpdateResourceRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchemaSensitive,
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "encoded-test-priorstate-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchemaSensitive,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "encoded-test-priorstate-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
+					}),
+					Schema: testSchemaSensitive,
+				},
+				ResourceSchema: testSchemaSensitive,
+				Resource: &testprovider.ResourceWithSensitiveValueTransform{
+					Resource: &testprovider.Resource{
+						UpdateMethod: func(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+							var data testSchemaData
+
+							resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+							if data.TestComputed.ValueString() != "test-priorstate-value" {
+								resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.ValueString())
+							}
+
+							resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+						},
+					},
+					DecodeSensitiveValueMethod: func(_ context.Context, req resource.SensitiveValueTransformRequest, resp *resource.SensitiveValueTransformResponse) {
+						resp.Value = types.StringValue(strings.TrimPrefix(req.Value.ValueString(), "encoded-"))
+					},
+					EncodeSensitiveValueMethod: func(_ context.Context, req resource.SensitiveValueTransformRequest, resp *resource.SensitiveValueTransformResponse) {
+						// Non-deterministic encodings must keep the prior
+						// state value to match the plan.
+						resp.Value = types.StringValue("encoded-different-" + req.Value.ValueString())
+					},
+				},
+			},
+			expectedResponse: &fwserver.UpdateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "encoded-test-priorstate-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchemaSensitive,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"request-priorstate": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -57,8 +58,8 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 	}
 
 	// Define options to be used when unmarshalling raw state.
-	// IgnoreUndefinedAttributes will silently skip over fields in the JSON
-	// that do not have a matching entry in the schema.
+	// IgnoreUndefinedAttributes will skip over fields in the JSON that do not
+	// have a matching entry in the schema, which are logged beforehand.
 	unmarshalOpts := tfprotov6.UnmarshalOpts{
 		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
 			IgnoreUndefinedAttributes: true,
@@ -88,7 +89,22 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 
 		resourceSchemaType := req.ResourceSchema.Type().TerraformType(ctx)
 
-		rawStateValue, err := req.RawState.UnmarshalWithOpts(resourceSchemaType, unmarshalOpts)
+		// Move prior state values of renamed attributes before unmarshalling,
+		// which would otherwise ignore them as undefined attributes.
+		rawState, err := renameRawStateAttributes(ctx, req.ResourceSchema, req.RawState)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Previously Saved State for UpgradeResourceState",
+				"There was an error reading the saved resource state to move renamed attribute values. "+
+					"Please report this to the provider developer:\n\n"+err.Error(),
+			)
+			return
+		}
+
+		logUndefinedRawStateAttributes(ctx, resourceSchemaType, rawState)
+
+		rawStateValue, err := rawState.UnmarshalWithOpts(resourceSchemaType, unmarshalOpts)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -118,7 +134,9 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		spanCtx, span := tracing.Start(ctx, "Resource Configure")
+		resourceWithConfigure.Configure(spanCtx, configureReq, &configureResp)
+		span.End()
 		logging.FrameworkTrace(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -172,6 +190,8 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 
 		priorSchemaType := resourceStateUpgrader.PriorSchema.Type().TerraformType(ctx)
 
+		logUndefinedRawStateAttributes(ctx, priorSchemaType, req.RawState)
+
 		rawStateValue, err := req.RawState.UnmarshalWithOpts(priorSchemaType, unmarshalOpts)
 
 		if err != nil {
@@ -202,7 +222,9 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 	// any errors.
 
 	logging.FrameworkTrace(ctx, "Calling provider defined StateUpgrader")
-	resourceStateUpgrader.StateUpgrader(ctx, upgradeResourceStateRequest, &upgradeResourceStateResponse)
+	spanCtx, span := tracing.Start(ctx, "StateUpgrader")
+	resourceStateUpgrader.StateUpgrader(spanCtx, upgradeResourceStateRequest, &upgradeResourceStateResponse)
+	span.End()
 	logging.FrameworkTrace(ctx, "Called provider defined StateUpgrader")
 
 	resp.Diagnostics.Append(upgradeResourceStateResponse.Diagnostics...)
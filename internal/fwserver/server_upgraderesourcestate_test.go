@@ -42,6 +42,29 @@ func TestServerUpgradeResourceState(t *testing.T) {
 	}
 	schemaType := testSchema.Type().TerraformType(ctx)
 
+	testSchemaRenamedFrom := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"nested_attribute": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"new_nested": schema.StringAttribute{
+						Optional:    true,
+						RenamedFrom: "old_nested",
+					},
+				},
+				Optional: true,
+			},
+			"new_attribute": schema.StringAttribute{
+				Required:    true,
+				RenamedFrom: "old_attribute",
+			},
+		},
+		Version: 1,
+	}
+	schemaTypeRenamedFrom := testSchemaRenamedFrom.Type().TerraformType(ctx)
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.UpgradeResourceStateRequest
@@ -733,6 +756,74 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				},
 			},
 		},
+		"Version-current-json-RenamedFrom": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":            "test-id-value",
+					"old_attribute": "test-value",
+					"nested_attribute": map[string]interface{}{
+						"old_nested": "test-nested-value",
+					},
+				}),
+				ResourceSchema: testSchemaRenamedFrom,
+				Resource:       &testprovider.Resource{},
+				Version:        1, // Must match current tfsdk.Schema version to trigger framework implementation
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaTypeRenamedFrom, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "test-id-value"),
+						"nested_attribute": tftypes.NewValue(
+							tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"new_nested": tftypes.String,
+								},
+							},
+							map[string]tftypes.Value{
+								"new_nested": tftypes.NewValue(tftypes.String, "test-nested-value"),
+							},
+						),
+						"new_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+					}),
+					Schema: testSchemaRenamedFrom,
+				},
+			},
+		},
+		"Version-current-json-RenamedFrom-current-name-present": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":            "test-id-value",
+					"new_attribute": "test-new-value",
+					"old_attribute": "test-old-value",
+				}),
+				ResourceSchema: testSchemaRenamedFrom,
+				Resource:       &testprovider.Resource{},
+				Version:        1, // Must match current tfsdk.Schema version to trigger framework implementation
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaTypeRenamedFrom, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "test-id-value"),
+						"nested_attribute": tftypes.NewValue(
+							tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"new_nested": tftypes.String,
+								},
+							},
+							nil,
+						),
+						"new_attribute": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchemaRenamedFrom,
+				},
+			},
+		},
 		"Version-not-implemented": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
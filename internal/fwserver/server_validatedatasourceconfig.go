@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -40,7 +41,9 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 		configureResp := datasource.ConfigureResponse{}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined DataSource Configure")
-		dataSourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		spanCtx, span := tracing.Start(ctx, "DataSource Configure")
+		dataSourceWithConfigure.Configure(spanCtx, configureReq, &configureResp)
+		span.End()
 		logging.FrameworkTrace(ctx, "Called provider defined DataSource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -69,7 +72,9 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 					logging.KeyDescription: configValidator.Description(ctx),
 				},
 			)
-			configValidator.ValidateDataSource(ctx, vdscReq, vdscResp)
+			spanCtx, span := tracing.Start(ctx, "ConfigValidator", tracing.Description(ctx, configValidator))
+			configValidator.ValidateDataSource(spanCtx, vdscReq, vdscResp)
+			span.End()
 			logging.FrameworkTrace(
 				ctx,
 				"Called provider defined ConfigValidator",
@@ -90,14 +95,17 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 		vdscResp := &datasource.ValidateConfigResponse{}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined DataSource ValidateConfig")
-		dataSource.ValidateConfig(ctx, vdscReq, vdscResp)
+		spanCtx, span := tracing.Start(ctx, "DataSource ValidateConfig")
+		dataSource.ValidateConfig(spanCtx, vdscReq, vdscResp)
+		span.End()
 		logging.FrameworkTrace(ctx, "Called provider defined DataSource ValidateConfig")
 
 		resp.Diagnostics.Append(vdscResp.Diagnostics...)
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config:             *req.Config,
+		DeprecationSummary: "Data Source Deprecated",
 	}
 	// Instantiate a new response for each request to prevent validators
 	// from modifying or removing diagnostics.
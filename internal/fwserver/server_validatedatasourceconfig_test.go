@@ -47,6 +47,20 @@ func TestServerValidateDataSourceConfig(t *testing.T) {
 		Schema: testSchema,
 	}
 
+	testSchemaDeprecated := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		DeprecationMessage: "Use something else instead.",
+	}
+
+	testConfigDeprecated := tfsdk.Config{
+		Raw:    testValue,
+		Schema: testSchemaDeprecated,
+	}
+
 	testSchemaAttributeValidator := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"test": schema.StringAttribute{
@@ -114,6 +128,27 @@ func TestServerValidateDataSourceConfig(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ValidateDataSourceConfigResponse{},
 		},
+		"request-config-deprecated": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateDataSourceConfigRequest{
+				Config: &testConfigDeprecated,
+				DataSource: &testprovider.DataSource{
+					SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+						resp.Schema = testSchemaDeprecated
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateDataSourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Data Source Deprecated",
+						"Use something else instead.",
+					),
+				},
+			},
+		},
 		"request-config-AttributeValidator": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
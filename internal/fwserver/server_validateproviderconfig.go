@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -50,7 +51,9 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 					logging.KeyDescription: configValidator.Description(ctx),
 				},
 			)
-			configValidator.ValidateProvider(ctx, vpcReq, vpcRes)
+			spanCtx, span := tracing.Start(ctx, "ConfigValidator", tracing.Description(ctx, configValidator))
+			configValidator.ValidateProvider(spanCtx, vpcReq, vpcRes)
+			span.End()
 			logging.FrameworkTrace(
 				ctx,
 				"Called provider defined ConfigValidator",
@@ -71,14 +74,17 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 		vpcRes := &provider.ValidateConfigResponse{}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined Provider ValidateConfig")
-		providerWithValidateConfig.ValidateConfig(ctx, vpcReq, vpcRes)
+		spanCtx, span := tracing.Start(ctx, "Provider ValidateConfig")
+		providerWithValidateConfig.ValidateConfig(spanCtx, vpcReq, vpcRes)
+		span.End()
 		logging.FrameworkTrace(ctx, "Called provider defined Provider ValidateConfig")
 
 		resp.Diagnostics.Append(vpcRes.Diagnostics...)
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config:             *req.Config,
+		DeprecationSummary: "Provider Deprecated",
 	}
 	// Instantiate a new response for each request to prevent validators
 	// from modifying or removing diagnostics.
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -40,7 +41,9 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		spanCtx, span := tracing.Start(ctx, "Resource Configure")
+		resourceWithConfigure.Configure(spanCtx, configureReq, &configureResp)
+		span.End()
 		logging.FrameworkTrace(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -69,7 +72,9 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 					logging.KeyDescription: configValidator.Description(ctx),
 				},
 			)
-			configValidator.ValidateResource(ctx, vdscReq, vdscResp)
+			spanCtx, span := tracing.Start(ctx, "ResourceConfigValidator", tracing.Description(ctx, configValidator))
+			configValidator.ValidateResource(spanCtx, vdscReq, vdscResp)
+			span.End()
 			logging.FrameworkTrace(
 				ctx,
 				"Called provider defined ResourceConfigValidator",
@@ -90,14 +95,17 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		vdscResp := &resource.ValidateConfigResponse{}
 
 		logging.FrameworkTrace(ctx, "Calling provider defined Resource ValidateConfig")
-		resourceWithValidateConfig.ValidateConfig(ctx, vdscReq, vdscResp)
+		spanCtx, span := tracing.Start(ctx, "Resource ValidateConfig")
+		resourceWithValidateConfig.ValidateConfig(spanCtx, vdscReq, vdscResp)
+		span.End()
 		logging.FrameworkTrace(ctx, "Called provider defined Resource ValidateConfig")
 
 		resp.Diagnostics.Append(vdscResp.Diagnostics...)
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config:             *req.Config,
+		DeprecationSummary: "Resource Deprecated",
 	}
 	// Instantiate a new response for each request to prevent validators
 	// from modifying or removing diagnostics.
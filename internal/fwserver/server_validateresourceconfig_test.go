@@ -47,6 +47,20 @@ func TestServerValidateResourceConfig(t *testing.T) {
 		Schema: testSchema,
 	}
 
+	testSchemaDeprecated := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		DeprecationMessage: "Use something else instead.",
+	}
+
+	testConfigDeprecated := tfsdk.Config{
+		Raw:    testValue,
+		Schema: testSchemaDeprecated,
+	}
+
 	testSchemaAttributeValidator := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"test": schema.StringAttribute{
@@ -114,6 +128,27 @@ func TestServerValidateResourceConfig(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
 		},
+		"request-config-deprecated": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigDeprecated,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaDeprecated
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Resource Deprecated",
+						"Use something else instead.",
+					),
+				},
+			},
+		},
 		"request-config-AttributeValidator": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"context"
+)
+
+// attributeAccessLoggingKey is the context key for enabling attribute access
+// logging.
+type attributeAccessLoggingKey struct{}
+
+// WithAttributeAccessLogging returns a new Context with attribute access
+// logging enabled, which logs every provider read and write of schema data
+// attribute values.
+func WithAttributeAccessLogging(ctx context.Context) context.Context {
+	return context.WithValue(ctx, attributeAccessLoggingKey{}, true)
+}
+
+// AttributeAccessLogging returns true if attribute access logging is enabled
+// in the Context.
+func AttributeAccessLogging(ctx context.Context) bool {
+	enabled, ok := ctx.Value(attributeAccessLoggingKey{}).(bool)
+
+	return ok && enabled
+}
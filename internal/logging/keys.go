@@ -15,6 +15,14 @@ const (
 	// as parent.0.child in this project.
 	KeyAttributePath = "tf_attribute_path"
 
+	// Human readable attribute value representation, which is redacted for
+	// sensitive attributes.
+	KeyAttributeValue = "tf_attribute_value"
+
+	// Human readable prior attribute value representation, which is redacted
+	// for sensitive attributes.
+	KeyAttributePriorValue = "tf_attribute_prior_value"
+
 	// The type of data source being operated on, such as "archive_file"
 	KeyDataSourceType = "tf_data_source_type"
 
@@ -25,12 +33,19 @@ const (
 	// implement the Description() method, such as validators.
 	KeyDescription = "description"
 
+	// Attribute paths of prior resource state values which are not defined in
+	// the current schema and were dropped while reading the state.
+	KeyDroppedAttributePaths = "tf_dropped_attribute_paths"
+
 	// Underlying Go error string when logging an error.
 	KeyError = "error"
 
 	// The name of function being operated on, such as "parse_xyz"
 	KeyFunctionName = "tf_function_name"
 
+	// The key of a resource mutex lock, such as a parent object identifier.
+	KeyResourceMutexKey = "tf_resource_mutex_key"
+
 	// The type of resource being operated on, such as "random_pet"
 	KeyResourceType = "tf_resource_type"
 
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"context"
+)
+
+// readDriftLoggingKey is the context key for enabling resource read drift
+// logging.
+type readDriftLoggingKey struct{}
+
+// WithReadDriftLogging returns a new Context with resource read drift
+// logging enabled, which logs every attribute value that differs between
+// the prior state and the refreshed state after a resource Read.
+func WithReadDriftLogging(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readDriftLoggingKey{}, true)
+}
+
+// ReadDriftLogging returns true if resource read drift logging is enabled in
+// the Context.
+func ReadDriftLogging(ctx context.Context) bool {
+	enabled, ok := ctx.Value(readDriftLoggingKey{}).(bool)
+
+	return ok && enabled
+}
@@ -245,6 +245,28 @@ type ProviderData struct {
 	data map[string][]byte
 }
 
+// Clone returns a copy of the ProviderData which does not share its data with
+// the receiver.
+func (d *ProviderData) Clone() *ProviderData {
+	if d == nil {
+		return nil
+	}
+
+	if d.data == nil {
+		return &ProviderData{}
+	}
+
+	data := make(map[string][]byte, len(d.data))
+
+	for key, value := range d.data {
+		data[key] = append([]byte(nil), value...)
+	}
+
+	return &ProviderData{
+		data: data,
+	}
+}
+
 // Equal returns true if the given ProviderData is exactly equivalent. The
 // internal data is compared byte-for-byte, not accounting for semantic
 // equivalency such as JSON whitespace or property reordering.
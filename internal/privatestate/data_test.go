@@ -645,6 +645,56 @@ func TestProviderDataEqual(t *testing.T) {
 	}
 }
 
+func TestProviderDataClone(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerData *ProviderData
+	}{
+		"nil": {
+			providerData: nil,
+		},
+		"empty": {
+			providerData: EmptyProviderData(context.Background()),
+		},
+		"data": {
+			providerData: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test": []byte(`{"subtest":true}`)}),
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.providerData.Clone()
+
+			if !got.Equal(testCase.providerData) {
+				t.Fatalf("expected clone to equal original")
+			}
+
+			if got == nil {
+				return
+			}
+
+			// The clone must not share data with the original.
+			diags := got.SetKey(context.Background(), "test", []byte(`{"subtest":false}`))
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if got.Equal(testCase.providerData) {
+				t.Errorf("expected original to be unchanged")
+			}
+		})
+	}
+}
+
 func TestProviderData_GetKey(t *testing.T) {
 	t.Parallel()
 
@@ -6,9 +6,17 @@ package proto5server
 import (
 	"context"
 	"sync"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
 )
 
 var _ tfprotov5.ProviderServer = &Server{}
@@ -17,8 +25,59 @@ var _ tfprotov5.ProviderServer = &Server{}
 type Server struct {
 	FrameworkServer fwserver.Server
 
+	// RPCStartHook, if set, is called at the start of handling each RPC with
+	// the RPC name.
+	RPCStartHook func(ctx context.Context, rpc string)
+
+	// RPCFinishHook, if set, is called at the end of handling each RPC with
+	// the RPC name, the duration of handling, and the response diagnostics.
+	RPCFinishHook func(ctx context.Context, rpc string, duration time.Duration, diags diag.Diagnostics)
+
+	// MetricsRecorder, if set, records the name, duration, and response
+	// diagnostic counts of each RPC.
+	MetricsRecorder metrics.Recorder
+
+	// TracerProvider, if set, is used to create OpenTelemetry spans for each
+	// RPC and provider defined logic, such as validators.
+	TracerProvider trace.TracerProvider
+
+	// AttributeAccessLogging, if true, enables debug logging of every
+	// provider read and write of configuration, plan, and state attribute
+	// values.
+	AttributeAccessLogging bool
+
+	// DecodedModelCaching, if true, enables caching of models decoded by
+	// configuration, plan, and state Get calls for the duration of each RPC.
+	DecodedModelCaching bool
+
+	// ReadDriftLogging, if true, enables DEBUG level framework logging of
+	// attribute values which differ between the prior state and the
+	// refreshed state after each resource Read.
+	ReadDriftLogging bool
+
+	// ReportAllModelMismatches, if true, causes configuration, plan, and
+	// state Get calls to report every mismatch between the schema and the
+	// target struct, rather than only the first.
+	ReportAllModelMismatches bool
+
+	// RPCConcurrencyLimits, if set, limits the number of concurrent
+	// executions of each RPC by name, such as "PlanResourceChange". RPCs
+	// above the limit wait until an execution finishes.
+	RPCConcurrencyLimits map[string]int
+
+	// MaxMessageSize, if greater than 0, is the maximum size in bytes of
+	// encoded state and plan data sent to Terraform. Larger data returns an
+	// error diagnostic instead. Defaults to the maximum message size
+	// Terraform receives from providers.
+	MaxMessageSize int
+
 	contextCancels   []context.CancelFunc
 	contextCancelsMu sync.Mutex
+
+	// rpcSemaphores contains a buffered channel for each RPC name in
+	// RPCConcurrencyLimits. Use the rpcSemaphore method to access.
+	rpcSemaphores   map[string]chan struct{}
+	rpcSemaphoresMu sync.Mutex
 }
 
 func (s *Server) registerContext(in context.Context) context.Context {
@@ -29,6 +88,136 @@ func (s *Server) registerContext(in context.Context) context.Context {
 	return ctx
 }
 
+// startRPC waits for the RPC concurrency limit, if any, starts a span for
+// the RPC, if a TracerProvider is set, and calls the RPCStartHook, if set. The
+// returned function, which accepts the response diagnostics, calls the
+// RPCFinishHook, if set, with those diagnostics, records the RPC with the
+// MetricsRecorder, if set, ends the span, and releases the RPC concurrency
+// limit. It is intended to be deferred, even when the returned diagnostics
+// contain an error because the context was cancelled while waiting for the
+// RPC concurrency limit, in which case the RPC must respond with those
+// diagnostics without further handling.
+func (s *Server) startRPC(ctx context.Context, rpc string, attributes ...tracing.Attribute) (context.Context, func(diag.Diagnostics), diag.Diagnostics) {
+	release, diags := s.acquireRPC(ctx, rpc)
+
+	ctx = tracing.InitContext(ctx, s.TracerProvider)
+	ctx, span := tracing.Start(ctx, rpc, attributes...)
+
+	if s.AttributeAccessLogging {
+		ctx = logging.WithAttributeAccessLogging(ctx)
+	}
+
+	ctx = fwschemadata.WithTransformCache(ctx)
+
+	if s.DecodedModelCaching {
+		ctx = fwschemadata.WithGetCache(ctx)
+	}
+
+	if s.ReadDriftLogging {
+		ctx = logging.WithReadDriftLogging(ctx)
+	}
+
+	if s.ReportAllModelMismatches {
+		ctx = fwschemadata.WithReportAllMismatches(ctx)
+	}
+
+	if s.MaxMessageSize > 0 {
+		ctx = fwschemadata.WithMessageSizeLimit(ctx, s.MaxMessageSize)
+	}
+
+	if s.RPCStartHook != nil {
+		s.RPCStartHook(ctx, rpc)
+	}
+
+	start := time.Now()
+
+	return ctx, func(diags diag.Diagnostics) {
+		duration := time.Since(start)
+
+		if s.RPCFinishHook != nil {
+			s.RPCFinishHook(ctx, rpc, duration, diags)
+		}
+
+		if s.MetricsRecorder != nil {
+			s.MetricsRecorder.RecordRPC(ctx, metrics.RPC{
+				Name:         rpc,
+				Duration:     duration,
+				ErrorCount:   diags.ErrorsCount(),
+				WarningCount: diags.WarningsCount(),
+			})
+		}
+
+		span.End()
+		release()
+	}, diags
+}
+
+// acquireRPC waits until the number of concurrent executions of the RPC is
+// below its limit in RPCConcurrencyLimits, if any, or the context is
+// cancelled, such as by StopProvider. The returned function releases the
+// execution and must always be called. If the context is cancelled first, an
+// error diagnostic is returned and the RPC must not be executed.
+func (s *Server) acquireRPC(ctx context.Context, rpc string) (func(), diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	semaphore := s.rpcSemaphore(rpc)
+
+	if semaphore == nil {
+		return func() {}, diags
+	}
+
+	select {
+	case semaphore <- struct{}{}:
+	default:
+		logging.FrameworkDebug(ctx, "Waiting for "+rpc+" concurrency limit")
+
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			logging.FrameworkDebug(ctx, "Context cancelled while waiting for "+rpc+" concurrency limit")
+
+			diags.AddError(
+				"RPC Cancelled",
+				"The "+rpc+" RPC was cancelled while waiting for the provider concurrency limit of the RPC. "+
+					"Terraform may have been cancelled or the provider may have been stopped.\n\n"+
+					"Error: "+ctx.Err().Error(),
+			)
+
+			return func() {}, diags
+		}
+	}
+
+	return func() {
+		<-semaphore
+	}, diags
+}
+
+// rpcSemaphore returns the semaphore for the RPC, or nil if the RPC has no
+// concurrency limit.
+func (s *Server) rpcSemaphore(rpc string) chan struct{} {
+	limit := s.RPCConcurrencyLimits[rpc]
+
+	if limit <= 0 {
+		return nil
+	}
+
+	s.rpcSemaphoresMu.Lock()
+	defer s.rpcSemaphoresMu.Unlock()
+
+	if s.rpcSemaphores == nil {
+		s.rpcSemaphores = make(map[string]chan struct{})
+	}
+
+	semaphore, ok := s.rpcSemaphores[rpc]
+
+	if !ok {
+		semaphore = make(chan struct{}, limit)
+		s.rpcSemaphores[rpc] = semaphore
+	}
+
+	return semaphore
+}
+
 func (s *Server) cancelRegisteredContexts(_ context.Context) {
 	s.contextCancelsMu.Lock()
 	defer s.contextCancelsMu.Unlock()
@@ -6,13 +6,19 @@ package proto5server
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 )
 
 func TestServerCancelInFlightContexts(t *testing.T) {
@@ -55,6 +61,43 @@ func TestServerCancelInFlightContexts(t *testing.T) {
 	// canceled, or we have an error reported
 }
 
+func TestServerRPCHooks(t *testing.T) {
+	t.Parallel()
+
+	var got []string
+
+	s := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{},
+		},
+		RPCStartHook: func(_ context.Context, rpc string) {
+			got = append(got, "start "+rpc)
+		},
+		RPCFinishHook: func(_ context.Context, rpc string, duration time.Duration, diags diag.Diagnostics) {
+			if duration < 0 {
+				t.Errorf("unexpected negative duration: %s", duration)
+			}
+
+			got = append(got, fmt.Sprintf("finish %s with %d errors", rpc, diags.ErrorsCount()))
+		},
+	}
+
+	_, err := s.GetMetadata(context.Background(), &tfprotov5.GetMetadataRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		"start GetMetadata",
+		"finish GetMetadata with 0 errors",
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
 func testNewSingleValueDynamicValue(t *testing.T, argumentValue tftypes.Value) *tfprotov5.DynamicValue {
 	t.Helper()
 
@@ -106,3 +149,128 @@ func testNewTfprotov6RawState(t *testing.T, jsonMap map[string]interface{}) *tfp
 		JSON: rawStateJSON,
 	}
 }
+
+func TestServerRPCConcurrencyLimits(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{
+		RPCConcurrencyLimits: map[string]int{
+			"PlanResourceChange": 2,
+		},
+	}
+
+	var mu sync.Mutex
+	var current, maximum int
+
+	wg := new(sync.WaitGroup)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, finishRPC, diags := s.startRPC(context.Background(), "PlanResourceChange")
+			defer finishRPC(diags)
+
+			if diags.HasError() {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+
+			mu.Lock()
+			current++
+
+			if current > maximum {
+				maximum = current
+			}
+
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			current--
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	if maximum != 2 {
+		t.Errorf("expected maximum of 2 concurrent executions, got: %d", maximum)
+	}
+
+	// RPCs without a limit are not blocked.
+	_, finishRPC, _ := s.startRPC(context.Background(), "ApplyResourceChange")
+	finishRPC(nil)
+
+	// Waiting for the limit stops when the context is cancelled.
+	_, finishFirst, _ := s.startRPC(context.Background(), "PlanResourceChange")
+	_, finishSecond, _ := s.startRPC(context.Background(), "PlanResourceChange")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, finishCancelled, diags := s.startRPC(ctx, "PlanResourceChange")
+
+	finishCancelled(diags)
+	finishSecond(nil)
+	finishFirst(nil)
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"RPC Cancelled",
+			"The PlanResourceChange RPC was cancelled while waiting for the provider concurrency limit of the RPC. "+
+				"Terraform may have been cancelled or the provider may have been stopped.\n\n"+
+				"Error: context canceled",
+		),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
+
+func TestServerRPCConcurrencyLimits_cancelled(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{},
+		},
+		RPCConcurrencyLimits: map[string]int{
+			"GetMetadata": 1,
+		},
+	}
+
+	_, finishRPC, _ := s.startRPC(context.Background(), "GetMetadata")
+	defer finishRPC(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	got, err := s.GetMetadata(ctx, &tfprotov5.GetMetadataRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfprotov5.GetMetadataResponse{
+		DataSources: []tfprotov5.DataSourceMetadata{},
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "RPC Cancelled",
+				Detail: "The GetMetadata RPC was cancelled while waiting for the provider concurrency limit of the RPC. " +
+					"Terraform may have been cancelled or the provider may have been stopped.\n\n" +
+					"Error: context canceled",
+			},
+		},
+		Functions: []tfprotov5.FunctionMetadata{},
+		Resources: []tfprotov5.ResourceMetadata{},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
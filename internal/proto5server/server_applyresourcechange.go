@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 )
 
@@ -18,8 +19,18 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto5Req *tfprotov5.A
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	ctx, finishRPC, diags := s.startRPC(ctx, "ApplyResourceChange", tracing.ResourceType(proto5Req.TypeName))
+
 	fwResp := &fwserver.ApplyResourceChangeResponse{}
 
+	defer func() { finishRPC(fwResp.Diagnostics) }()
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
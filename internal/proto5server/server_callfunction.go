@@ -8,11 +8,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 )
 
 // CallFunction satisfies the tfprotov5.ProviderServer interface.
@@ -20,8 +22,18 @@ func (s *Server) CallFunction(ctx context.Context, protoReq *tfprotov5.CallFunct
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	ctx, finishRPC, diags := s.startRPC(ctx, "CallFunction", tracing.FunctionName(protoReq.Name))
+
 	fwResp := &fwserver.CallFunctionResponse{}
 
+	defer func() { finishRPC(funcErrorDiagnostics(fwResp.Error)) }()
+
+	fwResp.Error = function.FuncErrorFromDiags(ctx, diags)
+
+	if fwResp.Error != nil {
+		return toproto5.CallFunctionResponse(ctx, fwResp), nil
+	}
+
 	serverFunction, err := s.FrameworkServer.Function(ctx, protoReq.Name)
 
 	fwResp.Error = err
@@ -53,3 +65,15 @@ func (s *Server) CallFunction(ctx context.Context, protoReq *tfprotov5.CallFunct
 
 	return toproto5.CallFunctionResponse(ctx, fwResp), nil
 }
+
+// funcErrorDiagnostics returns an error diagnostic for the function error, if
+// any, so CallFunction responses can be recorded by the MetricsRecorder.
+func funcErrorDiagnostics(funcErr *function.FuncError) diag.Diagnostics {
+	if funcErr == nil {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic("Function Error", funcErr.Error()),
+	}
+}
@@ -19,8 +19,18 @@ func (s *Server) ConfigureProvider(ctx context.Context, proto5Req *tfprotov5.Con
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	ctx, finishRPC, diags := s.startRPC(ctx, "ConfigureProvider")
+
 	fwResp := &provider.ConfigureResponse{}
 
+	defer func() { finishRPC(fwResp.Diagnostics) }()
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.ConfigureProviderResponse(ctx, fwResp), nil
+	}
+
 	providerSchema, diags := s.FrameworkServer.ProviderSchema(ctx)
 
 	fwResp.Diagnostics.Append(diags...)
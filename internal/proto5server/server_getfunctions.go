@@ -18,9 +18,19 @@ func (s *Server) GetFunctions(ctx context.Context, protoReq *tfprotov5.GetFuncti
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	ctx, finishRPC, diags := s.startRPC(ctx, "GetFunctions")
+
 	fwReq := fromproto5.GetFunctionsRequest(ctx, protoReq)
 	fwResp := &fwserver.GetFunctionsResponse{}
 
+	defer func() { finishRPC(fwResp.Diagnostics) }()
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.GetFunctionsResponse(ctx, fwResp), nil
+	}
+
 	s.FrameworkServer.GetFunctions(ctx, fwReq, fwResp)
 
 	return toproto5.GetFunctionsResponse(ctx, fwResp), nil
@@ -18,9 +18,19 @@ func (s *Server) GetMetadata(ctx context.Context, proto6Req *tfprotov5.GetMetada
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	ctx, finishRPC, diags := s.startRPC(ctx, "GetMetadata")
+
 	fwReq := fromproto5.GetMetadataRequest(ctx, proto6Req)
 	fwResp := &fwserver.GetMetadataResponse{}
 
+	defer func() { finishRPC(fwResp.Diagnostics) }()
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.GetMetadataResponse(ctx, fwResp), nil
+	}
+
 	s.FrameworkServer.GetMetadata(ctx, fwReq, fwResp)
 
 	return toproto5.GetMetadataResponse(ctx, fwResp), nil
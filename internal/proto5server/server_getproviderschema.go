@@ -18,9 +18,19 @@ func (s *Server) GetProviderSchema(ctx context.Context, proto5Req *tfprotov5.Get
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	ctx, finishRPC, diags := s.startRPC(ctx, "GetProviderSchema")
+
 	fwReq := fromproto5.GetProviderSchemaRequest(ctx, proto5Req)
 	fwResp := &fwserver.GetProviderSchemaResponse{}
 
+	defer func() { finishRPC(fwResp.Diagnostics) }()
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.GetProviderSchemaResponse(ctx, fwResp), nil
+	}
+
 	s.FrameworkServer.GetProviderSchema(ctx, fwReq, fwResp)
 
 	return toproto5.GetProviderSchemaResponse(ctx, fwResp), nil
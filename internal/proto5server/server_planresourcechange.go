@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 )
 
 // PlanResourceChange satisfies the tfprotov5.ProviderServer interface.
@@ -19,8 +20,18 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto5Req *tfprotov5.Pl
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	ctx, finishRPC, diags := s.startRPC(ctx, "PlanResourceChange", tracing.ResourceType(proto5Req.TypeName))
+
 	fwResp := &fwserver.PlanResourceChangeResponse{}
 
+	defer func() { finishRPC(fwResp.Diagnostics) }()
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.PlanResourceChangeResponse(ctx, fwResp), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -18,8 +18,18 @@ func (s *Server) PrepareProviderConfig(ctx context.Context, proto5Req *tfprotov5
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	ctx, finishRPC, diags := s.startRPC(ctx, "PrepareProviderConfig")
+
 	fwResp := &fwserver.ValidateProviderConfigResponse{}
 
+	defer func() { finishRPC(fwResp.Diagnostics) }()
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.PrepareProviderConfigResponse(ctx, fwResp), nil
+	}
+
 	providerSchema, diags := s.FrameworkServer.ProviderSchema(ctx)
 
 	fwResp.Diagnostics.Append(diags...)
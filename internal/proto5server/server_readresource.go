@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 )
 
 // ReadResource satisfies the tfprotov5.ProviderServer interface.
@@ -19,8 +20,18 @@ func (s *Server) ReadResource(ctx context.Context, proto5Req *tfprotov5.ReadReso
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	ctx, finishRPC, diags := s.startRPC(ctx, "ReadResource", tracing.ResourceType(proto5Req.TypeName))
+
 	fwResp := &fwserver.ReadResourceResponse{}
 
+	defer func() { finishRPC(fwResp.Diagnostics) }()
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.ReadResourceResponse(ctx, fwResp), nil
+	}
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 )
 
@@ -18,8 +19,18 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, proto5Req *tfprot
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	ctx, finishRPC, diags := s.startRPC(ctx, "ValidateDataSourceConfig", tracing.DataSourceType(proto5Req.TypeName))
+
 	fwResp := &fwserver.ValidateDataSourceConfigResponse{}
 
+	defer func() { finishRPC(fwResp.Diagnostics) }()
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+	}
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -6,12 +6,25 @@ package proto6server
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 func TestServerCancelInFlightContexts(t *testing.T) {
@@ -54,6 +67,273 @@ func TestServerCancelInFlightContexts(t *testing.T) {
 	// canceled, or we have an error reported
 }
 
+func TestServerRPCHooks(t *testing.T) {
+	t.Parallel()
+
+	var got []string
+
+	s := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{},
+		},
+		RPCStartHook: func(_ context.Context, rpc string) {
+			got = append(got, "start "+rpc)
+		},
+		RPCFinishHook: func(_ context.Context, rpc string, duration time.Duration, diags diag.Diagnostics) {
+			if duration < 0 {
+				t.Errorf("unexpected negative duration: %s", duration)
+			}
+
+			got = append(got, fmt.Sprintf("finish %s with %d errors", rpc, diags.ErrorsCount()))
+		},
+	}
+
+	_, err := s.GetMetadata(context.Background(), &tfprotov6.GetMetadataRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		"start GetMetadata",
+		"finish GetMetadata with 0 errors",
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestServerRPCHooks_MessageSize(t *testing.T) {
+	t.Parallel()
+
+	var got []string
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+		},
+	}
+
+	counters := metrics.NewCounters()
+
+	s := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = schema.Schema{
+										Attributes: map[string]schema.Attribute{
+											"test": schema.StringAttribute{
+												Required: true,
+											},
+										},
+									}
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+		MaxMessageSize:  1,
+		MetricsRecorder: counters,
+		RPCFinishHook: func(_ context.Context, rpc string, _ time.Duration, diags diag.Diagnostics) {
+			got = append(got, fmt.Sprintf("finish %s with %d errors", rpc, diags.ErrorsCount()))
+		},
+	}
+
+	resp, err := s.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		CurrentState: testNewDynamicValue(t, testType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, "test-value"),
+		}),
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Summary != "State Exceeds Message Size Limit" {
+		t.Fatalf("expected message size error diagnostic, got: %v", resp.Diagnostics)
+	}
+
+	expected := []string{
+		"finish ReadResource with 1 errors",
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if errorCount := counters.Snapshot()["ReadResource"].ErrorCount; errorCount != 1 {
+		t.Errorf("expected 1 recorded error, got: %d", errorCount)
+	}
+}
+
+func TestServerMetricsRecorder(t *testing.T) {
+	t.Parallel()
+
+	counters := metrics.NewCounters()
+
+	s := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{},
+		},
+		MetricsRecorder: counters,
+	}
+
+	_, err := s.GetMetadata(context.Background(), &tfprotov6.GetMetadataRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = s.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName: "test_missing",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = s.CallFunction(context.Background(), &tfprotov6.CallFunctionRequest{
+		Name: "test_missing",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := counters.Snapshot()
+
+	for name, rpcCounters := range got {
+		if rpcCounters.Duration < 0 {
+			t.Errorf("unexpected negative duration for %s: %s", name, rpcCounters.Duration)
+		}
+
+		rpcCounters.Duration = 0
+		got[name] = rpcCounters
+	}
+
+	expected := map[string]metrics.RPCCounters{
+		"CallFunction": {
+			Count:      1,
+			ErrorCount: 1,
+		},
+		"GetMetadata": {
+			Count: 1,
+		},
+		"ReadResource": {
+			Count:      1,
+			ErrorCount: 1,
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestServerTracerProvider(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					testvalidator.String{
+						DescriptionMethod: func(_ context.Context) string {
+							return "test validator"
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tracerProvider := &testTracerProvider{}
+
+	s := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = testSchema
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+		TracerProvider: tracerProvider,
+	}
+
+	_, err := s.ValidateResourceConfig(context.Background(), &tfprotov6.ValidateResourceConfigRequest{
+		Config: testNewDynamicValue(t, testSchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, "test-value"),
+		}),
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		"ValidateResourceConfig tf_resource_type=test_resource",
+		"validator.String tf_attribute_path=test description=test validator",
+	}
+
+	if diff := cmp.Diff(tracerProvider.spans, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+// testTracerProvider records the name and attributes of started spans.
+type testTracerProvider struct {
+	noop.TracerProvider
+
+	spans []string
+}
+
+func (p *testTracerProvider) Tracer(_ string, _ ...trace.TracerOption) trace.Tracer {
+	return testTracer{provider: p}
+}
+
+type testTracer struct {
+	noop.Tracer
+
+	provider *testTracerProvider
+}
+
+func (t testTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	span := name
+	config := trace.NewSpanStartConfig(opts...)
+
+	for _, kv := range config.Attributes() {
+		span += " " + string(kv.Key) + "=" + kv.Value.Emit()
+	}
+
+	t.provider.spans = append(t.provider.spans, span)
+
+	return t.Tracer.Start(ctx, name, opts...)
+}
+
 func testNewSingleValueDynamicValue(t *testing.T, argumentValue tftypes.Value) *tfprotov6.DynamicValue {
 	t.Helper()
 
@@ -91,3 +371,128 @@ func testNewRawState(t *testing.T, jsonMap map[string]interface{}) *tfprotov6.Ra
 		JSON: rawStateJSON,
 	}
 }
+
+func TestServerRPCConcurrencyLimits(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{
+		RPCConcurrencyLimits: map[string]int{
+			"PlanResourceChange": 2,
+		},
+	}
+
+	var mu sync.Mutex
+	var current, maximum int
+
+	wg := new(sync.WaitGroup)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, finishRPC, diags := s.startRPC(context.Background(), "PlanResourceChange")
+			defer finishRPC(diags)
+
+			if diags.HasError() {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+
+			mu.Lock()
+			current++
+
+			if current > maximum {
+				maximum = current
+			}
+
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			current--
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	if maximum != 2 {
+		t.Errorf("expected maximum of 2 concurrent executions, got: %d", maximum)
+	}
+
+	// RPCs without a limit are not blocked.
+	_, finishRPC, _ := s.startRPC(context.Background(), "ApplyResourceChange")
+	finishRPC(nil)
+
+	// Waiting for the limit stops when the context is cancelled.
+	_, finishFirst, _ := s.startRPC(context.Background(), "PlanResourceChange")
+	_, finishSecond, _ := s.startRPC(context.Background(), "PlanResourceChange")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, finishCancelled, diags := s.startRPC(ctx, "PlanResourceChange")
+
+	finishCancelled(diags)
+	finishSecond(nil)
+	finishFirst(nil)
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"RPC Cancelled",
+			"The PlanResourceChange RPC was cancelled while waiting for the provider concurrency limit of the RPC. "+
+				"Terraform may have been cancelled or the provider may have been stopped.\n\n"+
+				"Error: context canceled",
+		),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
+
+func TestServerRPCConcurrencyLimits_cancelled(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{},
+		},
+		RPCConcurrencyLimits: map[string]int{
+			"GetMetadata": 1,
+		},
+	}
+
+	_, finishRPC, _ := s.startRPC(context.Background(), "GetMetadata")
+	defer finishRPC(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	got, err := s.GetMetadata(ctx, &tfprotov6.GetMetadataRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfprotov6.GetMetadataResponse{
+		DataSources: []tfprotov6.DataSourceMetadata{},
+		Diagnostics: []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "RPC Cancelled",
+				Detail: "The GetMetadata RPC was cancelled while waiting for the provider concurrency limit of the RPC. " +
+					"Terraform may have been cancelled or the provider may have been stopped.\n\n" +
+					"Error: context canceled",
+			},
+		},
+		Functions: []tfprotov6.FunctionMetadata{},
+		Resources: []tfprotov6.ResourceMetadata{},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -18,8 +18,18 @@ func (s *Server) ConfigureProvider(ctx context.Context, proto6Req *tfprotov6.Con
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	ctx, finishRPC, diags := s.startRPC(ctx, "ConfigureProvider")
+
 	fwResp := &provider.ConfigureResponse{}
 
+	defer func() { finishRPC(fwResp.Diagnostics) }()
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto6.ConfigureProviderResponse(ctx, fwResp), nil
+	}
+
 	providerSchema, diags := s.FrameworkServer.ProviderSchema(ctx)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -126,6 +126,38 @@ func TestServerGetFunctions(t *testing.T) {
 				Functions: map[string]*tfprotov6.Function{},
 			},
 		},
+		"functions-invalid-name": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.ProviderWithFunctions{
+						FunctionsMethod: func(_ context.Context) []func() function.Function {
+							return []func() function.Function{
+								func() function.Function {
+									return &testprovider.Function{
+										MetadataMethod: func(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+											resp.Name = "1invalid name" // intentionally invalid
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov6.GetFunctionsRequest{},
+			expectedResponse: &tfprotov6.GetFunctionsResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Invalid Function Name",
+						Detail: "The *testprovider.Function Function returned an invalid name \"1invalid name\" from the Metadata method. " +
+							"Function names must begin with an alphabet character (a-z, A-Z) or underscore (_) and must only contain alphanumeric characters (a-z, A-Z, 0-9), underscores (_), and hyphens (-). " +
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					},
+				},
+				Functions: map[string]*tfprotov6.Function{},
+			},
+		},
 		"functions-empty-name": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -18,9 +18,19 @@ func (s *Server) GetProviderSchema(ctx context.Context, proto6Req *tfprotov6.Get
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	ctx, finishRPC, diags := s.startRPC(ctx, "GetProviderSchema")
+
 	fwReq := fromproto6.GetProviderSchemaRequest(ctx, proto6Req)
 	fwResp := &fwserver.GetProviderSchemaResponse{}
 
+	defer func() { finishRPC(fwResp.Diagnostics) }()
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto6.GetProviderSchemaResponse(ctx, fwResp), nil
+	}
+
 	s.FrameworkServer.GetProviderSchema(ctx, fwReq, fwResp)
 
 	return toproto6.GetProviderSchemaResponse(ctx, fwResp), nil
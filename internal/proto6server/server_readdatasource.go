@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -18,8 +19,18 @@ func (s *Server) ReadDataSource(ctx context.Context, proto6Req *tfprotov6.ReadDa
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	ctx, finishRPC, diags := s.startRPC(ctx, "ReadDataSource", tracing.DataSourceType(proto6Req.TypeName))
+
 	fwResp := &fwserver.ReadDataSourceResponse{}
 
+	defer func() { finishRPC(fwResp.Diagnostics) }()
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto6.ReadDataSourceResponse(ctx, fwResp), nil
+	}
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -18,12 +19,24 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto6Req *tfprotov6.
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	ctx, finishRPC, diags := s.startRPC(ctx, "UpgradeResourceState")
+
 	fwResp := &fwserver.UpgradeResourceStateResponse{}
 
+	defer func() { finishRPC(fwResp.Diagnostics) }()
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
+	}
+
 	if proto6Req == nil {
 		return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
 	}
 
+	tracing.SetAttributes(ctx, tracing.ResourceType(proto6Req.TypeName))
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -18,8 +18,18 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, proto6Req *tfprotov
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
 
+	ctx, finishRPC, diags := s.startRPC(ctx, "ValidateProviderConfig")
+
 	fwResp := &fwserver.ValidateProviderConfigResponse{}
 
+	defer func() { finishRPC(fwResp.Diagnostics) }()
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto6.ValidateProviderConfigResponse(ctx, fwResp), nil
+	}
+
 	providerSchema, diags := s.FrameworkServer.ProviderSchema(ctx)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -4,10 +4,12 @@
 package reflect
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -45,6 +47,44 @@ func valueFromTerraformErrorDiag(err error, path path.Path) diag.DiagnosticWithP
 	)
 }
 
+// TypeConversionErrorDiags returns the error diagnostics from the
+// xattr.TypeWithConversionError ConversionError method, if the given type
+// implements the interface and sets any. Diagnostics without a path are
+// associated with the given path. Otherwise, the returned boolean is false
+// and the caller should use its default error diagnostic.
+func TypeConversionErrorDiags(ctx context.Context, typ attr.Type, val tftypes.Value, err error, path path.Path) (diag.Diagnostics, bool) {
+	typeWithConversionError, ok := typ.(xattr.TypeWithConversionError)
+
+	if !ok {
+		return nil, false
+	}
+
+	req := xattr.ConversionErrorRequest{
+		Path:  path,
+		Value: val,
+		Error: err,
+	}
+	resp := xattr.ConversionErrorResponse{}
+
+	typeWithConversionError.ConversionError(ctx, req, &resp)
+
+	if len(resp.Diagnostics) == 0 {
+		return nil, false
+	}
+
+	diags := make(diag.Diagnostics, 0, len(resp.Diagnostics))
+
+	for _, d := range resp.Diagnostics {
+		if _, ok := d.(diag.DiagnosticWithPath); !ok {
+			d = diag.WithPath(path, d)
+		}
+
+		diags = append(diags, d)
+	}
+
+	return diags, true
+}
+
 type DiagIntoIncompatibleType struct {
 	Val        tftypes.Value
 	TargetType reflect.Type
@@ -5,11 +5,17 @@ package reflect
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
+	"unicode"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
@@ -45,10 +51,16 @@ func commaSeparatedString(in []string) string {
 	}
 }
 
+// mapKeyStructTag is the "tfsdk" struct tag of a field which does not map to
+// an object attribute, but is set to the map key when the struct is a map
+// element.
+const mapKeyStructTag = ",mapkey"
+
 // getStructTags returns a map of Terraform field names to their position in
 // the tags of the struct `in`. `in` must be a struct.
 func getStructTags(_ context.Context, in reflect.Value, path path.Path) (map[string]int, error) {
 	tags := map[string]int{}
+	var errs []error
 	typ := trueReflectValue(in).Type()
 	if typ.Kind() != reflect.Struct {
 		return nil, fmt.Errorf("%s: can't get struct tags of %s, is not a struct", path, in.Type())
@@ -60,25 +72,165 @@ func getStructTags(_ context.Context, in reflect.Value, path path.Path) (map[str
 			continue
 		}
 		tag := field.Tag.Get(`tfsdk`)
-		if tag == "-" {
+		if tag == "-" || tag == mapKeyStructTag {
 			// skip explicitly excluded fields
 			continue
 		}
 		if tag == "" {
-			return nil, fmt.Errorf(`%s: need a struct tag for "tfsdk" on %s`, path, field.Name)
+			errs = append(errs, fmt.Errorf(`%s: need a struct tag for "tfsdk" on %s`, path, field.Name))
+			continue
 		}
 		path := path.AtName(tag)
 		if !isValidFieldName(tag) {
-			return nil, fmt.Errorf("%s: invalid field name, must only use lowercase letters, underscores, and numbers, and must start with a letter", path)
+			errs = append(errs, fmt.Errorf("%s: invalid field name, must only use lowercase letters, underscores, and numbers, and must start with a letter", path))
+			continue
 		}
 		if other, ok := tags[tag]; ok {
-			return nil, fmt.Errorf("%s: can't use field name for both %s and %s", path, typ.Field(other).Name, field.Name)
+			errs = append(errs, fmt.Errorf("%s: can't use field name for both %s and %s", path, typ.Field(other).Name, field.Name))
+			continue
 		}
 		tags[tag] = i
 	}
+	// Report every struct tag issue at once, rather than one per attempt.
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
 	return tags, nil
 }
 
+// getMapKeyField returns the position of the field in the struct `in` with
+// the ",mapkey" tag, if there is one. `in` must be a struct.
+func getMapKeyField(_ context.Context, in reflect.Value, path path.Path) (int, bool, error) {
+	pos := -1
+	typ := trueReflectValue(in).Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			// skip unexported fields
+			continue
+		}
+		if field.Tag.Get(`tfsdk`) != mapKeyStructTag {
+			continue
+		}
+		if pos >= 0 {
+			return 0, false, fmt.Errorf("%s: can't use map key for both %s and %s", path, typ.Field(pos).Name, field.Name)
+		}
+		pos = i
+	}
+	return pos, pos >= 0, nil
+}
+
+// setMapKeyField sets the ",mapkey" tagged field of the struct `target`, if
+// there is one, to the map key of the last step of `p`. Structs which are not
+// map elements are left unchanged. The field must be a string kind or an
+// attr.Value whose type accepts string values, such as types.String.
+func setMapKeyField(ctx context.Context, target reflect.Value, p path.Path) error {
+	pos, ok, err := getMapKeyField(ctx, target, p)
+	if err != nil || !ok {
+		return err
+	}
+
+	step, _ := p.Steps().LastStep()
+	key, ok := step.(path.PathStepElementKeyString)
+	if !ok {
+		return nil
+	}
+
+	field := target.Field(pos)
+	if field.Kind() == reflect.String {
+		field.SetString(string(key))
+		return nil
+	}
+
+	zero, ok := reflect.Zero(field.Type()).Interface().(attr.Value)
+	if !ok {
+		return fmt.Errorf("%s: map key field %s must be a string or attr.Value, got %s", p, target.Type().Field(pos).Name, field.Type())
+	}
+
+	keyVal, err := zero.Type(ctx).ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, string(key)))
+	if err != nil {
+		return fmt.Errorf("%s: error setting map key field %s: %w", p, target.Type().Field(pos).Name, err)
+	}
+
+	if !reflect.TypeOf(keyVal).AssignableTo(field.Type()) {
+		return fmt.Errorf("%s: map key field %s must be a string or attr.Value, got %s", p, target.Type().Field(pos).Name, field.Type())
+	}
+
+	field.Set(reflect.ValueOf(keyVal))
+	return nil
+}
+
+// getLooseStructFields returns a map of the names of the given attribute
+// types to the position of their matching field in the struct `in`, as
+// described by Options.LooseStructFields. `in` must be a struct. Fields
+// without a matching attribute are omitted. If multiple fields match the same
+// attribute, the first field is used.
+func getLooseStructFields(_ context.Context, in reflect.Value, attrTypes map[string]attr.Type) map[string]int {
+	fields := map[string]int{}
+	typ := trueReflectValue(in).Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			// skip unexported fields
+			continue
+		}
+		name := field.Tag.Get(`tfsdk`)
+		if name == mapKeyStructTag {
+			// skip map key fields, which are not object attributes
+			continue
+		}
+		if name == "" {
+			name, _, _ = strings.Cut(field.Tag.Get(`json`), ",")
+		}
+		if name == "" {
+			name = snakeCase(field.Name)
+		}
+		if name == "-" {
+			// skip explicitly excluded fields
+			continue
+		}
+		if _, ok := attrTypes[name]; !ok {
+			continue
+		}
+		if _, ok := fields[name]; ok {
+			continue
+		}
+		fields[name] = i
+	}
+	return fields
+}
+
+// snakeCase converts a Go field name, such as HTTPEndpoint, into snake case,
+// such as http_endpoint.
+func snakeCase(name string) string {
+	var result strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			previousLower := i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]))
+			nextLower := i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if previousLower || nextLower {
+				result.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		result.WriteRune(r)
+	}
+	return result.String()
+}
+
+// sortedFieldNames returns the Terraform field names of the struct tags
+// returned by getStructTags in lexicographic order, so traversal and
+// diagnostics are deterministic.
+func sortedFieldNames(tags map[string]int) []string {
+	names := make([]string, 0, len(tags))
+	for name := range tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // isValidFieldName returns true if `name` can be used as a field name in a
 // Terraform resource or data source.
 func isValidFieldName(name string) bool {
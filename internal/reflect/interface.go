@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// registeredInterface describes how to decode an object into a registered
+// interface type.
+type registeredInterface struct {
+	// discriminator is the name of the string object attribute whose value
+	// selects the implementation.
+	discriminator string
+
+	// implementations maps discriminator values to implementation types.
+	implementations map[string]reflect.Type
+}
+
+// registeredImplementation describes how to encode an implementation of a
+// registered interface type.
+type registeredImplementation struct {
+	discriminator string
+	name          string
+}
+
+var interfaceRegistry = struct {
+	sync.RWMutex
+
+	interfaces      map[reflect.Type]registeredInterface
+	implementations map[reflect.Type]registeredImplementation
+}{
+	interfaces:      map[reflect.Type]registeredInterface{},
+	implementations: map[reflect.Type]registeredImplementation{},
+}
+
+// RegisterInterface registers the implementations of the interface type
+// `iface`, keyed by the value of the `discriminator` string object attribute
+// which selects them. Each implementation must be a struct type or a pointer
+// to a struct type which implements `iface`, and can only be registered
+// once.
+func RegisterInterface(iface reflect.Type, discriminator string, implementations map[string]reflect.Type) error {
+	if iface == nil || iface.Kind() != reflect.Interface {
+		return fmt.Errorf("%s is not an interface type", iface)
+	}
+
+	if discriminator == "" {
+		return fmt.Errorf("discriminator attribute name for %s must not be empty", iface)
+	}
+
+	if len(implementations) == 0 {
+		return fmt.Errorf("no implementations given for %s", iface)
+	}
+
+	interfaceRegistry.Lock()
+	defer interfaceRegistry.Unlock()
+
+	if _, ok := interfaceRegistry.interfaces[iface]; ok {
+		return fmt.Errorf("%s is already registered", iface)
+	}
+
+	registered := registeredInterface{
+		discriminator:   discriminator,
+		implementations: make(map[string]reflect.Type, len(implementations)),
+	}
+
+	for name, impl := range implementations {
+		if impl == nil {
+			return fmt.Errorf("implementation %q of %s must not be nil", name, iface)
+		}
+
+		structType := impl
+		if structType.Kind() == reflect.Ptr {
+			structType = structType.Elem()
+		}
+
+		if structType.Kind() != reflect.Struct {
+			return fmt.Errorf("implementation %q of %s must be a struct or a pointer to a struct, got %s", name, iface, impl)
+		}
+
+		if !impl.Implements(iface) {
+			return fmt.Errorf("implementation %q type %s does not implement %s", name, impl, iface)
+		}
+
+		if _, ok := interfaceRegistry.implementations[structType]; ok {
+			return fmt.Errorf("implementation %q type %s is already registered", name, impl)
+		}
+
+		registered.implementations[name] = impl
+	}
+
+	interfaceRegistry.interfaces[iface] = registered
+
+	for name, impl := range registered.implementations {
+		if impl.Kind() == reflect.Ptr {
+			impl = impl.Elem()
+		}
+
+		interfaceRegistry.implementations[impl] = registeredImplementation{
+			discriminator: discriminator,
+			name:          name,
+		}
+	}
+
+	return nil
+}
+
+func lookupInterface(iface reflect.Type) (registeredInterface, bool) {
+	interfaceRegistry.RLock()
+	defer interfaceRegistry.RUnlock()
+
+	registered, ok := interfaceRegistry.interfaces[iface]
+
+	return registered, ok
+}
+
+func lookupImplementation(impl reflect.Type) (registeredImplementation, bool) {
+	interfaceRegistry.RLock()
+	defer interfaceRegistry.RUnlock()
+
+	registered, ok := interfaceRegistry.implementations[impl]
+
+	return registered, ok
+}
+
+// Interface builds a new value of the implementation registered for the
+// interface type of `target`, chosen by the discriminator attribute of
+// `object`, which must be a `tftypes.Object`. Object attributes without a
+// matching field in the implementation struct are ignored, so the object can
+// hold the union of the attributes of all implementations.
+//
+// It is meant to be called through Into, not directly.
+func Interface(ctx context.Context, typ attr.Type, object tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	registered, ok := lookupInterface(target.Type())
+	if !ok {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        object,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("no implementations registered for interface %s", target.Type()),
+		}))
+		return target, diags
+	}
+
+	if !object.Type().Is(tftypes.Object{}) {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        object,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("cannot reflect %s into an interface, must be an object", object.Type().String()),
+		}))
+		return target, diags
+	}
+
+	var objectFields map[string]tftypes.Value
+	err := object.As(&objectFields)
+	if err != nil {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        object,
+			TargetType: target.Type(),
+			Err:        err,
+		}))
+		return target, diags
+	}
+
+	discriminatorVal, ok := objectFields[registered.discriminator]
+	if !ok {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        object,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("object does not define discriminator attribute %q", registered.discriminator),
+		}))
+		return target, diags
+	}
+
+	if !discriminatorVal.Type().Is(tftypes.String) || !discriminatorVal.IsFullyKnown() || discriminatorVal.IsNull() {
+		diags.Append(diag.WithPath(path.AtName(registered.discriminator), DiagIntoIncompatibleType{
+			Val:        discriminatorVal,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("discriminator attribute must be a known, non-null string to select an implementation of %s", target.Type()),
+		}))
+		return target, diags
+	}
+
+	var name string
+	err = discriminatorVal.As(&name)
+	if err != nil {
+		diags.Append(diag.WithPath(path.AtName(registered.discriminator), DiagIntoIncompatibleType{
+			Val:        discriminatorVal,
+			TargetType: target.Type(),
+			Err:        err,
+		}))
+		return target, diags
+	}
+
+	impl, ok := registered.implementations[name]
+	if !ok {
+		names := make([]string, 0, len(registered.implementations))
+		for n := range registered.implementations {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+
+		diags.Append(diag.WithPath(path.AtName(registered.discriminator), DiagIntoIncompatibleType{
+			Val:        discriminatorVal,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("no implementation of %s registered for %q, expected one of: %s", target.Type(), name, strings.Join(names, ", ")),
+		}))
+		return target, diags
+	}
+
+	implOpts := opts
+	implOpts.IgnoreUndefinedObjectAttributes = true
+
+	implVal, implDiags := BuildValue(ctx, typ, object, reflect.New(impl).Elem(), implOpts, path)
+	diags.Append(implDiags...)
+
+	if diags.HasError() {
+		return target, diags
+	}
+
+	result := reflect.New(target.Type()).Elem()
+	result.Set(implVal)
+
+	return result, diags
+}
+
+// fromElementValue builds an attr.Value as produced by `typ` from `val`, a
+// struct field, slice element, or map value. If `val` is declared as a
+// registered interface type and holds one of its implementations, it is
+// converted with FromInterfaceImplementation, otherwise with FromValue.
+// Implementations used as concrete types are converted as ordinary structs.
+func fromElementValue(ctx context.Context, typ attr.Type, val reflect.Value, opts Options, path path.Path) (attr.Value, diag.Diagnostics) {
+	if val.Kind() != reflect.Interface || val.IsNil() {
+		return FromValue(ctx, typ, val.Interface(), opts, path)
+	}
+
+	if _, ok := lookupInterface(val.Type()); !ok {
+		return FromValue(ctx, typ, val.Interface(), opts, path)
+	}
+
+	impl := val.Elem()
+
+	if impl.Kind() == reflect.Ptr {
+		if impl.IsNil() {
+			return FromValue(ctx, typ, val.Interface(), opts, path)
+		}
+
+		impl = impl.Elem()
+	}
+
+	registered, ok := lookupImplementation(impl.Type())
+	if !ok {
+		return FromValue(ctx, typ, val.Interface(), opts, path)
+	}
+
+	attrsType, ok := typ.(attr.TypeWithAttributeTypes)
+	if !ok {
+		return FromValue(ctx, typ, val.Interface(), opts, path)
+	}
+
+	return FromInterfaceImplementation(ctx, attrsType, impl, registered, opts, path)
+}
+
+// FromInterfaceImplementation builds an attr.Value as produced by `typ` from
+// `val`, a struct type registered as an implementation of an interface type.
+// Object attributes without a matching field in the struct are null, and the
+// discriminator attribute is set to the name the implementation was
+// registered with.
+//
+// It is meant to be called through fromElementValue, not directly.
+func FromInterfaceImplementation(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflect.Value, registered registeredImplementation, opts Options, path path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if _, ok := typ.AttributeTypes()[registered.discriminator]; !ok {
+		err := fmt.Errorf("object type %s does not define discriminator attribute %q for %s", typ, registered.discriminator, val.Type())
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, diags
+	}
+
+	implOpts := opts
+	implOpts.IgnoreUndefinedObjectAttributes = true
+
+	objVal, objDiags := FromStruct(ctx, typ, val, implOpts, path)
+	diags.Append(objDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tfVal, err := objVal.ToTerraformValue(ctx)
+	if err != nil {
+		return nil, append(diags, toTerraformValueErrorDiag(err, path))
+	}
+
+	var objValues map[string]tftypes.Value
+	err = tfVal.As(&objValues)
+	if err != nil {
+		return nil, append(diags, toTerraformValueErrorDiag(err, path))
+	}
+
+	objValues[registered.discriminator] = tftypes.NewValue(tftypes.String, registered.name)
+
+	ret, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(tfVal.Type(), objValues))
+	if err != nil {
+		return nil, append(diags, valueFromTerraformErrorDiag(err, path))
+	}
+
+	return ret, diags
+}
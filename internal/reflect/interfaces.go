@@ -386,6 +386,10 @@ func NewAttributeValue(ctx context.Context, typ attr.Type, val tftypes.Value, ta
 
 	res, err := typ.ValueFromTerraform(ctx, val)
 	if err != nil {
+		if conversionDiags, ok := TypeConversionErrorDiags(ctx, typ, val, err, path); ok {
+			return target, append(diags, conversionDiags...)
+		}
+
 		return target, append(diags, valueFromTerraformErrorDiag(err, path))
 	}
 
@@ -547,6 +547,17 @@ func TestNewAttributeValue(t *testing.T) {
 				diag.NewAttributeErrorDiagnostic(path.Empty(), "Error Diagnostic", "This is an error."),
 			},
 		},
+		"conversion-error": {
+			typ: testtypes.StringTypeWithConversionError{},
+			val: tftypes.NewValue(tftypes.String, "hello"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid CIDR Value",
+					`Expected CIDR like 10.0.0.0/16, got: tftypes.String<"hello">. Error: test conversion error`,
+				),
+			},
+		},
 	}
 
 	for name, tc := range testCases {
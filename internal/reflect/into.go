@@ -200,6 +200,10 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		val, valDiags := Pointer(ctx, typ, val, target, opts, path)
 		diags.Append(valDiags...)
 		return val, diags
+	case reflect.Interface:
+		val, valDiags := Interface(ctx, typ, val, target, opts, path)
+		diags.Append(valDiags...)
+		return val, diags
 	default:
 		err := fmt.Errorf("don't know how to reflect %s into %s", val.Type(), target.Type())
 		diags.AddAttributeError(
@@ -231,3 +231,76 @@ func TestInto_Slices(t *testing.T) {
 		})
 	}
 }
+
+type testNamedTags map[string]string
+
+type testNamedCount int64
+
+type testNamedRule struct {
+	Name string `tfsdk:"name"`
+}
+
+type testNamedRules []testNamedRule
+
+type testNamedModel struct {
+	Count testNamedCount   `tfsdk:"count"`
+	Ports []testNamedCount `tfsdk:"ports"`
+	Rules testNamedRules   `tfsdk:"rules"`
+	Tags  *testNamedTags   `tfsdk:"tags"`
+}
+
+func TestInto_NamedTypes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	typ := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"count": types.Int64Type,
+			"ports": types.SetType{ElemType: types.Int64Type},
+			"rules": types.ListType{
+				ElemType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": types.StringType,
+					},
+				},
+			},
+			"tags": types.MapType{ElemType: types.StringType},
+		},
+	}
+
+	expected := testNamedModel{
+		Count: 2,
+		Ports: []testNamedCount{80, 443},
+		Rules: testNamedRules{
+			{Name: "allow"},
+		},
+		Tags: &testNamedTags{
+			"env": "prod",
+		},
+	}
+
+	value, diags := refl.FromValue(ctx, typ, expected, refl.Options{}, path.Empty())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected FromValue diagnostics: %v", diags)
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got testNamedModel
+
+	diags = refl.Into(ctx, typ, tfValue, &got, refl.Options{}, path.Empty())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected Into diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
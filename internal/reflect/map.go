@@ -97,7 +97,7 @@ func Map(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.V
 // will be of the type produced by `typ`.
 //
 // It is meant to be called through FromValue, not directly.
-func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Value, opts Options, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	tfType := typ.TerraformType(ctx)
 
@@ -163,7 +163,7 @@ func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Valu
 		// If the element implements xattr.ValidateableAttribute, or xattr.TypeWithValidate,
 		// and the element does not validate then diagnostics will be added here and returned
 		// before reaching the switch statement below.
-		val, valDiags := FromValue(ctx, elemType, val.MapIndex(key).Interface(), mapKeyPath)
+		val, valDiags := fromElementValue(ctx, elemType, val.MapIndex(key), opts, mapKeyPath)
 		diags.Append(valDiags...)
 
 		if diags.HasError() {
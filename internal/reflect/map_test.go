@@ -5,6 +5,7 @@ package reflect_test
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 
@@ -248,7 +249,7 @@ func TestFromMap(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, diags := refl.FromMap(context.Background(), tc.typ, tc.val, path.Empty())
+			got, diags := refl.FromMap(context.Background(), tc.typ, tc.val, refl.Options{}, path.Empty())
 
 			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
 				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
@@ -260,3 +261,164 @@ func TestFromMap(t *testing.T) {
 		})
 	}
 }
+
+func TestReflectMap_mapKey(t *testing.T) {
+	t.Parallel()
+
+	type stringKey struct {
+		Name  string `tfsdk:",mapkey"`
+		Value string `tfsdk:"value"`
+	}
+
+	type typesKey struct {
+		Name  types.String `tfsdk:",mapkey"`
+		Value types.String `tfsdk:"value"`
+	}
+
+	type duplicateKey struct {
+		Name  string `tfsdk:",mapkey"`
+		ID    string `tfsdk:",mapkey"`
+		Value string `tfsdk:"value"`
+	}
+
+	type invalidKey struct {
+		Name  int64  `tfsdk:",mapkey"`
+		Value string `tfsdk:"value"`
+	}
+
+	typ := types.MapType{
+		ElemType: types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"value": types.StringType,
+			},
+		},
+	}
+	val := tftypes.NewValue(tftypes.Map{
+		ElementType: tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"value": tftypes.String,
+			},
+		},
+	}, map[string]tftypes.Value{
+		"one": tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"value": tftypes.String,
+			},
+		}, map[string]tftypes.Value{
+			"value": tftypes.NewValue(tftypes.String, "a"),
+		}),
+	})
+
+	testCases := map[string]struct {
+		target        any
+		expected      any
+		expectedDiags diag.Diagnostics
+	}{
+		"string": {
+			target: map[string]stringKey{},
+			expected: map[string]stringKey{
+				"one": {Name: "one", Value: "a"},
+			},
+		},
+		"string-pointer": {
+			target: map[string]*stringKey{},
+			expected: map[string]*stringKey{
+				"one": {Name: "one", Value: "a"},
+			},
+		},
+		"types-string": {
+			target: map[string]typesKey{},
+			expected: map[string]typesKey{
+				"one": {Name: types.StringValue("one"), Value: types.StringValue("a")},
+			},
+		},
+		"duplicate": {
+			target: map[string]duplicateKey{},
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Empty().AtMapKey("one"), refl.DiagIntoIncompatibleType{
+					Val: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"value": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"value": tftypes.NewValue(tftypes.String, "a"),
+					}),
+					TargetType: reflect.TypeOf(duplicateKey{}),
+					Err:        errors.New(`["one"]: can't use map key for both Name and ID`),
+				}),
+			},
+		},
+		"invalid": {
+			target: map[string]invalidKey{},
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Empty().AtMapKey("one"), refl.DiagIntoIncompatibleType{
+					Val: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"value": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"value": tftypes.NewValue(tftypes.String, "a"),
+					}),
+					TargetType: reflect.TypeOf(invalidKey{}),
+					Err:        errors.New(`["one"]: map key field Name must be a string or attr.Value, got int64`),
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, diags := refl.Map(context.Background(), typ, val, reflect.ValueOf(testCase.target), refl.Options{}, path.Empty())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Fatalf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(result.Interface(), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromMap_mapKey(t *testing.T) {
+	t.Parallel()
+
+	type element struct {
+		Name  string `tfsdk:",mapkey"`
+		Value string `tfsdk:"value"`
+	}
+
+	elemType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"value": types.StringType,
+		},
+	}
+
+	expected := types.MapValueMust(elemType, map[string]attr.Value{
+		"one": types.ObjectValueMust(elemType.AttrTypes, map[string]attr.Value{
+			"value": types.StringValue("a"),
+		}),
+	})
+
+	// The map key field is stripped, even when it differs from the map key.
+	got, diags := refl.FromMap(context.Background(), types.MapType{ElemType: elemType}, reflect.ValueOf(map[string]element{
+		"one": {Name: "other", Value: "a"},
+	}), refl.Options{}, path.Empty())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
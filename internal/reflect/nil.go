@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// FromNil returns the null representation of `typ`, for untyped nil values,
+// such as a nil interface struct field or a nil value passed to SetAttribute.
+//
+// It is meant to be called through FromValue, not directly.
+func FromNil(ctx context.Context, typ attr.Type, path path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfVal := tftypes.NewValue(typ.TerraformType(ctx), nil)
+
+	attrVal, err := typ.ValueFromTerraform(ctx, tfVal)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert from nil value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, diags
+	}
+
+	switch t := attrVal.(type) {
+	case xattr.ValidateableAttribute:
+		resp := xattr.ValidateAttributeResponse{}
+
+		t.ValidateAttribute(ctx,
+			xattr.ValidateAttributeRequest{
+				Path: path,
+			},
+			&resp,
+		)
+
+		diags.Append(resp.Diagnostics...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+	default:
+		//nolint:staticcheck // xattr.TypeWithValidate is deprecated, but we still need to support it.
+		if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
+			diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+	}
+
+	return attrVal, diags
+}
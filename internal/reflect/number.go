@@ -21,8 +21,8 @@ import (
 
 // Number creates a *big.Float and populates it with the data in `val`. It then
 // gets converted to the type of `target`, as long as `target` is a valid
-// number type (any of the built-in int, uint, or float types, *big.Float, and
-// *big.Int).
+// number type (any of the built-in int, uint, or float types, named types
+// based on them, *big.Float, and *big.Int).
 //
 // Number will loudly fail when a number cannot be losslessly represented using
 // the requested type.
@@ -73,7 +73,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 			if strconv.IntSize == 32 && intResult < math.MinInt32 {
 				return target, append(diags, roundingErrorDiag)
 			}
-			return reflect.ValueOf(int(intResult)), diags
+			return reflect.ValueOf(int(intResult)).Convert(target.Type()), diags
 		case reflect.Int8:
 			if intResult > math.MaxInt8 {
 				return target, append(diags, roundingErrorDiag)
@@ -81,7 +81,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 			if intResult < math.MinInt8 {
 				return target, append(diags, roundingErrorDiag)
 			}
-			return reflect.ValueOf(int8(intResult)), diags
+			return reflect.ValueOf(int8(intResult)).Convert(target.Type()), diags
 		case reflect.Int16:
 			if intResult > math.MaxInt16 {
 				return target, append(diags, roundingErrorDiag)
@@ -89,7 +89,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 			if intResult < math.MinInt16 {
 				return target, append(diags, roundingErrorDiag)
 			}
-			return reflect.ValueOf(int16(intResult)), diags
+			return reflect.ValueOf(int16(intResult)).Convert(target.Type()), diags
 		case reflect.Int32:
 			if intResult > math.MaxInt32 {
 				return target, append(diags, roundingErrorDiag)
@@ -97,9 +97,9 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 			if intResult < math.MinInt32 {
 				return target, append(diags, roundingErrorDiag)
 			}
-			return reflect.ValueOf(int32(intResult)), diags
+			return reflect.ValueOf(int32(intResult)).Convert(target.Type()), diags
 		case reflect.Int64:
-			return reflect.ValueOf(intResult), diags
+			return reflect.ValueOf(intResult).Convert(target.Type()), diags
 		}
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
 		reflect.Uint64:
@@ -112,24 +112,24 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 			if strconv.IntSize == 32 && uintResult > math.MaxUint32 {
 				return target, append(diags, roundingErrorDiag)
 			}
-			return reflect.ValueOf(uint(uintResult)), diags
+			return reflect.ValueOf(uint(uintResult)).Convert(target.Type()), diags
 		case reflect.Uint8:
 			if uintResult > math.MaxUint8 {
 				return target, append(diags, roundingErrorDiag)
 			}
-			return reflect.ValueOf(uint8(uintResult)), diags
+			return reflect.ValueOf(uint8(uintResult)).Convert(target.Type()), diags
 		case reflect.Uint16:
 			if uintResult > math.MaxUint16 {
 				return target, append(diags, roundingErrorDiag)
 			}
-			return reflect.ValueOf(uint16(uintResult)), diags
+			return reflect.ValueOf(uint16(uintResult)).Convert(target.Type()), diags
 		case reflect.Uint32:
 			if uintResult > math.MaxUint32 {
 				return target, append(diags, roundingErrorDiag)
 			}
-			return reflect.ValueOf(uint32(uintResult)), diags
+			return reflect.ValueOf(uint32(uintResult)).Convert(target.Type()), diags
 		case reflect.Uint64:
-			return reflect.ValueOf(uintResult), diags
+			return reflect.ValueOf(uintResult).Convert(target.Type()), diags
 		}
 	case reflect.Float32:
 		float64Result, _ := result.Float64()
@@ -160,7 +160,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 			return target, diags
 		}
 
-		return reflect.ValueOf(float32Result), diags
+		return reflect.ValueOf(float32Result).Convert(target.Type()), diags
 	case reflect.Float64:
 		floatResult, _ := result.Float64()
 
@@ -172,7 +172,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 			return target, diags
 		}
 
-		return reflect.ValueOf(floatResult), diags
+		return reflect.ValueOf(floatResult).Convert(target.Type()), diags
 	}
 
 	err = fmt.Errorf("cannot convert number to %s", target.Type())
@@ -107,6 +107,40 @@ func TestNumber_int(t *testing.T) {
 	}
 }
 
+func TestNumber_namedInt64(t *testing.T) {
+	t.Parallel()
+
+	type count int64
+
+	var n count
+
+	result, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, 123), reflect.ValueOf(n), refl.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&n).Elem().Set(result)
+	if n != 123 {
+		t.Errorf("Expected %v, got %v", 123, n)
+	}
+}
+
+func TestNumber_namedFloat64(t *testing.T) {
+	t.Parallel()
+
+	type ratio float64
+
+	var n ratio
+
+	result, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, 1.5), reflect.ValueOf(n), refl.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&n).Elem().Set(result)
+	if n != 1.5 {
+		t.Errorf("Expected %v, got %v", 1.5, n)
+	}
+}
+
 func TestNumber_intOverflowError(t *testing.T) {
 	t.Parallel()
 
@@ -15,4 +15,33 @@ type Options struct {
 	// translated into empty values without provider interaction, or if
 	// they must be explicitly handled.
 	UnhandledUnknownAsEmpty bool
+
+	// ReportAllMismatches controls whether reflection into structs
+	// continues after finding a mismatch between struct fields and object
+	// attributes, so every mismatch, including those in nested structs, is
+	// reported at once. When set to false, reflection stops at the first
+	// struct with a mismatch.
+	ReportAllMismatches bool
+
+	// UntypedNilAsNull controls whether untyped nil values, such as nil
+	// interface struct fields, should be translated into null values when
+	// converting from Go values, or if they must be explicitly handled.
+	UntypedNilAsNull bool
+
+	// LooseStructFields controls how struct fields are matched to object
+	// attributes when converting from Go values. When enabled, fields are
+	// matched by their "tfsdk" struct tag, then by the name in their "json"
+	// struct tag, then by their field name converted to snake case. Fields
+	// without a matching attribute are ignored and attributes without a
+	// matching field are null, so structs from remote system API SDKs can
+	// be converted without defining separate tagged structs.
+	LooseStructFields bool
+
+	// IgnoreUndefinedObjectAttributes controls whether object attributes
+	// without a matching struct field are ignored when reflecting into a
+	// struct, and are null when converting from a struct, rather than
+	// reporting a mismatch. It only applies to the struct being converted
+	// and not to structs nested within it, and is used for implementations
+	// of registered interface types.
+	IgnoreUndefinedObjectAttributes bool
 }
@@ -18,10 +18,14 @@ import (
 // FromValue is the inverse of Into, taking a Go value (`val`) and transforming it
 // into an attr.Value using the attr.Type supplied. `val` will first be
 // transformed into a tftypes.Value, then passed to `typ`'s ValueFromTerraform
-// method.
-func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Path) (attr.Value, diag.Diagnostics) {
+// method. Nil pointers, slices, and maps are transformed into the null
+// representation of `typ`, as are untyped nil values if enabled by `opts`.
+func FromValue(ctx context.Context, typ attr.Type, val interface{}, opts Options, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
+	if val == nil && opts.UntypedNilAsNull {
+		return FromNil(ctx, typ, path)
+	}
 	if v, ok := val.(attr.Value); ok {
 		return FromAttributeValue(ctx, typ, v, path)
 	}
@@ -54,7 +58,7 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 			)
 			return nil, diags
 		}
-		return FromStruct(ctx, t, value, path)
+		return FromStruct(ctx, t, value, opts, path)
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
 		reflect.Int64:
 		return FromInt(ctx, typ, value.Int(), path)
@@ -68,7 +72,7 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 	case reflect.String:
 		return FromString(ctx, typ, value.String(), path)
 	case reflect.Slice:
-		return FromSlice(ctx, typ, value, path)
+		return FromSlice(ctx, typ, value, opts, path)
 	case reflect.Map:
 		t, ok := typ.(attr.TypeWithElementType)
 		if !ok {
@@ -80,9 +84,9 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 			)
 			return nil, diags
 		}
-		return FromMap(ctx, t, value, path)
+		return FromMap(ctx, t, value, opts, path)
 	case reflect.Ptr:
-		return FromPointer(ctx, typ, value, path)
+		return FromPointer(ctx, typ, value, opts, path)
 	default:
 		err := fmt.Errorf("cannot construct attr.Type from %T (%s)", val, kind)
 		diags.AddAttributeError(
@@ -21,9 +21,77 @@ func TestFromValue_go_types(t *testing.T) {
 	testCases := map[string]struct {
 		typ           attr.Type
 		value         any
+		opts          refl.Options
 		expected      attr.Value
 		expectedDiags diag.Diagnostics
 	}{
+		"nil-to-string-value-error": {
+			typ:   types.StringType,
+			value: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"cannot construct attr.Type from <nil> (invalid)",
+				),
+			},
+		},
+		"nil-to-string-value": {
+			opts:     refl.Options{UntypedNilAsNull: true},
+			typ:      types.StringType,
+			value:    nil,
+			expected: types.StringNull(),
+		},
+		"nil-to-object-value": {
+			opts: refl.Options{UntypedNilAsNull: true},
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"test": types.StringType,
+				},
+			},
+			value: nil,
+			expected: types.ObjectNull(map[string]attr.Type{
+				"test": types.StringType,
+			}),
+		},
+		"nil-go-map-to-map-value": {
+			typ:      types.MapType{ElemType: types.StringType},
+			value:    map[string]string(nil),
+			expected: types.MapNull(types.StringType),
+		},
+		"nil-go-struct-pointer-to-object-value": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"test": types.StringType,
+				},
+			},
+			value: (*struct {
+				Test string `tfsdk:"test"`
+			})(nil),
+			expected: types.ObjectNull(map[string]attr.Type{
+				"test": types.StringType,
+			}),
+		},
+		"struct-nil-interface-field-to-object-value": {
+			opts: refl.Options{UntypedNilAsNull: true},
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"test": types.StringType,
+				},
+			},
+			value: struct {
+				Test attr.Value `tfsdk:"test"`
+			}{},
+			expected: types.ObjectValueMust(
+				map[string]attr.Type{
+					"test": types.StringType,
+				},
+				map[string]attr.Value{
+					"test": types.StringNull(),
+				},
+			),
+		},
 		"nil-go-slice-to-list-value": {
 			typ:      types.ListType{ElemType: types.StringType},
 			value:    new([]string),
@@ -172,7 +240,7 @@ func TestFromValue_go_types(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, diags := refl.FromValue(context.Background(), testCase.typ, testCase.value, path.Empty())
+			got, diags := refl.FromValue(context.Background(), testCase.typ, testCase.value, testCase.opts, path.Empty())
 
 			if diff := cmp.Diff(got, testCase.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
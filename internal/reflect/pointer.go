@@ -79,7 +79,7 @@ func pointerSafeZeroValue(_ context.Context, target reflect.Value) reflect.Value
 // the pointer is referencing.
 //
 // It is meant to be called through FromValue, not directly.
-func FromPointer(ctx context.Context, typ attr.Type, value reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+func FromPointer(ctx context.Context, typ attr.Type, value reflect.Value, opts Options, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if value.Kind() != reflect.Ptr {
@@ -135,7 +135,7 @@ func FromPointer(ctx context.Context, typ attr.Type, value reflect.Value, path p
 		return attrVal, diags
 	}
 
-	attrVal, attrValDiags := FromValue(ctx, typ, value.Elem().Interface(), path)
+	attrVal, attrValDiags := FromValue(ctx, typ, value.Elem().Interface(), opts, path)
 	diags.Append(attrValDiags...)
 
 	return attrVal, diags
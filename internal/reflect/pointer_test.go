@@ -119,6 +119,33 @@ func TestFromPointer(t *testing.T) {
 			val:      reflect.ValueOf(new(*string)),
 			expected: types.StringNull(),
 		},
+		"struct": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": types.StringType,
+				},
+			},
+			val: reflect.ValueOf(&testPointerStruct{Name: "hello"}),
+			expected: types.ObjectValueMust(
+				map[string]attr.Type{
+					"name": types.StringType,
+				},
+				map[string]attr.Value{
+					"name": types.StringValue("hello"),
+				},
+			),
+		},
+		"struct-null": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": types.StringType,
+				},
+			},
+			val: reflect.ValueOf((*testPointerStruct)(nil)),
+			expected: types.ObjectNull(map[string]attr.Type{
+				"name": types.StringType,
+			}),
+		},
 		"WithValidateError": {
 			typ: testtypes.StringTypeWithValidateError{},
 			val: reflect.ValueOf(new(*string)),
@@ -164,7 +191,7 @@ func TestFromPointer(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, diags := refl.FromPointer(context.Background(), tc.typ, tc.val, path.Empty())
+			got, diags := refl.FromPointer(context.Background(), tc.typ, tc.val, refl.Options{}, path.Empty())
 
 			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
 				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
@@ -177,6 +204,85 @@ func TestFromPointer(t *testing.T) {
 	}
 }
 
+type testPointerStruct struct {
+	Name string `tfsdk:"name"`
+}
+
+func TestPointer_struct(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		Nested *testPointerStruct `tfsdk:"nested"`
+	}
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+	modelType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested": nestedType,
+		},
+	}
+	typ := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"nested": types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": types.StringType,
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		val      tftypes.Value
+		target   model
+		expected model
+	}{
+		"null": {
+			val: tftypes.NewValue(modelType, map[string]tftypes.Value{
+				"nested": tftypes.NewValue(nestedType, nil),
+			}),
+			expected: model{},
+		},
+		"null-overwrites-existing": {
+			val: tftypes.NewValue(modelType, map[string]tftypes.Value{
+				"nested": tftypes.NewValue(nestedType, nil),
+			}),
+			target:   model{Nested: &testPointerStruct{Name: "stale"}},
+			expected: model{},
+		},
+		"value": {
+			val: tftypes.NewValue(modelType, map[string]tftypes.Value{
+				"nested": tftypes.NewValue(nestedType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "hello"),
+				}),
+			}),
+			expected: model{Nested: &testPointerStruct{Name: "hello"}},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.target
+
+			diags := refl.Into(context.Background(), typ, tc.val, &got, refl.Options{}, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func strPtr(s string) *string {
 	return &s
 }
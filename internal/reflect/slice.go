@@ -178,7 +178,7 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 // `typ` to construct values for them.
 //
 // It is meant to be called through FromValue, not directly.
-func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, opts Options, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	tfType := typ.TerraformType(ctx)
@@ -243,7 +243,7 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.
 			// If the element implements xattr.ValidateableAttribute, or xattr.TypeWithValidate,
 			// and the element does not validate then diagnostics will be added here and returned
 			// before reaching the switch statement below.
-			val, valDiags := FromValue(ctx, elemType, val.Index(i).Interface(), valPath)
+			val, valDiags := fromElementValue(ctx, elemType, val.Index(i), opts, valPath)
 			diags.Append(valDiags...)
 
 			if diags.HasError() {
@@ -337,7 +337,7 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.
 			// If the element implements xattr.ValidateableAttribute, or xattr.TypeWithValidate,
 			// and the element does not validate then diagnostics will be added here and returned
 			// before reaching the switch statement below.
-			val, valDiags := FromValue(ctx, elemAttrType, val.Index(i).Interface(), valPath)
+			val, valDiags := fromElementValue(ctx, elemAttrType, val.Index(i), opts, valPath)
 			diags.Append(valDiags...)
 
 			if diags.HasError() {
@@ -392,7 +392,7 @@ func TestFromSlice(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, diags := refl.FromSlice(context.Background(), tc.typ, tc.val, path.Empty())
+			got, diags := refl.FromSlice(context.Background(), tc.typ, tc.val, refl.Options{}, path.Empty())
 
 			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
 				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
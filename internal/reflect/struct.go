@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -29,6 +30,9 @@ import (
 // explicitly defining them as not part of the object. This is to catch typos
 // and other mistakes early.
 //
+// A property tagged with `tfsdk:",mapkey"` is also not part of the object.
+// When the struct is an element of a map, it is set to the element's map key.
+//
 // Struct is meant to be called from Into, not directly.
 func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -95,11 +99,14 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 		}
 	}
 	for field := range objectFields {
-		if _, ok := targetFields[field]; !ok {
+		if _, ok := targetFields[field]; !ok && !opts.IgnoreUndefinedObjectAttributes {
 			targetMissing = append(targetMissing, field)
 		}
 	}
 	if len(objectMissing) > 0 || len(targetMissing) > 0 {
+		sort.Strings(objectMissing)
+		sort.Strings(targetMissing)
+
 		var missing []string
 		if len(objectMissing) > 0 {
 			missing = append(missing, fmt.Sprintf("Struct defines fields not found in object: %s.", commaSeparatedString(objectMissing)))
@@ -112,15 +119,29 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 			TargetType: target.Type(),
 			Err:        fmt.Errorf("mismatch between struct and object: %s", strings.Join(missing, " ")),
 		}))
-		return target, diags
+
+		if !opts.ReportAllMismatches {
+			return target, diags
+		}
 	}
 
 	attrTypes := attrsType.AttributeTypes()
 
+	fieldOpts := opts
+	fieldOpts.IgnoreUndefinedObjectAttributes = false
+
 	// now that we know they match perfectly, fill the struct with the
 	// values in the object
 	result := reflect.New(target.Type()).Elem()
-	for field, structFieldPos := range targetFields {
+	for _, field := range sortedFieldNames(targetFields) {
+		structFieldPos := targetFields[field]
+
+		// Only reachable when reporting all mismatches, which were already
+		// added as diagnostics above.
+		if _, ok := objectFields[field]; !ok {
+			continue
+		}
+
 		attrType, ok := attrTypes[field]
 		if !ok {
 			diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
@@ -131,43 +152,70 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 			return target, diags
 		}
 		structField := result.Field(structFieldPos)
-		fieldVal, fieldValDiags := BuildValue(ctx, attrType, objectFields[field], structField, opts, path.AtName(field))
+		fieldVal, fieldValDiags := BuildValue(ctx, attrType, objectFields[field], structField, fieldOpts, path.AtName(field))
 		diags.Append(fieldValDiags...)
 
-		if diags.HasError() {
+		if fieldValDiags.HasError() {
+			if opts.ReportAllMismatches {
+				continue
+			}
+
 			return target, diags
 		}
 		structField.Set(fieldVal)
 	}
+
+	if diags.HasError() {
+		return target, diags
+	}
+
+	if err := setMapKeyField(ctx, result, path); err != nil {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        object,
+			TargetType: target.Type(),
+			Err:        err,
+		}))
+		return target, diags
+	}
+
 	return result, diags
 }
 
 // FromStruct builds an attr.Value as produced by `typ` from the data in `val`.
 // `val` must be a struct type, and must have all its properties tagged and be
-// a 1:1 match with the attributes reported by `typ`. FromStruct will recurse
+// a 1:1 match with the attributes reported by `typ`. Properties tagged with
+// `tfsdk:",mapkey"` are omitted from the object. FromStruct will recurse
 // into FromValue for each attribute, using the type of the attribute as
 // reported by `typ`.
 //
 // It is meant to be called through FromValue, not directly.
-func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflect.Value, opts Options, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	objTypes := map[string]tftypes.Type{}
 	objValues := map[string]tftypes.Value{}
 
+	attrTypes := typ.AttributeTypes()
+
 	// collect a map of fields that are defined in the tags of the struct
 	// passed in
-	targetFields, err := getStructTags(ctx, val, path)
-	if err != nil {
-		err = fmt.Errorf("error retrieving field names from struct tags: %w", err)
-		diags.AddAttributeError(
-			path,
-			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert from struct value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
-		)
-		return nil, diags
-	}
+	var targetFields map[string]int
 
-	attrTypes := typ.AttributeTypes()
+	if opts.LooseStructFields {
+		targetFields = getLooseStructFields(ctx, val, attrTypes)
+	} else {
+		structTags, err := getStructTags(ctx, val, path)
+		if err != nil {
+			err = fmt.Errorf("error retrieving field names from struct tags: %w", err)
+			diags.AddAttributeError(
+				path,
+				"Value Conversion Error",
+				"An unexpected error was encountered trying to convert from struct value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return nil, diags
+		}
+
+		targetFields = structTags
+	}
 
 	var objectMissing, structMissing []string
 
@@ -182,12 +230,15 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 			objectMissing = append(objectMissing, attrName)
 		}
 
-		if _, ok := targetFields[attrName]; !ok {
+		if _, ok := targetFields[attrName]; !ok && !opts.LooseStructFields && !opts.IgnoreUndefinedObjectAttributes {
 			structMissing = append(structMissing, attrName)
 		}
 	}
 
 	if len(objectMissing) > 0 || len(structMissing) > 0 {
+		sort.Strings(objectMissing)
+		sort.Strings(structMissing)
+
 		missing := make([]string, 0, len(objectMissing)+len(structMissing))
 
 		if len(objectMissing) > 0 {
@@ -211,14 +262,18 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 		return nil, diags
 	}
 
-	for name, fieldNo := range targetFields {
+	fieldOpts := opts
+	fieldOpts.IgnoreUndefinedObjectAttributes = false
+
+	for _, name := range sortedFieldNames(targetFields) {
+		fieldNo := targetFields[name]
 		path := path.AtName(name)
 		fieldValue := val.Field(fieldNo)
 
 		// If the attr implements xattr.ValidateableAttribute, or xattr.TypeWithValidate,
 		// and the attr does not validate then diagnostics will be added here and returned
 		// before reaching the switch statement below.
-		attrVal, attrValDiags := FromValue(ctx, attrTypes[name], fieldValue.Interface(), path)
+		attrVal, attrValDiags := fromElementValue(ctx, attrTypes[name], fieldValue, fieldOpts, path)
 		diags.Append(attrValDiags...)
 
 		if diags.HasError() {
@@ -270,6 +325,18 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 		objTypes[name] = tfObjTyp
 	}
 
+	// Object attributes without a matching struct field are null.
+	if opts.LooseStructFields || opts.IgnoreUndefinedObjectAttributes {
+		for attrName, attrType := range attrTypes {
+			if _, ok := targetFields[attrName]; ok || attrType == nil {
+				continue
+			}
+
+			objTypes[attrName] = attrType.TerraformType(ctx)
+			objValues[attrName] = tftypes.NewValue(objTypes[attrName], nil)
+		}
+	}
+
 	tfVal := tftypes.NewValue(tftypes.Object{
 		AttributeTypes: objTypes,
 	}, objValues)
@@ -308,3 +375,45 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 
 	return ret, diags
 }
+
+// StructFieldValues returns the values of the fields of `val`, keyed by
+// their "tfsdk" struct tag names. `val` must be a struct or a non-nil pointer
+// to a struct, and all of its exported fields must be tagged.
+func StructFieldValues(ctx context.Context, val any, path path.Path) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	structValue := reflect.ValueOf(val)
+
+	for structValue.Kind() == reflect.Ptr && !structValue.IsNil() {
+		structValue = structValue.Elem()
+	}
+
+	if structValue.Kind() != reflect.Struct {
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert from struct value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("expected a struct or pointer to a struct, got: %T", val),
+		)
+		return nil, diags
+	}
+
+	fields, err := getStructTags(ctx, structValue, path)
+	if err != nil {
+		err = fmt.Errorf("error retrieving field names from struct tags: %w", err)
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert from struct value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, diags
+	}
+
+	result := make(map[string]any, len(fields))
+
+	for name, fieldNo := range fields {
+		result[name] = structValue.Field(fieldNo).Interface()
+	}
+
+	return result, diags
+}
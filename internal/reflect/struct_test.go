@@ -117,6 +117,53 @@ func TestNewStruct_errors(t *testing.T) {
 				"error retrieving field names from struct tags: %w",
 				errors.New(`: need a struct tag for "tfsdk" on ExportedAndUntagged`)),
 		},
+		"object-and-struct-missing-multiple-fields-sorted": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"d": types.StringType,
+					"c": types.StringType,
+				},
+			},
+			objVal: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"d": tftypes.String,
+					"c": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"d": tftypes.NewValue(tftypes.String, "hello"),
+				"c": tftypes.NewValue(tftypes.String, "world"),
+			}),
+			targetVal: reflect.ValueOf(struct {
+				B string `tfsdk:"b"`
+				A string `tfsdk:"a"`
+			}{}),
+			expectedError: errors.New("mismatch between struct and object: Struct defines fields not found in object: a and b. Object defines fields not found in struct: c and d."),
+		},
+		"struct-has-multiple-untagged-fields": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": types.StringType,
+				},
+			},
+			objVal: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"a": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			targetVal: reflect.ValueOf(struct {
+				A                        string `tfsdk:"a"`
+				ExportedAndUntagged      string
+				OtherExportedAndUntagged string
+			}{}),
+			expectedError: fmt.Errorf(
+				"error retrieving field names from struct tags: %w",
+				errors.Join(
+					errors.New(`: need a struct tag for "tfsdk" on ExportedAndUntagged`),
+					errors.New(`: need a struct tag for "tfsdk" on OtherExportedAndUntagged`),
+				)),
+		},
 		"struct-has-invalid-tags": {
 			typ: types.ObjectType{
 				AttrTypes: map[string]attr.Type{
@@ -186,6 +233,67 @@ func TestNewStruct_errors(t *testing.T) {
 	}
 }
 
+func TestNewStruct_ReportAllMismatches(t *testing.T) {
+	t.Parallel()
+
+	nestedType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"c": types.StringType,
+		},
+	}
+	typ := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"a":      types.StringType,
+			"nested": nestedType,
+		},
+	}
+	nestedObjType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"c": tftypes.String,
+		},
+	}
+	nestedObjVal := tftypes.NewValue(nestedObjType, map[string]tftypes.Value{
+		"c": tftypes.NewValue(tftypes.String, "world"),
+	})
+	objVal := tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"a":      tftypes.String,
+			"nested": nestedObjType,
+		},
+	}, map[string]tftypes.Value{
+		"a":      tftypes.NewValue(tftypes.String, "hello"),
+		"nested": nestedObjVal,
+	})
+
+	type nestedTarget struct {
+		D string `tfsdk:"d"`
+	}
+
+	targetVal := reflect.ValueOf(struct {
+		B      string       `tfsdk:"b"`
+		Nested nestedTarget `tfsdk:"nested"`
+	}{})
+
+	expectedDiags := diag.Diagnostics{
+		diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
+			Err:        errors.New("mismatch between struct and object: Struct defines fields not found in object: b. Object defines fields not found in struct: a."),
+			TargetType: targetVal.Type(),
+			Val:        objVal,
+		}),
+		diag.WithPath(path.Root("nested"), refl.DiagIntoIncompatibleType{
+			Err:        errors.New("mismatch between struct and object: Struct defines fields not found in object: d. Object defines fields not found in struct: c."),
+			TargetType: reflect.TypeOf(nestedTarget{}),
+			Val:        nestedObjVal,
+		}),
+	}
+
+	_, diags := refl.Struct(context.Background(), typ, objVal, targetVal, refl.Options{ReportAllMismatches: true}, path.Empty())
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics: %s", diff)
+	}
+}
+
 func TestNewStruct_primitives(t *testing.T) {
 	t.Parallel()
 
@@ -585,7 +693,7 @@ func TestFromStruct_primitives(t *testing.T) {
 			"age":      types.NumberType,
 			"opted_in": types.BoolType,
 		},
-	}, reflect.ValueOf(disk1), path.Empty())
+	}, reflect.ValueOf(disk1), refl.Options{}, path.Empty())
 	if diags.HasError() {
 		t.Fatalf("Unexpected error: %v", diags)
 	}
@@ -738,7 +846,7 @@ func TestFromStruct_complex(t *testing.T) {
 			"big_int":         types.NumberType,
 			"uint":            types.NumberType,
 		},
-	}, reflect.ValueOf(s), path.Empty())
+	}, reflect.ValueOf(s), refl.Options{}, path.Empty())
 	if diags.HasError() {
 		t.Errorf("Unexpected error: %v", diags)
 	}
@@ -1395,6 +1503,7 @@ func TestFromStruct_errors(t *testing.T) {
 				context.Background(),
 				testCase.typ,
 				testCase.val,
+				refl.Options{},
 				path.Root("test"),
 			)
 
@@ -1432,7 +1541,7 @@ func TestFromStruct_structtags_ignores(t *testing.T) {
 		AttrTypes: map[string]attr.Type{
 			"exported_and_tagged": types.StringType,
 		},
-	}, reflect.ValueOf(testStruct), path.Empty())
+	}, reflect.ValueOf(testStruct), refl.Options{}, path.Empty())
 	if diags.HasError() {
 		t.Fatalf("Unexpected error: %v", diags)
 	}
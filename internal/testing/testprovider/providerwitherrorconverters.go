@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var (
+	_ provider.Provider                    = &ProviderWithErrorConverters{}
+	_ provider.ProviderWithErrorConverters = &ProviderWithErrorConverters{}
+)
+
+// Declarative provider.ProviderWithErrorConverters for unit testing.
+type ProviderWithErrorConverters struct {
+	*Provider
+
+	// ProviderWithErrorConverters interface methods
+	ErrorConvertersMethod func(context.Context) []diag.ErrorConverter
+}
+
+// ErrorConverters satisfies the provider.ProviderWithErrorConverters interface.
+func (p *ProviderWithErrorConverters) ErrorConverters(ctx context.Context) []diag.ErrorConverter {
+	if p.ErrorConvertersMethod == nil {
+		return nil
+	}
+
+	return p.ErrorConvertersMethod(ctx)
+}
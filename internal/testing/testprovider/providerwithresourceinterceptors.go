@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ provider.Provider                         = &ProviderWithResourceInterceptors{}
+	_ provider.ProviderWithResourceInterceptors = &ProviderWithResourceInterceptors{}
+)
+
+// Declarative provider.ProviderWithResourceInterceptors for unit testing.
+type ProviderWithResourceInterceptors struct {
+	*Provider
+
+	// ProviderWithResourceInterceptors interface methods
+	ResourceInterceptorsMethod func(context.Context) []resource.Interceptor
+}
+
+// ResourceInterceptors satisfies the provider.ProviderWithResourceInterceptors interface.
+func (p *ProviderWithResourceInterceptors) ResourceInterceptors(ctx context.Context) []resource.Interceptor {
+	if p.ResourceInterceptorsMethod == nil {
+		return nil
+	}
+
+	return p.ResourceInterceptorsMethod(ctx)
+}
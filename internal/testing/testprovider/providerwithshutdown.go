@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithShutdown{}
+var _ provider.ProviderWithShutdown = &ProviderWithShutdown{}
+
+// Declarative provider.ProviderWithShutdown for unit testing.
+type ProviderWithShutdown struct {
+	*Provider
+
+	// ProviderWithShutdown interface methods
+	ShutdownMethod func(context.Context, provider.ShutdownRequest, *provider.ShutdownResponse)
+}
+
+// Shutdown satisfies the provider.ProviderWithShutdown interface.
+func (p *ProviderWithShutdown) Shutdown(ctx context.Context, req provider.ShutdownRequest, resp *provider.ShutdownResponse) {
+	if p.ShutdownMethod == nil {
+		return
+	}
+
+	p.ShutdownMethod(ctx, req, resp)
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithBatchRead{}
+var _ resource.ResourceWithBatchRead = &ResourceWithBatchRead{}
+
+// Declarative resource.ResourceWithBatchRead for unit testing.
+type ResourceWithBatchRead struct {
+	*Resource
+
+	// ResourceWithBatchRead interface methods
+	BatchReadMethod         func(context.Context, resource.BatchReadRequest, *resource.BatchReadResponse)
+	BatchReadSettingsMethod func(context.Context) resource.BatchReadSettings
+}
+
+// BatchRead satisfies the resource.ResourceWithBatchRead interface.
+func (r *ResourceWithBatchRead) BatchRead(ctx context.Context, req resource.BatchReadRequest, resp *resource.BatchReadResponse) {
+	if r.BatchReadMethod == nil {
+		return
+	}
+
+	r.BatchReadMethod(ctx, req, resp)
+}
+
+// BatchReadSettings satisfies the resource.ResourceWithBatchRead interface.
+func (r *ResourceWithBatchRead) BatchReadSettings(ctx context.Context) resource.BatchReadSettings {
+	if r.BatchReadSettingsMethod == nil {
+		return resource.BatchReadSettings{}
+	}
+
+	return r.BatchReadSettingsMethod(ctx)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithImportStateAndSensitiveValueTransform{}
+var _ resource.ResourceWithImportState = &ResourceWithImportStateAndSensitiveValueTransform{}
+var _ resource.ResourceWithSensitiveValueTransform = &ResourceWithImportStateAndSensitiveValueTransform{}
+
+// Declarative resource.ResourceWithImportStateAndSensitiveValueTransform for unit testing.
+type ResourceWithImportStateAndSensitiveValueTransform struct {
+	*Resource
+
+	// ResourceWithImportState interface methods
+	ImportStateMethod func(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse)
+
+	// ResourceWithSensitiveValueTransform interface methods
+	DecodeSensitiveValueMethod func(context.Context, resource.SensitiveValueTransformRequest, *resource.SensitiveValueTransformResponse)
+	EncodeSensitiveValueMethod func(context.Context, resource.SensitiveValueTransformRequest, *resource.SensitiveValueTransformResponse)
+}
+
+// ImportState satisfies the resource.ResourceWithImportState interface.
+func (r *ResourceWithImportStateAndSensitiveValueTransform) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if r.ImportStateMethod == nil {
+		return
+	}
+
+	r.ImportStateMethod(ctx, req, resp)
+}
+
+// DecodeSensitiveValue satisfies the resource.ResourceWithSensitiveValueTransform interface.
+func (r *ResourceWithImportStateAndSensitiveValueTransform) DecodeSensitiveValue(ctx context.Context, req resource.SensitiveValueTransformRequest, resp *resource.SensitiveValueTransformResponse) {
+	if r.DecodeSensitiveValueMethod == nil {
+		return
+	}
+
+	r.DecodeSensitiveValueMethod(ctx, req, resp)
+}
+
+// EncodeSensitiveValue satisfies the resource.ResourceWithSensitiveValueTransform interface.
+func (r *ResourceWithImportStateAndSensitiveValueTransform) EncodeSensitiveValue(ctx context.Context, req resource.SensitiveValueTransformRequest, resp *resource.SensitiveValueTransformResponse) {
+	if r.EncodeSensitiveValueMethod == nil {
+		return
+	}
+
+	r.EncodeSensitiveValueMethod(ctx, req, resp)
+}
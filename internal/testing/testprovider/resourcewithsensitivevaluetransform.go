@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithSensitiveValueTransform{}
+var _ resource.ResourceWithSensitiveValueTransform = &ResourceWithSensitiveValueTransform{}
+
+// Declarative resource.ResourceWithSensitiveValueTransform for unit testing.
+type ResourceWithSensitiveValueTransform struct {
+	*Resource
+
+	// ResourceWithSensitiveValueTransform interface methods
+	DecodeSensitiveValueMethod func(context.Context, resource.SensitiveValueTransformRequest, *resource.SensitiveValueTransformResponse)
+	EncodeSensitiveValueMethod func(context.Context, resource.SensitiveValueTransformRequest, *resource.SensitiveValueTransformResponse)
+}
+
+// DecodeSensitiveValue satisfies the resource.ResourceWithSensitiveValueTransform interface.
+func (p *ResourceWithSensitiveValueTransform) DecodeSensitiveValue(ctx context.Context, req resource.SensitiveValueTransformRequest, resp *resource.SensitiveValueTransformResponse) {
+	if p.DecodeSensitiveValueMethod == nil {
+		return
+	}
+
+	p.DecodeSensitiveValueMethod(ctx, req, resp)
+}
+
+// EncodeSensitiveValue satisfies the resource.ResourceWithSensitiveValueTransform interface.
+func (p *ResourceWithSensitiveValueTransform) EncodeSensitiveValue(ctx context.Context, req resource.SensitiveValueTransformRequest, resp *resource.SensitiveValueTransformResponse) {
+	if p.EncodeSensitiveValueMethod == nil {
+		return
+	}
+
+	p.EncodeSensitiveValueMethod(ctx, req, resp)
+}
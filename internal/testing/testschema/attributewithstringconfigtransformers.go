@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/transformer"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ fwschema.AttributeWithStringConfigTransformers = AttributeWithStringConfigTransformers{}
+
+type AttributeWithStringConfigTransformers struct {
+	Computed            bool
+	DeprecationMessage  string
+	Description         string
+	MarkdownDescription string
+	Optional            bool
+	Required            bool
+	Sensitive           bool
+	ConfigTransformers  []transformer.String
+}
+
+// ApplyTerraform5AttributePathStep satisfies the fwschema.Attribute interface.
+func (a AttributeWithStringConfigTransformers) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return a.GetType().ApplyTerraform5AttributePathStep(step)
+}
+
+// Equal satisfies the fwschema.Attribute interface.
+func (a AttributeWithStringConfigTransformers) Equal(o fwschema.Attribute) bool {
+	_, ok := o.(AttributeWithStringConfigTransformers)
+
+	if !ok {
+		return false
+	}
+
+	return fwschema.AttributesEqual(a, o)
+}
+
+// GetDeprecationMessage satisfies the fwschema.Attribute interface.
+func (a AttributeWithStringConfigTransformers) GetDeprecationMessage() string {
+	return a.DeprecationMessage
+}
+
+// GetDescription satisfies the fwschema.Attribute interface.
+func (a AttributeWithStringConfigTransformers) GetDescription() string {
+	return a.Description
+}
+
+// GetMarkdownDescription satisfies the fwschema.Attribute interface.
+func (a AttributeWithStringConfigTransformers) GetMarkdownDescription() string {
+	return a.MarkdownDescription
+}
+
+// GetType satisfies the fwschema.Attribute interface.
+func (a AttributeWithStringConfigTransformers) GetType() attr.Type {
+	return types.StringType
+}
+
+// IsComputed satisfies the fwschema.Attribute interface.
+func (a AttributeWithStringConfigTransformers) IsComputed() bool {
+	return a.Computed
+}
+
+// IsOptional satisfies the fwschema.Attribute interface.
+func (a AttributeWithStringConfigTransformers) IsOptional() bool {
+	return a.Optional
+}
+
+// IsRequired satisfies the fwschema.Attribute interface.
+func (a AttributeWithStringConfigTransformers) IsRequired() bool {
+	return a.Required
+}
+
+// IsSensitive satisfies the fwschema.Attribute interface.
+func (a AttributeWithStringConfigTransformers) IsSensitive() bool {
+	return a.Sensitive
+}
+
+// StringConfigTransformers satisfies the fwschema.AttributeWithStringConfigTransformers interface.
+func (a AttributeWithStringConfigTransformers) StringConfigTransformers() []transformer.String {
+	return a.ConfigTransformers
+}
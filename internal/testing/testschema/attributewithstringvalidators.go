@@ -12,7 +12,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-var _ fwxschema.AttributeWithStringValidators = AttributeWithStringValidators{}
+var (
+	_ fwxschema.AttributeWithStringValidators        = AttributeWithStringValidators{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = AttributeWithStringValidators{}
+)
 
 type AttributeWithStringValidators struct {
 	Computed            bool
@@ -23,6 +26,8 @@ type AttributeWithStringValidators struct {
 	Required            bool
 	Sensitive           bool
 	Validators          []validator.String
+
+	ValidatorsUnknownPolicy validator.UnknownPolicy
 }
 
 // ApplyTerraform5AttributePathStep satisfies the fwschema.Attribute interface.
@@ -56,6 +61,12 @@ func (a AttributeWithStringValidators) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetValidatorsUnknownPolicy satisfies the
+// fwxschema.AttributeWithValidatorsUnknownPolicy interface.
+func (a AttributeWithStringValidators) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // GetType satisfies the fwschema.Attribute interface.
 func (a AttributeWithStringValidators) GetType() attr.Type {
 	return types.StringType
@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package testtransformer contains declarative schema/transformer
+// implementations for unit testing.
+package testtransformer
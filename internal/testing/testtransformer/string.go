@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testtransformer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/transformer"
+)
+
+var _ transformer.String = &String{}
+
+// Declarative transformer.String for unit testing.
+type String struct {
+	// String interface methods
+	DescriptionMethod         func(context.Context) string
+	MarkdownDescriptionMethod func(context.Context) string
+	TransformStringMethod     func(context.Context, transformer.StringRequest, *transformer.StringResponse)
+}
+
+// Description satisfies the transformer.String interface.
+func (t String) Description(ctx context.Context) string {
+	if t.DescriptionMethod == nil {
+		return ""
+	}
+
+	return t.DescriptionMethod(ctx)
+}
+
+// MarkdownDescription satisfies the transformer.String interface.
+func (t String) MarkdownDescription(ctx context.Context) string {
+	if t.MarkdownDescriptionMethod == nil {
+		return ""
+	}
+
+	return t.MarkdownDescriptionMethod(ctx)
+}
+
+// TransformString satisfies the transformer.String interface.
+func (t String) TransformString(ctx context.Context, req transformer.StringRequest, resp *transformer.StringResponse) {
+	if t.TransformStringMethod == nil {
+		return
+	}
+
+	t.TransformStringMethod(ctx, req, resp)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testtypes
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+)
+
+var _ xattr.TypeWithConversionError = StringTypeWithConversionError{}
+
+// StringTypeWithConversionError is a string type which always returns an
+// error from ValueFromTerraform and describes the error with a
+// practitioner-facing diagnostic.
+type StringTypeWithConversionError struct {
+	StringType
+}
+
+func (t StringTypeWithConversionError) ConversionError(_ context.Context, req xattr.ConversionErrorRequest, resp *xattr.ConversionErrorResponse) {
+	resp.Diagnostics.AddError(
+		"Invalid CIDR Value",
+		"Expected CIDR like 10.0.0.0/16, got: "+req.Value.String()+". Error: "+req.Error.Error(),
+	)
+}
+
+func (t StringTypeWithConversionError) Equal(o attr.Type) bool {
+	other, ok := o.(StringTypeWithConversionError)
+	if !ok {
+		return false
+	}
+	return t == other
+}
+
+func (t StringTypeWithConversionError) ValueFromTerraform(_ context.Context, _ tftypes.Value) (attr.Value, error) {
+	return nil, errors.New("test conversion error")
+}
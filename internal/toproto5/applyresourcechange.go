@@ -19,16 +19,19 @@ func ApplyResourceChangeResponse(ctx context.Context, fw *fwserver.ApplyResource
 	}
 
 	proto5 := &tfprotov5.ApplyResourceChangeResponse{
-		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
+		Diagnostics:                 Diagnostics(ctx, fw.Diagnostics),
+		UnsafeToUseLegacyTypeSystem: fw.UnsafeToUseLegacyTypeSystem,
 	}
 
 	newState, diags := State(ctx, fw.NewState)
 
+	fw.Diagnostics.Append(diags...)
 	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
 	proto5.NewState = newState
 
 	newPrivate, diags := fw.Private.Bytes(ctx)
 
+	fw.Diagnostics.Append(diags...)
 	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
 	proto5.Private = newPrivate
 
@@ -155,6 +155,14 @@ func TestApplyResourceChangeResponse(t *testing.T) {
 				}),
 			},
 		},
+		"unsafetouselegacytypesystem": {
+			input: &fwserver.ApplyResourceChangeResponse{
+				UnsafeToUseLegacyTypeSystem: true,
+			},
+			expected: &tfprotov5.ApplyResourceChangeResponse{
+				UnsafeToUseLegacyTypeSystem: true,
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -24,13 +24,29 @@ func DiagnosticSeverity(s diag.Severity) tfprotov5.DiagnosticSeverity {
 	}
 }
 
+// DiagnosticDetail returns the diagnostic detail, including the diagnostic
+// code, if any.
+func DiagnosticDetail(d diag.Diagnostic) string {
+	code := diag.Code(d)
+
+	if code == "" {
+		return d.Detail()
+	}
+
+	if d.Detail() == "" {
+		return "Diagnostic Code: " + code
+	}
+
+	return d.Detail() + "\n\nDiagnostic Code: " + code
+}
+
 // Diagnostics converts the diagnostics into the tfprotov5 collection type.
 func Diagnostics(ctx context.Context, diagnostics diag.Diagnostics) []*tfprotov5.Diagnostic {
 	var results []*tfprotov5.Diagnostic
 
 	for _, diagnostic := range diagnostics {
 		tfprotov5Diagnostic := &tfprotov5.Diagnostic{
-			Detail:   diagnostic.Detail(),
+			Detail:   DiagnosticDetail(diagnostic),
 			Severity: DiagnosticSeverity(diagnostic.Severity()),
 			Summary:  diagnostic.Summary(),
 		}
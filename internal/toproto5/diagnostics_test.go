@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func TestDiagnosticSeverity(t *testing.T) {
@@ -93,6 +94,25 @@ func TestDiagnostics(t *testing.T) {
 				},
 			},
 		},
+		"DiagnosticWithCode": {
+			diags: diag.Diagnostics{
+				diag.WithCode("TEST_CODE_ONE", diag.NewErrorDiagnostic("one summary", "one detail")),
+				diag.WithCode("TEST_CODE_TWO", diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "")),
+			},
+			expected: []*tfprotov5.Diagnostic{
+				{
+					Detail:   "one detail\n\nDiagnostic Code: TEST_CODE_ONE",
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "one summary",
+				},
+				{
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+					Detail:    "Diagnostic Code: TEST_CODE_TWO",
+					Severity:  tfprotov5.DiagnosticSeverityWarning,
+					Summary:   "two summary",
+				},
+			},
+		},
 		"DiagnosticWithPath": {
 			diags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(path.Empty(), "one summary", "one detail"),
@@ -113,6 +133,25 @@ func TestDiagnostics(t *testing.T) {
 				},
 			},
 		},
+		"DiagnosticWithPath-ElementKeyValue": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(types.StringValue("element")),
+					"one summary",
+					"one detail",
+				),
+			},
+			expected: []*tfprotov5.Diagnostic{
+				{
+					Attribute: tftypes.NewAttributePath().
+						WithAttributeName("test").
+						WithElementKeyValue(tftypes.NewValue(tftypes.String, "element")),
+					Detail:   "one detail",
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "one summary",
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
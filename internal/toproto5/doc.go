@@ -3,4 +3,8 @@
 
 // Package toproto5 contains functions to convert from framework types to
 // protocol version 5 (tfprotov5) types.
+//
+// Response functions also append any diagnostics from converting the
+// response, such as message size errors, to the framework response
+// diagnostics, so they are included when the RPC finishes.
 package toproto5
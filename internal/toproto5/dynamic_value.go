@@ -43,5 +43,14 @@ func DynamicValue(ctx context.Context, data *fwschemadata.Data) (*tfprotov5.Dyna
 		return nil, diags
 	}
 
+	// Prevent opaque Terraform transport errors for oversized data.
+	sizeDiags := data.MessageSizeDiagnostics(ctx, len(proto5.MsgPack))
+
+	if sizeDiags.HasError() {
+		diags.Append(sizeDiags...)
+
+		return nil, diags
+	}
+
 	return &proto5, nil
 }
@@ -26,6 +26,7 @@ func ImportResourceStateResponse(ctx context.Context, fw *fwserver.ImportResourc
 	for _, fwImportedResource := range fw.ImportedResources {
 		proto5ImportedResource, diags := ImportedResource(ctx, &fwImportedResource)
 
+		fw.Diagnostics.Append(diags...)
 		proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
 
 		if diags.HasError() {
@@ -24,11 +24,13 @@ func MoveResourceStateResponse(ctx context.Context, fw *fwserver.MoveResourceSta
 
 	targetPrivate, diags := fw.TargetPrivate.Bytes(ctx)
 
+	fw.Diagnostics.Append(diags...)
 	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
 	proto5.TargetPrivate = targetPrivate
 
 	targetState, diags := State(ctx, fw.TargetState)
 
+	fw.Diagnostics.Append(diags...)
 	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
 	proto5.TargetState = targetState
 
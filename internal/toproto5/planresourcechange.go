@@ -20,22 +20,26 @@ func PlanResourceChangeResponse(ctx context.Context, fw *fwserver.PlanResourceCh
 	}
 
 	proto5 := &tfprotov5.PlanResourceChangeResponse{
-		Deferred:    ResourceDeferred(fw.Deferred),
-		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
+		Deferred:                    ResourceDeferred(fw.Deferred),
+		Diagnostics:                 Diagnostics(ctx, fw.Diagnostics),
+		UnsafeToUseLegacyTypeSystem: fw.UnsafeToUseLegacyTypeSystem,
 	}
 
 	plannedState, diags := State(ctx, fw.PlannedState)
 
+	fw.Diagnostics.Append(diags...)
 	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
 	proto5.PlannedState = plannedState
 
 	requiresReplace, diags := totftypes.AttributePaths(ctx, fw.RequiresReplace)
 
+	fw.Diagnostics.Append(diags...)
 	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
 	proto5.RequiresReplace = requiresReplace
 
 	plannedPrivate, diags := fw.PlannedPrivate.Bytes(ctx)
 
+	fw.Diagnostics.Append(diags...)
 	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
 	proto5.PlannedPrivate = plannedPrivate
 
@@ -197,6 +197,14 @@ func TestPlanResourceChangeResponse(t *testing.T) {
 				Deferred: testProto5Deferred,
 			},
 		},
+		"unsafetouselegacytypesystem": {
+			input: &fwserver.PlanResourceChangeResponse{
+				UnsafeToUseLegacyTypeSystem: true,
+			},
+			expected: &tfprotov5.PlanResourceChangeResponse{
+				UnsafeToUseLegacyTypeSystem: true,
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -25,6 +25,7 @@ func ReadDataSourceResponse(ctx context.Context, fw *fwserver.ReadDataSourceResp
 
 	state, diags := State(ctx, fw.State)
 
+	fw.Diagnostics.Append(diags...)
 	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
 	proto5.State = state
 
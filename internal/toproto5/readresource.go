@@ -25,11 +25,13 @@ func ReadResourceResponse(ctx context.Context, fw *fwserver.ReadResourceResponse
 
 	newState, diags := State(ctx, fw.NewState)
 
+	fw.Diagnostics.Append(diags...)
 	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
 	proto5.NewState = newState
 
 	newPrivate, diags := fw.Private.Bytes(ctx)
 
+	fw.Diagnostics.Append(diags...)
 	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
 	proto5.Private = newPrivate
 
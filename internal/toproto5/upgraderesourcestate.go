@@ -23,6 +23,7 @@ func UpgradeResourceStateResponse(ctx context.Context, fw *fwserver.UpgradeResou
 
 	upgradedState, diags := State(ctx, fw.UpgradedState)
 
+	fw.Diagnostics.Append(diags...)
 	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
 	proto5.UpgradedState = upgradedState
 
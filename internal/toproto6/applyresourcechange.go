@@ -19,16 +19,19 @@ func ApplyResourceChangeResponse(ctx context.Context, fw *fwserver.ApplyResource
 	}
 
 	proto6 := &tfprotov6.ApplyResourceChangeResponse{
-		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
+		Diagnostics:                 Diagnostics(ctx, fw.Diagnostics),
+		UnsafeToUseLegacyTypeSystem: fw.UnsafeToUseLegacyTypeSystem,
 	}
 
 	newState, diags := State(ctx, fw.NewState)
 
+	fw.Diagnostics.Append(diags...)
 	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 	proto6.NewState = newState
 
 	newPrivate, diags := fw.Private.Bytes(ctx)
 
+	fw.Diagnostics.Append(diags...)
 	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 	proto6.Private = newPrivate
 
@@ -154,6 +154,14 @@ func TestApplyResourceChangeResponse(t *testing.T) {
 				}),
 			},
 		},
+		"unsafetouselegacytypesystem": {
+			input: &fwserver.ApplyResourceChangeResponse{
+				UnsafeToUseLegacyTypeSystem: true,
+			},
+			expected: &tfprotov6.ApplyResourceChangeResponse{
+				UnsafeToUseLegacyTypeSystem: true,
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
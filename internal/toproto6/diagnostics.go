@@ -24,13 +24,29 @@ func DiagnosticSeverity(s diag.Severity) tfprotov6.DiagnosticSeverity {
 	}
 }
 
+// DiagnosticDetail returns the diagnostic detail, including the diagnostic
+// code, if any.
+func DiagnosticDetail(d diag.Diagnostic) string {
+	code := diag.Code(d)
+
+	if code == "" {
+		return d.Detail()
+	}
+
+	if d.Detail() == "" {
+		return "Diagnostic Code: " + code
+	}
+
+	return d.Detail() + "\n\nDiagnostic Code: " + code
+}
+
 // Diagnostics converts the diagnostics into the tfprotov6 collection type.
 func Diagnostics(ctx context.Context, diagnostics diag.Diagnostics) []*tfprotov6.Diagnostic {
 	var results []*tfprotov6.Diagnostic
 
 	for _, diagnostic := range diagnostics {
 		tfprotov6Diagnostic := &tfprotov6.Diagnostic{
-			Detail:   diagnostic.Detail(),
+			Detail:   DiagnosticDetail(diagnostic),
 			Severity: DiagnosticSeverity(diagnostic.Severity()),
 			Summary:  diagnostic.Summary(),
 		}
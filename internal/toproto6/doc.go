@@ -3,4 +3,8 @@
 
 // Package toproto6 contains functions to convert from framework types to
 // protocol version 6 (tfprotov6) types.
+//
+// Response functions also append any diagnostics from converting the
+// response, such as message size errors, to the framework response
+// diagnostics, so they are included when the RPC finishes.
 package toproto6
@@ -1247,3 +1247,53 @@ func TestDynamicValue(t *testing.T) {
 		})
 	}
 }
+
+func TestDynamicValue_MessageSizeLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx := fwschemadata.WithMessageSizeLimit(context.Background(), 10)
+
+	fw := &fwschemadata.Data{
+		Description: fwschemadata.DataDescriptionState,
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"test": testschema.Attribute{
+					Optional: true,
+					Type:     types.StringType,
+				},
+			},
+		},
+		TerraformValue: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.String,
+				},
+			},
+			map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "test-value"),
+			},
+		),
+	}
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"State Exceeds Message Size Limit",
+			"The encoded state is 17 bytes, which exceeds the message size limit of 10 bytes. "+
+				"Terraform would be unable to receive it and would only report a transport error. "+
+				"Reduce the amount of data in the largest attributes. "+
+				"Please report this to the provider developers.\n\n"+
+				"Largest Attributes:\n\n"+
+				"- test: 11 bytes",
+		),
+	}
+
+	got, diags := toproto6.DynamicValue(ctx, fw)
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	if got != nil {
+		t.Errorf("expected no value, got: %v", got)
+	}
+}
@@ -26,6 +26,7 @@ func ImportResourceStateResponse(ctx context.Context, fw *fwserver.ImportResourc
 	for _, fwImportedResource := range fw.ImportedResources {
 		proto6ImportedResource, diags := ImportedResource(ctx, &fwImportedResource)
 
+		fw.Diagnostics.Append(diags...)
 		proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 
 		if diags.HasError() {
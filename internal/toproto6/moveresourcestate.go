@@ -24,11 +24,13 @@ func MoveResourceStateResponse(ctx context.Context, fw *fwserver.MoveResourceSta
 
 	targetPrivate, diags := fw.TargetPrivate.Bytes(ctx)
 
+	fw.Diagnostics.Append(diags...)
 	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 	proto6.TargetPrivate = targetPrivate
 
 	targetState, diags := State(ctx, fw.TargetState)
 
+	fw.Diagnostics.Append(diags...)
 	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 	proto6.TargetState = targetState
 
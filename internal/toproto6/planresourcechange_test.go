@@ -197,6 +197,14 @@ func TestPlanResourceChangeResponse(t *testing.T) {
 				Deferred: testProto6Deferred,
 			},
 		},
+		"unsafetouselegacytypesystem": {
+			input: &fwserver.PlanResourceChangeResponse{
+				UnsafeToUseLegacyTypeSystem: true,
+			},
+			expected: &tfprotov6.PlanResourceChangeResponse{
+				UnsafeToUseLegacyTypeSystem: true,
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -25,6 +25,7 @@ func ReadDataSourceResponse(ctx context.Context, fw *fwserver.ReadDataSourceResp
 
 	state, diags := State(ctx, fw.State)
 
+	fw.Diagnostics.Append(diags...)
 	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 	proto6.State = state
 
@@ -25,11 +25,13 @@ func ReadResourceResponse(ctx context.Context, fw *fwserver.ReadResourceResponse
 
 	newState, diags := State(ctx, fw.NewState)
 
+	fw.Diagnostics.Append(diags...)
 	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 	proto6.NewState = newState
 
 	newPrivate, diags := fw.Private.Bytes(ctx)
 
+	fw.Diagnostics.Append(diags...)
 	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 	proto6.Private = newPrivate
 
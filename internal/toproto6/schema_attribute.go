@@ -71,6 +71,12 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 			return nil, err
 		}
 
+		// Sensitive nested attributes propagate sensitivity to all
+		// underlying attributes, so it is not necessary to repeat it.
+		if schemaAttribute.Sensitive {
+			schemaAttributeSensitive(nestedSchemaAttribute)
+		}
+
 		object.Attributes = append(object.Attributes, nestedSchemaAttribute)
 	}
 
@@ -91,3 +97,21 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 
 	return schemaAttribute, nil
 }
+
+// schemaAttributeSensitive marks the given *tfprotov6.SchemaAttribute and all
+// of its nested attributes, if any, as sensitive.
+func schemaAttributeSensitive(a *tfprotov6.SchemaAttribute) {
+	if a == nil {
+		return
+	}
+
+	a.Sensitive = true
+
+	if a.NestedType == nil {
+		return
+	}
+
+	for _, nestedA := range a.NestedType.Attributes {
+		schemaAttributeSensitive(nestedA)
+	}
+}
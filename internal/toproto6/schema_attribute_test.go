@@ -426,6 +426,67 @@ func TestSchemaAttribute(t *testing.T) {
 				},
 			},
 		},
+		"nested-attr-sensitive": {
+			name: "single_nested",
+			attr: testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"string": testschema.Attribute{
+							Type:     types.StringType,
+							Optional: true,
+						},
+						"list_nested": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"string": testschema.Attribute{
+										Type:     types.StringType,
+										Optional: true,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeList,
+							Optional:    true,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeSingle,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name:      "single_nested",
+				Optional:  true,
+				Sensitive: true,
+				NestedType: &tfprotov6.SchemaObject{
+					Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:      "list_nested",
+							Optional:  true,
+							Sensitive: true,
+							NestedType: &tfprotov6.SchemaObject{
+								Nesting: tfprotov6.SchemaObjectNestingModeList,
+								Attributes: []*tfprotov6.SchemaAttribute{
+									{
+										Name:      "string",
+										Optional:  true,
+										Sensitive: true,
+										Type:      tftypes.String,
+									},
+								},
+							},
+						},
+						{
+							Name:      "string",
+							Optional:  true,
+							Sensitive: true,
+							Type:      tftypes.String,
+						},
+					},
+				},
+			},
+		},
 		"missing-required-optional-and-computed": {
 			name: "whoops",
 			attr: testschema.Attribute{
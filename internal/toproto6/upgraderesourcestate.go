@@ -23,6 +23,7 @@ func UpgradeResourceStateResponse(ctx context.Context, fw *fwserver.UpgradeResou
 
 	upgradedState, diags := State(ctx, fw.UpgradedState)
 
+	fw.Diagnostics.Append(diags...)
 	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 	proto6.UpgradedState = upgradedState
 
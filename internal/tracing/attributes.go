@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Span attributes share the structured logging keys, so traces and logs can
+// be correlated.
+
+// Attribute is a span attribute which is only built when the context
+// contains a framework tracer, so provider defined logic, such as validator
+// descriptions, is not called when tracing is disabled.
+type Attribute func() attribute.KeyValue
+
+// describer is implemented by provider defined types with descriptions, such
+// as validators and plan modifiers.
+type describer interface {
+	Description(context.Context) string
+}
+
+// AttributePath returns a span attribute for the given attribute path.
+func AttributePath(p path.Path) Attribute {
+	return func() attribute.KeyValue {
+		return attribute.String(logging.KeyAttributePath, p.String())
+	}
+}
+
+// DataSourceType returns a span attribute for the given data source type.
+func DataSourceType(typeName string) Attribute {
+	return func() attribute.KeyValue {
+		return attribute.String(logging.KeyDataSourceType, typeName)
+	}
+}
+
+// Description returns a span attribute for the description of the given
+// provider defined type, such as validators.
+func Description(ctx context.Context, d describer) Attribute {
+	return func() attribute.KeyValue {
+		return attribute.String(logging.KeyDescription, d.Description(ctx))
+	}
+}
+
+// FunctionName returns a span attribute for the given function name.
+func FunctionName(name string) Attribute {
+	return func() attribute.KeyValue {
+		return attribute.String(logging.KeyFunctionName, name)
+	}
+}
+
+// ResourceType returns a span attribute for the given resource type.
+func ResourceType(typeName string) Attribute {
+	return func() attribute.KeyValue {
+		return attribute.String(logging.KeyResourceType, typeName)
+	}
+}
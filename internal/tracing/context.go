@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// TracerName is the instrumentation name of the framework tracer.
+const TracerName = "github.com/hashicorp/terraform-plugin-framework"
+
+// tracerKey is the context key for the framework tracer.
+type tracerKey struct{}
+
+// InitContext stores the framework tracer from the given TracerProvider in
+// the context. If the TracerProvider is nil, the context is returned
+// unmodified and Start will not create spans.
+func InitContext(ctx context.Context, tracerProvider trace.TracerProvider) context.Context {
+	if tracerProvider == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, tracerKey{}, tracerProvider.Tracer(TracerName))
+}
+
+// Start creates a span with the given name and attributes using the
+// framework tracer in the context. If there is no framework tracer in the
+// context, the context is returned unmodified with a no-op span.
+func Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, trace.Span) {
+	tracer, ok := ctx.Value(tracerKey{}).(trace.Tracer)
+
+	if !ok {
+		return ctx, noop.Span{}
+	}
+
+	return tracer.Start(ctx, name, trace.WithAttributes(keyValues(attributes)...))
+}
+
+// SetAttributes sets the given attributes on the current span in the context,
+// if any. This is useful when attributes are not known when starting the span.
+func SetAttributes(ctx context.Context, attributes ...Attribute) {
+	span := trace.SpanFromContext(ctx)
+
+	if !span.IsRecording() {
+		return
+	}
+
+	span.SetAttributes(keyValues(attributes)...)
+}
+
+// keyValues builds the given span attributes.
+func keyValues(attributes []Attribute) []attribute.KeyValue {
+	keyValues := make([]attribute.KeyValue, 0, len(attributes))
+
+	for _, buildAttribute := range attributes {
+		keyValues = append(keyValues, buildAttribute())
+	}
+
+	return keyValues
+}
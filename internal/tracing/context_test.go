@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/tracing"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestStart(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tracerProvider *testTracerProvider
+		expected       []string
+	}{
+		"no-tracerprovider": {},
+		"tracerprovider": {
+			tracerProvider: &testTracerProvider{},
+			expected: []string{
+				"test-span tf_attribute_path=test",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			if testCase.tracerProvider != nil {
+				ctx = tracing.InitContext(ctx, testCase.tracerProvider)
+			}
+
+			_, span := tracing.Start(ctx, "test-span", tracing.AttributePath(path.Root("test")))
+			span.End()
+
+			var got []string
+
+			if testCase.tracerProvider != nil {
+				got = testCase.tracerProvider.spans
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStart_NoTracer(t *testing.T) {
+	t.Parallel()
+
+	ctx := tracing.InitContext(context.Background(), nil)
+
+	got, span := tracing.Start(ctx, "test-span", tracing.Description(ctx, testDescriber{t: t}))
+
+	if got != ctx {
+		t.Errorf("expected unmodified context")
+	}
+
+	if span.SpanContext().IsValid() {
+		t.Errorf("expected no-op span")
+	}
+}
+
+// testDescriber fails the test if its description is built.
+type testDescriber struct {
+	t *testing.T
+}
+
+func (d testDescriber) Description(_ context.Context) string {
+	d.t.Errorf("unexpected Description call")
+
+	return "test"
+}
+
+// testTracerProvider records the name and attributes of started spans.
+type testTracerProvider struct {
+	noop.TracerProvider
+
+	spans []string
+}
+
+func (p *testTracerProvider) Tracer(_ string, _ ...trace.TracerOption) trace.Tracer {
+	return testTracer{provider: p}
+}
+
+type testTracer struct {
+	noop.Tracer
+
+	provider *testTracerProvider
+}
+
+func (t testTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	span := name
+	config := trace.NewSpanStartConfig(opts...)
+
+	for _, kv := range config.Attributes() {
+		span += " " + string(kv.Key) + "=" + kv.Value.Emit()
+	}
+
+	t.provider.spans = append(t.provider.spans, span)
+
+	return t.Tracer.Start(ctx, name, opts...)
+}
@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tracing contains framework internal helpers for optional
+// OpenTelemetry tracing of RPC handling and provider defined logic.
+package tracing
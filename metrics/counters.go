@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"sync"
+	"time"
+)
+
+var (
+	_ Recorder   = &Counters{}
+	_ expvar.Var = &Counters{}
+)
+
+// Counters is a Recorder which accumulates the metrics of each protocol RPC
+// name in memory. It also implements the expvar.Var interface, so it can be
+// published with the expvar.Publish function. Use NewCounters to create a
+// Counters.
+type Counters struct {
+	rpcs   map[string]RPCCounters
+	rpcsMu sync.Mutex
+}
+
+// NewCounters returns a new Counters without any recorded RPCs.
+func NewCounters() *Counters {
+	return &Counters{
+		rpcs: make(map[string]RPCCounters),
+	}
+}
+
+// RPCCounters contains the accumulated metrics of a protocol RPC name.
+type RPCCounters struct {
+	// Count is the number of handled RPCs.
+	Count int64 `json:"count"`
+
+	// Duration is the total duration of handling the RPCs.
+	Duration time.Duration `json:"duration_ns"`
+
+	// ErrorCount is the total number of error diagnostics in responses.
+	ErrorCount int64 `json:"error_count"`
+
+	// WarningCount is the total number of warning diagnostics in responses.
+	WarningCount int64 `json:"warning_count"`
+}
+
+// RecordRPC satisfies the Recorder interface.
+func (c *Counters) RecordRPC(_ context.Context, rpc RPC) {
+	c.rpcsMu.Lock()
+	defer c.rpcsMu.Unlock()
+
+	if c.rpcs == nil {
+		c.rpcs = make(map[string]RPCCounters)
+	}
+
+	counters := c.rpcs[rpc.Name]
+
+	counters.Count++
+	counters.Duration += rpc.Duration
+	counters.ErrorCount += int64(rpc.ErrorCount)
+	counters.WarningCount += int64(rpc.WarningCount)
+
+	c.rpcs[rpc.Name] = counters
+}
+
+// Snapshot returns a copy of the accumulated metrics, keyed by protocol RPC
+// name. RPCs which have not been handled are not included.
+func (c *Counters) Snapshot() map[string]RPCCounters {
+	c.rpcsMu.Lock()
+	defer c.rpcsMu.Unlock()
+
+	result := make(map[string]RPCCounters, len(c.rpcs))
+
+	for name, counters := range c.rpcs {
+		result[name] = counters
+	}
+
+	return result
+}
+
+// String returns the Snapshot as a JSON object, which satisfies the
+// expvar.Var interface.
+func (c *Counters) String() string {
+	// Marshalling a map with string keys and only integer values cannot
+	// return an error.
+	result, _ := json.Marshal(c.Snapshot())
+
+	return string(result)
+}
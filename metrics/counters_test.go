@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
+)
+
+func TestCountersRecordRPC(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rpcs     []metrics.RPC
+		expected map[string]metrics.RPCCounters
+	}{
+		"none": {
+			expected: map[string]metrics.RPCCounters{},
+		},
+		"one": {
+			rpcs: []metrics.RPC{
+				{
+					Name:         "ReadResource",
+					Duration:     time.Second,
+					ErrorCount:   1,
+					WarningCount: 2,
+				},
+			},
+			expected: map[string]metrics.RPCCounters{
+				"ReadResource": {
+					Count:        1,
+					Duration:     time.Second,
+					ErrorCount:   1,
+					WarningCount: 2,
+				},
+			},
+		},
+		"multiple": {
+			rpcs: []metrics.RPC{
+				{
+					Name:     "PlanResourceChange",
+					Duration: time.Second,
+				},
+				{
+					Name:         "ReadResource",
+					Duration:     time.Second,
+					WarningCount: 1,
+				},
+				{
+					Name:       "ReadResource",
+					Duration:   2 * time.Second,
+					ErrorCount: 1,
+				},
+			},
+			expected: map[string]metrics.RPCCounters{
+				"PlanResourceChange": {
+					Count:    1,
+					Duration: time.Second,
+				},
+				"ReadResource": {
+					Count:        2,
+					Duration:     3 * time.Second,
+					ErrorCount:   1,
+					WarningCount: 1,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			counters := metrics.NewCounters()
+
+			for _, rpc := range testCase.rpcs {
+				counters.RecordRPC(context.Background(), rpc)
+			}
+
+			got := counters.Snapshot()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCountersString(t *testing.T) {
+	t.Parallel()
+
+	counters := metrics.NewCounters()
+
+	counters.RecordRPC(context.Background(), metrics.RPC{
+		Name:         "ReadResource",
+		Duration:     time.Millisecond,
+		ErrorCount:   1,
+		WarningCount: 2,
+	})
+
+	got := counters.String()
+	expected := `{"ReadResource":{"count":1,"duration_ns":1000000,"error_count":1,"warning_count":2}}`
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package metrics contains functionality for recording metrics about the
+// protocol RPCs handled by a provider server, such as RPC counts, durations,
+// and diagnostic severities.
+//
+// Set the providerserver.ServeOpts MetricsRecorder field to a Recorder
+// implementation to enable recording. The Counters type is an in-memory
+// Recorder which can be published with the standard library expvar package
+// or exposed in the Prometheus text exposition format with the
+// PrometheusHandler method, so provider operators can monitor plugin behavior
+// in automation environments.
+package metrics
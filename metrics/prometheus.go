@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+const (
+	// PrometheusRPCsTotal is the name of the Prometheus counter of handled
+	// RPCs, labelled by RPC name.
+	PrometheusRPCsTotal = "terraform_plugin_framework_rpcs_total"
+
+	// PrometheusRPCDurationSecondsTotal is the name of the Prometheus
+	// counter of the total duration of handling RPCs in seconds, labelled by
+	// RPC name.
+	PrometheusRPCDurationSecondsTotal = "terraform_plugin_framework_rpc_duration_seconds_total"
+
+	// PrometheusRPCDiagnosticsTotal is the name of the Prometheus counter of
+	// diagnostics in RPC responses, labelled by RPC name and severity.
+	PrometheusRPCDiagnosticsTotal = "terraform_plugin_framework_rpc_diagnostics_total"
+
+	// prometheusContentType is the content type of the Prometheus text-based
+	// exposition format.
+	prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// WritePrometheus writes the accumulated metrics to w in the Prometheus
+// text-based exposition format, which allows them to be scraped without an
+// additional dependency on the Prometheus client libraries. Metrics are
+// written in order of RPC name.
+func (c *Counters) WritePrometheus(w io.Writer) error {
+	snapshot := c.Snapshot()
+	names := make([]string, 0, len(snapshot))
+
+	for name := range snapshot {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	buf := bufio.NewWriter(w)
+
+	fmt.Fprintf(buf, "# HELP %s Number of protocol RPCs handled by the provider server.\n", PrometheusRPCsTotal)
+	fmt.Fprintf(buf, "# TYPE %s counter\n", PrometheusRPCsTotal)
+
+	for _, name := range names {
+		fmt.Fprintf(buf, "%s{rpc=%q} %d\n", PrometheusRPCsTotal, name, snapshot[name].Count)
+	}
+
+	fmt.Fprintf(buf, "# HELP %s Total duration of handling protocol RPCs in seconds.\n", PrometheusRPCDurationSecondsTotal)
+	fmt.Fprintf(buf, "# TYPE %s counter\n", PrometheusRPCDurationSecondsTotal)
+
+	for _, name := range names {
+		seconds := strconv.FormatFloat(snapshot[name].Duration.Seconds(), 'g', -1, 64)
+
+		fmt.Fprintf(buf, "%s{rpc=%q} %s\n", PrometheusRPCDurationSecondsTotal, name, seconds)
+	}
+
+	fmt.Fprintf(buf, "# HELP %s Number of diagnostics in protocol RPC responses.\n", PrometheusRPCDiagnosticsTotal)
+	fmt.Fprintf(buf, "# TYPE %s counter\n", PrometheusRPCDiagnosticsTotal)
+
+	for _, name := range names {
+		fmt.Fprintf(buf, "%s{rpc=%q,severity=\"error\"} %d\n", PrometheusRPCDiagnosticsTotal, name, snapshot[name].ErrorCount)
+		fmt.Fprintf(buf, "%s{rpc=%q,severity=\"warning\"} %d\n", PrometheusRPCDiagnosticsTotal, name, snapshot[name].WarningCount)
+	}
+
+	return buf.Flush()
+}
+
+// PrometheusHandler returns an http.Handler which responds with the
+// accumulated metrics in the Prometheus text-based exposition format, such
+// as for a /metrics endpoint.
+func (c *Counters) PrometheusHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", prometheusContentType)
+
+		// The response has already started, so there is no way to return
+		// the error to the client.
+		_ = c.WritePrometheus(w)
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
+)
+
+func TestCountersWritePrometheus(t *testing.T) {
+	t.Parallel()
+
+	counters := metrics.NewCounters()
+
+	counters.RecordRPC(context.Background(), metrics.RPC{
+		Name:       "ReadResource",
+		Duration:   1500 * time.Millisecond,
+		ErrorCount: 1,
+	})
+	counters.RecordRPC(context.Background(), metrics.RPC{
+		Name:         "PlanResourceChange",
+		Duration:     250 * time.Millisecond,
+		WarningCount: 2,
+	})
+
+	var got strings.Builder
+
+	err := counters.WritePrometheus(&got)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `# HELP terraform_plugin_framework_rpcs_total Number of protocol RPCs handled by the provider server.
+# TYPE terraform_plugin_framework_rpcs_total counter
+terraform_plugin_framework_rpcs_total{rpc="PlanResourceChange"} 1
+terraform_plugin_framework_rpcs_total{rpc="ReadResource"} 1
+# HELP terraform_plugin_framework_rpc_duration_seconds_total Total duration of handling protocol RPCs in seconds.
+# TYPE terraform_plugin_framework_rpc_duration_seconds_total counter
+terraform_plugin_framework_rpc_duration_seconds_total{rpc="PlanResourceChange"} 0.25
+terraform_plugin_framework_rpc_duration_seconds_total{rpc="ReadResource"} 1.5
+# HELP terraform_plugin_framework_rpc_diagnostics_total Number of diagnostics in protocol RPC responses.
+# TYPE terraform_plugin_framework_rpc_diagnostics_total counter
+terraform_plugin_framework_rpc_diagnostics_total{rpc="PlanResourceChange",severity="error"} 0
+terraform_plugin_framework_rpc_diagnostics_total{rpc="PlanResourceChange",severity="warning"} 2
+terraform_plugin_framework_rpc_diagnostics_total{rpc="ReadResource",severity="error"} 1
+terraform_plugin_framework_rpc_diagnostics_total{rpc="ReadResource",severity="warning"} 0
+`
+
+	if diff := cmp.Diff(got.String(), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestCountersPrometheusHandler(t *testing.T) {
+	t.Parallel()
+
+	counters := metrics.NewCounters()
+
+	counters.RecordRPC(context.Background(), metrics.RPC{
+		Name: "GetProviderSchema",
+	})
+
+	recorder := httptest.NewRecorder()
+
+	counters.PrometheusHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if diff := cmp.Diff(recorder.Header().Get("Content-Type"), "text/plain; version=0.0.4; charset=utf-8"); diff != "" {
+		t.Errorf("unexpected content type difference: %s", diff)
+	}
+
+	if !strings.Contains(recorder.Body.String(), `terraform_plugin_framework_rpcs_total{rpc="GetProviderSchema"} 1`) {
+		t.Errorf("expected GetProviderSchema count in body, got: %s", recorder.Body.String())
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics
+
+import (
+	"context"
+	"time"
+)
+
+// Recorder is the interface for recording metrics about protocol RPCs handled
+// by the provider server. Implementations must be safe for concurrent use, as
+// Terraform can send RPCs concurrently.
+type Recorder interface {
+	// RecordRPC is called when the provider server finishes handling each
+	// protocol RPC.
+	RecordRPC(context.Context, RPC)
+}
+
+// RPC contains the metrics of a single protocol RPC handled by the provider
+// server.
+type RPC struct {
+	// Name is the protocol RPC name, such as "PlanResourceChange".
+	Name string
+
+	// Duration is the duration of framework handling of the RPC, including
+	// provider defined logic, but excluding any time spent waiting for
+	// ServeOpts RPCConcurrencyLimits.
+	Duration time.Duration
+
+	// ErrorCount is the number of error diagnostics in the RPC response. A
+	// CallFunction RPC response error is counted as one error diagnostic.
+	ErrorCount int
+
+	// WarningCount is the number of warning diagnostics in the RPC response.
+	WarningCount int
+}
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -62,6 +63,13 @@ type ConfigureResponse struct {
 	// that implements the Configure method.
 	ResourceData any
 
+	// ResourceTimeouts are the default operation timeouts for each Resource
+	// type, which the framework applies to the Create, Read, Update, and
+	// Delete method contexts as deadlines. Resources can override these
+	// defaults in their "timeouts" data. Refer to [resource.Timeouts] for
+	// more information.
+	ResourceTimeouts resource.Timeouts
+
 	// Deferred indicates that Terraform should automatically defer
 	// all resources and data sources for this provider.
 	//
@@ -21,4 +21,31 @@ type MetadataResponse struct {
 	// This is not connected to any framework functionality currently, but may
 	// be in the future.
 	Version string
+
+	// ProviderBehavior is used to control framework-specific logic when
+	// interacting with this provider and all of its resources.
+	ProviderBehavior ProviderBehavior
+}
+
+// ProviderBehavior controls framework-specific logic when interacting with
+// a provider and all of its resources.
+type ProviderBehavior struct {
+	// When LegacyTypeSystemCompatibility is true, framework will apply
+	// terraform-plugin-sdk/v2 compatible value behaviors to all resources,
+	// which reduces plan differences for resources migrated from
+	// terraform-plugin-sdk/v2 during a migration window:
+	//
+	//   - When planning an update, string values which are empty in the
+	//     plan and null in the prior state, or the reverse, are planned as
+	//     the prior state value.
+	//   - Terraform is signaled to tolerate planned and applied values which
+	//     are inconsistent with the configuration or plan, such as set
+	//     elements which only differ by empty and null values, by logging
+	//     warnings instead of raising errors.
+	//
+	// This is intended to be temporary while practitioners migrate
+	// configurations and state. Errors that Terraform would otherwise raise
+	// indicate real issues in the provider which should be fixed before
+	// disabling this behavior.
+	LegacyTypeSystemCompatibility bool
 }
@@ -115,7 +115,11 @@ func (s Schema) Validate() diag.Diagnostics {
 func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	for attributeName, attribute := range s.GetAttributes() {
+	attributes := s.GetAttributes()
+
+	for _, attributeName := range fwschema.SortedNames(attributes) {
+		attribute := attributes[attributeName]
+
 		req := fwschema.ValidateImplementationRequest{
 			Name: attributeName,
 			Path: path.Root(attributeName),
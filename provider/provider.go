@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
@@ -106,6 +107,53 @@ type ProviderWithMetaSchema interface {
 	MetaSchema(context.Context, MetaSchemaRequest, *MetaSchemaResponse)
 }
 
+// ProviderWithErrorConverters is an interface type that extends Provider to
+// include converters which map errors, such as API client errors, to
+// diagnostics. The converters are used by error-returning resource handlers,
+// such as resource.CreateWithError, so the mapping is implemented once
+// instead of within each resource method.
+type ProviderWithErrorConverters interface {
+	Provider
+
+	// ErrorConverters returns the error converters. Converters are tried in
+	// slice order and the first converter which handles an error is used.
+	ErrorConverters(context.Context) []diag.ErrorConverter
+}
+
+// ProviderWithResourceInterceptors is an interface type that extends Provider
+// to include interceptors which wrap the Create, Read, Update, and Delete
+// methods of every resource in the provider. This enables implementing
+// cross-cutting logic, such as logging, metrics, or serialization of
+// operations, once instead of within each resource method.
+type ProviderWithResourceInterceptors interface {
+	Provider
+
+	// ResourceInterceptors returns the interceptors to wrap resource
+	// operations. Interceptors are called in slice order, where the first
+	// interceptor is the outermost and the resource method is innermost.
+	ResourceInterceptors(context.Context) []resource.Interceptor
+}
+
+// ProviderWithShutdown is an interface type that extends Provider to include
+// a teardown signal when the provider server stops, such as when Terraform
+// ends the provider process. This enables releasing resources which are
+// shared across RPCs, such as closing API client connection pools or
+// flushing telemetry.
+//
+// Shutdown is only called by providerserver.Serve after the provider server
+// has stopped serving RPCs, and is called at most once. Its context is not
+// canceled with the context given to providerserver.Serve, but has a deadline
+// of 30 seconds, after which cleanup should be abandoned. Provider servers
+// created with the providerserver.NewProtocol5, NewProtocol5WithError,
+// NewProtocol6, or NewProtocol6WithError functions do not call Shutdown, as
+// the caller controls their lifecycle.
+type ProviderWithShutdown interface {
+	Provider
+
+	// Shutdown releases any resources held by the provider.
+	Shutdown(context.Context, ShutdownRequest, *ShutdownResponse)
+}
+
 // ProviderWithValidateConfig is an interface type that extends Provider to include imperative validation.
 //
 // Declaring validation using this methodology simplifies one-off
@@ -15,8 +15,9 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                                = DynamicAttribute{}
-	_ fwxschema.AttributeWithDynamicValidators = DynamicAttribute{}
+	_ Attribute                                      = DynamicAttribute{}
+	_ fwxschema.AttributeWithDynamicValidators       = DynamicAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = DynamicAttribute{}
 )
 
 // DynamicAttribute represents a schema attribute that is a dynamic, rather
@@ -109,6 +110,11 @@ type DynamicAttribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Dynamic
+
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -175,3 +181,8 @@ func (a DynamicAttribute) IsSensitive() bool {
 func (a DynamicAttribute) DynamicValidators() []validator.Dynamic {
 	return a.Validators
 }
+
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a DynamicAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
@@ -15,8 +15,9 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                              = Int64Attribute{}
-	_ fwxschema.AttributeWithInt64Validators = Int64Attribute{}
+	_ Attribute                                      = Int64Attribute{}
+	_ fwxschema.AttributeWithInt64Validators         = Int64Attribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = Int64Attribute{}
 )
 
 // Int64Attribute represents a schema attribute that is a 64-bit integer.
@@ -115,6 +116,11 @@ type Int64Attribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Int64
+
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -162,6 +168,11 @@ func (a Int64Attribute) Int64Validators() []validator.Int64 {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a Int64Attribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // IsComputed always returns false as provider schemas cannot be Computed.
 func (a Int64Attribute) IsComputed() bool {
 	return false
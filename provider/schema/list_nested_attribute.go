@@ -19,9 +19,10 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ NestedAttribute                              = ListNestedAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = ListNestedAttribute{}
-	_ fwxschema.AttributeWithListValidators        = ListNestedAttribute{}
+	_ NestedAttribute                                = ListNestedAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = ListNestedAttribute{}
+	_ fwxschema.AttributeWithListValidators          = ListNestedAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = ListNestedAttribute{}
 )
 
 // ListNestedAttribute represents an attribute that is a list of objects where
@@ -141,6 +142,11 @@ type ListNestedAttribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.List
+
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
 }
 
 // ApplyTerraform5AttributePathStep returns the Attributes field value if step
@@ -228,6 +234,11 @@ func (a ListNestedAttribute) ListValidators() []validator.List {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a ListNestedAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
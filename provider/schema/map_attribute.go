@@ -18,9 +18,10 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                                    = MapAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = MapAttribute{}
-	_ fwxschema.AttributeWithMapValidators         = MapAttribute{}
+	_ Attribute                                      = MapAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = MapAttribute{}
+	_ fwxschema.AttributeWithMapValidators           = MapAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = MapAttribute{}
 )
 
 // MapAttribute represents a schema attribute that is a list with a single
@@ -134,6 +135,11 @@ type MapAttribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Map
+
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into a map
@@ -203,6 +209,11 @@ func (a MapAttribute) MapValidators() []validator.Map {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a MapAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC
@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package providerconfig provides helpers for a standard provider
+// configuration attribute group for HTTP transport settings, such as API
+// endpoint overrides, TLS certificate verification, and request timeouts, so
+// provider configuration schemas are consistent across providers.
+package providerconfig
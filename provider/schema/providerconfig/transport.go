@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TransportName is the conventional name of the transport attribute.
+const TransportName = "transport"
+
+// TransportAttribute returns an optional single nested attribute containing
+// the standard transport configuration attributes, which should be added to
+// the provider schema attributes under TransportName. The attribute data can
+// be read into a *TransportModel field. The endpoint and request_timeout
+// attributes validate that their values are an absolute URL and a duration
+// string respectively, so invalid values are reported during validation.
+//
+// Use the returned attribute as a starting point if the provider requires
+// different behaviors, such as additional validators.
+func TransportAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"endpoint": schema.StringAttribute{
+				Description:         "URL to use instead of the default API endpoint.",
+				MarkdownDescription: "URL to use instead of the default API endpoint.",
+				Optional:            true,
+				Validators: []validator.String{
+					endpointValidator{},
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description:         "Whether to skip TLS certificate verification. Only use for testing.",
+				MarkdownDescription: "Whether to skip TLS certificate verification. Only use for testing.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description:         "Timeout for each API request, as a duration string such as \"30s\" or \"1m\".",
+				MarkdownDescription: "Timeout for each API request, as a duration string such as `30s` or `1m`.",
+				Optional:            true,
+				Validators: []validator.String{
+					requestTimeoutValidator{},
+				},
+			},
+		},
+		Description:         "HTTP transport configuration.",
+		MarkdownDescription: "HTTP transport configuration.",
+		Optional:            true,
+	}
+}
+
+// TransportModel is the data model of the attribute returned by
+// TransportAttribute. Use a pointer to allow the attribute to be null.
+type TransportModel struct {
+	Endpoint           types.String `tfsdk:"endpoint"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+}
+
+// EndpointURL returns the parsed endpoint, or nil if the endpoint is null or
+// unknown. The attributePath should be the path of the transport attribute
+// and is used for diagnostics.
+func (m *TransportModel) EndpointURL(_ context.Context, attributePath path.Path) (*url.URL, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m == nil || m.Endpoint.IsNull() || m.Endpoint.IsUnknown() {
+		return nil, diags
+	}
+
+	endpoint, err := parseEndpoint(m.Endpoint.ValueString())
+
+	if err != nil {
+		diags.Append(invalidEndpointDiagnostic(attributePath.AtName("endpoint"), m.Endpoint.ValueString(), err))
+
+		return nil, diags
+	}
+
+	return endpoint, diags
+}
+
+// HTTPClient returns a new *http.Client with the configured TLS certificate
+// verification and request timeout, based on a clone of the
+// http.DefaultTransport, or a new *http.Transport with the same defaults if
+// http.DefaultTransport was replaced with another type. A nil TransportModel, such as when the attribute is
+// null, returns a client with the default settings. The attributePath should
+// be the path of the transport attribute and is used for diagnostics.
+func (m *TransportModel) HTTPClient(_ context.Context, attributePath path.Path) (*http.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	transport := defaultTransport()
+	client := &http.Client{
+		Transport: transport,
+	}
+
+	if m == nil {
+		return client, diags
+	}
+
+	if m.InsecureSkipVerify.ValueBool() {
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+
+		transport.TLSClientConfig.InsecureSkipVerify = true
+	}
+
+	if !m.RequestTimeout.IsNull() && !m.RequestTimeout.IsUnknown() {
+		timeout, err := parseRequestTimeout(m.RequestTimeout.ValueString())
+
+		if err != nil {
+			diags.Append(invalidRequestTimeoutDiagnostic(attributePath.AtName("request_timeout"), m.RequestTimeout.ValueString(), err))
+
+			return nil, diags
+		}
+
+		client.Timeout = timeout
+	}
+
+	return client, diags
+}
+
+// defaultTransport returns a clone of http.DefaultTransport, or a new
+// *http.Transport with the same default settings if http.DefaultTransport was
+// replaced with another type.
+func defaultTransport() *http.Transport {
+	if transport, ok := http.DefaultTransport.(*http.Transport); ok {
+		return transport.Clone()
+	}
+
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
+
+// parseEndpoint parses an endpoint, which must be an absolute URL.
+func parseEndpoint(value string) (*url.URL, error) {
+	endpoint, err := url.Parse(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("missing scheme or host")
+	}
+
+	return endpoint, nil
+}
+
+// parseRequestTimeout parses a request timeout, which must be a non-negative
+// duration string.
+func parseRequestTimeout(value string) (time.Duration, error) {
+	timeout, err := time.ParseDuration(value)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if timeout < 0 {
+		return 0, fmt.Errorf("duration must not be negative")
+	}
+
+	return timeout, nil
+}
+
+func invalidEndpointDiagnostic(attributePath path.Path, value string, err error) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Invalid Endpoint",
+		fmt.Sprintf("The endpoint must be an absolute URL, such as \"https://example.com\". Received %q: %s", value, err),
+	)
+}
+
+func invalidRequestTimeoutDiagnostic(attributePath path.Path, value string, err error) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Invalid Request Timeout",
+		fmt.Sprintf("The request timeout must be a duration string, such as \"30s\" or \"1m\". Received %q: %s", value, err),
+	)
+}
+
+var _ validator.String = endpointValidator{}
+
+// endpointValidator validates that the endpoint attribute is an absolute URL.
+type endpointValidator struct{}
+
+func (v endpointValidator) Description(_ context.Context) string {
+	return "value must be an absolute URL"
+}
+
+func (v endpointValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v endpointValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseEndpoint(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.Append(invalidEndpointDiagnostic(req.Path, req.ConfigValue.ValueString(), err))
+	}
+}
+
+var _ validator.String = requestTimeoutValidator{}
+
+// requestTimeoutValidator validates that the request_timeout attribute is a
+// non-negative duration string.
+type requestTimeoutValidator struct{}
+
+func (v requestTimeoutValidator) Description(_ context.Context) string {
+	return "value must be a non-negative duration string"
+}
+
+func (v requestTimeoutValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v requestTimeoutValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseRequestTimeout(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.Append(invalidRequestTimeoutDiagnostic(req.Path, req.ConfigValue.ValueString(), err))
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerconfig_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema/providerconfig"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTransportModelEndpointURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		model         *providerconfig.TransportModel
+		expected      *url.URL
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {},
+		"null": {
+			model: &providerconfig.TransportModel{
+				Endpoint: types.StringNull(),
+			},
+		},
+		"unknown": {
+			model: &providerconfig.TransportModel{
+				Endpoint: types.StringUnknown(),
+			},
+		},
+		"valid": {
+			model: &providerconfig.TransportModel{
+				Endpoint: types.StringValue("https://example.com/api"),
+			},
+			expected: &url.URL{
+				Scheme: "https",
+				Host:   "example.com",
+				Path:   "/api",
+			},
+		},
+		"invalid": {
+			model: &providerconfig.TransportModel{
+				Endpoint: types.StringValue("example.com"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("transport").AtName("endpoint"),
+					"Invalid Endpoint",
+					"The endpoint must be an absolute URL, such as \"https://example.com\". Received \"example.com\": missing scheme or host",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.model.EndpointURL(context.Background(), path.Root(providerconfig.TransportName))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTransportModelHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		model                      *providerconfig.TransportModel
+		expectedInsecureSkipVerify bool
+		expectedTimeout            time.Duration
+		expectedDiags              diag.Diagnostics
+	}{
+		"nil": {},
+		"null": {
+			model: &providerconfig.TransportModel{
+				Endpoint:           types.StringNull(),
+				InsecureSkipVerify: types.BoolNull(),
+				RequestTimeout:     types.StringNull(),
+			},
+		},
+		"insecure_skip_verify": {
+			model: &providerconfig.TransportModel{
+				InsecureSkipVerify: types.BoolValue(true),
+			},
+			expectedInsecureSkipVerify: true,
+		},
+		"request_timeout": {
+			model: &providerconfig.TransportModel{
+				RequestTimeout: types.StringValue("1m30s"),
+			},
+			expectedTimeout: 90 * time.Second,
+		},
+		"request_timeout-invalid": {
+			model: &providerconfig.TransportModel{
+				RequestTimeout: types.StringValue("-1s"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("transport").AtName("request_timeout"),
+					"Invalid Request Timeout",
+					"The request timeout must be a duration string, such as \"30s\" or \"1m\". Received \"-1s\": duration must not be negative",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.model.HTTPClient(context.Background(), path.Root(providerconfig.TransportName))
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			if got.Timeout != testCase.expectedTimeout {
+				t.Errorf("expected timeout %s, got: %s", testCase.expectedTimeout, got.Timeout)
+			}
+
+			transport, ok := got.Transport.(*http.Transport)
+
+			if !ok {
+				t.Fatalf("expected *http.Transport, got: %T", got.Transport)
+			}
+
+			gotInsecureSkipVerify := transport.TLSClientConfig != nil && transport.TLSClientConfig.InsecureSkipVerify
+
+			if gotInsecureSkipVerify != testCase.expectedInsecureSkipVerify {
+				t.Errorf("expected InsecureSkipVerify %t, got: %t", testCase.expectedInsecureSkipVerify, gotInsecureSkipVerify)
+			}
+
+			if transport == http.DefaultTransport {
+				t.Error("expected cloned transport, got http.DefaultTransport")
+			}
+		})
+	}
+}
+
+//nolint:paralleltest // Replaces the global http.DefaultTransport.
+func TestTransportModelHTTPClient_replacedDefaultTransport(t *testing.T) {
+	defaultTransport := http.DefaultTransport
+
+	t.Cleanup(func() {
+		http.DefaultTransport = defaultTransport
+	})
+
+	http.DefaultTransport = http.RoundTripper(nil)
+
+	model := &providerconfig.TransportModel{
+		InsecureSkipVerify: types.BoolValue(true),
+	}
+
+	got, diags := model.HTTPClient(context.Background(), path.Root(providerconfig.TransportName))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	transport, ok := got.Transport.(*http.Transport)
+
+	if !ok {
+		t.Fatalf("expected *http.Transport, got: %T", got.Transport)
+	}
+
+	if transport.Proxy == nil {
+		t.Error("expected default Proxy setting")
+	}
+
+	if transport.TLSClientConfig == nil || !transport.TLSClientConfig.InsecureSkipVerify {
+		t.Error("expected InsecureSkipVerify")
+	}
+}
+
+func TestTransportAttributeValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute     string
+		value         types.String
+		expectedDiags diag.Diagnostics
+	}{
+		"endpoint-null": {
+			attribute: "endpoint",
+			value:     types.StringNull(),
+		},
+		"endpoint-unknown": {
+			attribute: "endpoint",
+			value:     types.StringUnknown(),
+		},
+		"endpoint-valid": {
+			attribute: "endpoint",
+			value:     types.StringValue("https://example.com/api"),
+		},
+		"endpoint-invalid": {
+			attribute: "endpoint",
+			value:     types.StringValue("example.com"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("transport").AtName("endpoint"),
+					"Invalid Endpoint",
+					"The endpoint must be an absolute URL, such as \"https://example.com\". Received \"example.com\": missing scheme or host",
+				),
+			},
+		},
+		"request_timeout-null": {
+			attribute: "request_timeout",
+			value:     types.StringNull(),
+		},
+		"request_timeout-valid": {
+			attribute: "request_timeout",
+			value:     types.StringValue("30s"),
+		},
+		"request_timeout-invalid": {
+			attribute: "request_timeout",
+			value:     types.StringValue("30"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("transport").AtName("request_timeout"),
+					"Invalid Request Timeout",
+					"The request timeout must be a duration string, such as \"30s\" or \"1m\". Received \"30\": time: missing unit in duration \"30\"",
+				),
+			},
+		},
+		"request_timeout-negative": {
+			attribute: "request_timeout",
+			value:     types.StringValue("-1s"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("transport").AtName("request_timeout"),
+					"Invalid Request Timeout",
+					"The request timeout must be a duration string, such as \"30s\" or \"1m\". Received \"-1s\": duration must not be negative",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attribute, ok := providerconfig.TransportAttribute().Attributes[testCase.attribute].(schema.StringAttribute)
+
+			if !ok {
+				t.Fatalf("expected schema.StringAttribute for %s", testCase.attribute)
+			}
+
+			req := validator.StringRequest{
+				Path:        path.Root(providerconfig.TransportName).AtName(testCase.attribute),
+				ConfigValue: testCase.value,
+			}
+
+			var diags diag.Diagnostics
+
+			for _, v := range attribute.StringValidators() {
+				resp := &validator.StringResponse{}
+
+				v.ValidateString(context.Background(), req, resp)
+
+				diags.Append(resp.Diagnostics...)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -139,7 +139,11 @@ func (s Schema) Validate() diag.Diagnostics {
 func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	for attributeName, attribute := range s.GetAttributes() {
+	attributes := s.GetAttributes()
+
+	for _, attributeName := range fwschema.SortedNames(attributes) {
+		attribute := attributes[attributeName]
+
 		req := fwschema.ValidateImplementationRequest{
 			Name: attributeName,
 			Path: path.Root(attributeName),
@@ -149,7 +153,11 @@ func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 		diags.Append(fwschema.ValidateAttributeImplementation(ctx, attribute, req)...)
 	}
 
-	for blockName, block := range s.GetBlocks() {
+	blocks := s.GetBlocks()
+
+	for _, blockName := range fwschema.SortedNames(blocks) {
+		block := blocks[blockName]
+
 		req := fwschema.ValidateImplementationRequest{
 			Name: blockName,
 			Path: path.Root(blockName),
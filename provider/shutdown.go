@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ShutdownRequest represents a request for the provider to release any
+// resources it holds because the provider server has stopped. An instance of
+// this request struct is supplied as an argument to the provider's Shutdown
+// function.
+type ShutdownRequest struct{}
+
+// ShutdownResponse represents a response to a ShutdownRequest. An instance of
+// this response struct is supplied as an argument to the provider's Shutdown
+// function.
+type ShutdownResponse struct {
+	// Diagnostics report errors or warnings related to releasing resources.
+	// As Terraform is no longer communicating with the provider, these are
+	// only logged by the framework.
+	Diagnostics diag.Diagnostics
+}
@@ -9,7 +9,8 @@
 // from the provider codebase main package. If multiplexing the provider server
 // via terraform-plugin-mux functionality, use the NewProtocol* functions and
 // call the Serve function from that Go module. For testing usage, call the
-// NewProtocol* functions.
+// NewProtocol* functions. For protocol level testing with raw RPC data, refer
+// to the providerservertest package.
 //
 // All functionality in this package requires the provider.Provider type, which
 // contains the provider implementation including all managed resources and
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// CheckProtocol5 builds the schemas of the provider and all of its data
+// sources and resources, then reports every use of functionality which
+// requires protocol version 6, without serving the provider. Currently, this
+// is any nested attribute, such as schema.ListNestedAttribute, which must be
+// replaced with a block or an attribute with an object element type.
+//
+// An error listing each schema and attribute path is returned if there are
+// any problems, so provider developers know exactly what blocks serving the
+// provider with protocol version 5, such as for terraform-plugin-mux
+// compatibility with an SDKv2 provider. Only the outermost nested attribute of
+// each attribute path is reported. Validate calls CheckProtocol5 when the
+// protocol version is 5.
+func CheckProtocol5(ctx context.Context, providerFunc func() provider.Provider) error {
+	problems := protocol5Problems(ctx, providerFunc)
+
+	if len(problems) > 0 {
+		return fmt.Errorf("protocol version 5 check found %d problem(s):\n\n%s", len(problems), strings.Join(problems, "\n\n"))
+	}
+
+	return nil
+}
+
+// protocol5Problems returns a problem for each nested attribute in the
+// provider, provider_meta, data source, and resource schemas, or the error
+// diagnostics of building the schemas.
+func protocol5Problems(ctx context.Context, providerFunc func() provider.Provider) []string {
+	server := &fwserver.Server{
+		Provider: providerFunc(),
+	}
+	resp := &fwserver.GetProviderSchemaResponse{}
+
+	server.GetProviderSchema(ctx, &fwserver.GetProviderSchemaRequest{}, resp)
+
+	var problems []string
+
+	for _, d := range resp.Diagnostics.Errors() {
+		problems = append(problems, validateProblem(d.Summary(), d.Detail()))
+	}
+
+	if len(problems) > 0 {
+		return problems
+	}
+
+	problems = append(problems, protocol5SchemaProblems("Provider", resp.Provider)...)
+	problems = append(problems, protocol5SchemaProblems("Provider meta", resp.ProviderMeta)...)
+
+	for _, typeName := range sortedKeys(resp.DataSourceSchemas) {
+		problems = append(problems, protocol5SchemaProblems(fmt.Sprintf("Data source %q", typeName), resp.DataSourceSchemas[typeName])...)
+	}
+
+	for _, typeName := range sortedKeys(resp.ResourceSchemas) {
+		problems = append(problems, protocol5SchemaProblems(fmt.Sprintf("Resource %q", typeName), resp.ResourceSchemas[typeName])...)
+	}
+
+	return problems
+}
+
+// protocol5SchemaProblems returns a problem for each nested attribute in the
+// schema. The subject, such as `Resource "examplecloud_thing"`, begins each
+// problem detail.
+func protocol5SchemaProblems(subject string, schema fwschema.Schema) []string {
+	if schema == nil {
+		return nil
+	}
+
+	var problems []string
+
+	for _, attributePath := range protocol5NestedAttributePaths("", schema.GetAttributes(), schema.GetBlocks()) {
+		problems = append(problems, validateProblem(
+			"Protocol Version 5 Unsupported Nested Attribute",
+			fmt.Sprintf("%s attribute %q is a nested attribute, which requires protocol version 6. "+
+				"Use a block or an attribute with an object element type instead.", subject, attributePath),
+		))
+	}
+
+	return problems
+}
+
+// protocol5NestedAttributePaths returns the dot-separated path of each nested
+// attribute in the attributes and, recursively, the blocks, in sorted order.
+// Block element steps, such as list indexes, are omitted.
+func protocol5NestedAttributePaths(prefix string, attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) []string {
+	var result []string
+
+	for _, name := range sortedKeys(attributes) {
+		if _, ok := attributes[name].(fwschema.NestedAttribute); ok {
+			result = append(result, prefix+name)
+		}
+	}
+
+	for _, name := range sortedKeys(blocks) {
+		nestedObject := blocks[name].GetNestedObject()
+
+		if nestedObject == nil {
+			continue
+		}
+
+		result = append(result, protocol5NestedAttributePaths(prefix+name+".", nestedObject.GetAttributes(), nestedObject.GetBlocks())...)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// sortedKeys returns the keys of the map in sorted order.
+func sortedKeys[T any](m map[string]T) []string {
+	result := make([]string, 0, len(m))
+
+	for key := range m {
+		result = append(result, key)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestCheckProtocol5(t *testing.T) {
+	t.Parallel()
+
+	testDataSource := func() datasource.DataSource {
+		return &testprovider.DataSource{
+			MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+				resp.TypeName = "test_data_source"
+			},
+			SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+				resp.Schema = datasourceschema.Schema{
+					Attributes: map[string]datasourceschema.Attribute{
+						"items": datasourceschema.ListNestedAttribute{
+							NestedObject: datasourceschema.NestedAttributeObject{
+								Attributes: map[string]datasourceschema.Attribute{
+									"nested": datasourceschema.SingleNestedAttribute{
+										Attributes: map[string]datasourceschema.Attribute{
+											"test": datasourceschema.StringAttribute{
+												Computed: true,
+											},
+										},
+										Computed: true,
+									},
+								},
+							},
+							Computed: true,
+						},
+					},
+				}
+			},
+		}
+	}
+
+	testResource := func() resource.Resource {
+		return &testprovider.Resource{
+			MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+				resp.TypeName = "test_resource"
+			},
+			SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+				resp.Schema = resourceschema.Schema{
+					Attributes: map[string]resourceschema.Attribute{
+						"id": resourceschema.StringAttribute{
+							Computed: true,
+						},
+					},
+					Blocks: map[string]resourceschema.Block{
+						"config": resourceschema.ListNestedBlock{
+							NestedObject: resourceschema.NestedBlockObject{
+								Attributes: map[string]resourceschema.Attribute{
+									"settings": resourceschema.MapNestedAttribute{
+										NestedObject: resourceschema.NestedAttributeObject{
+											Attributes: map[string]resourceschema.Attribute{
+												"test": resourceschema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+										Optional: true,
+									},
+									"test": resourceschema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				}
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		providerFunc func() provider.Provider
+		expected     []string
+	}{
+		"valid": {
+			providerFunc: func() provider.Provider {
+				return &testprovider.Provider{}
+			},
+		},
+		"nested-attributes": {
+			providerFunc: func() provider.Provider {
+				return &testprovider.Provider{
+					DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+						return []func() datasource.DataSource{
+							testDataSource,
+						}
+					},
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							testResource,
+						}
+					},
+				}
+			},
+			expected: []string{
+				`Protocol Version 5 Unsupported Nested Attribute: Data source "test_data_source" attribute "items" is a nested attribute, which requires protocol version 6. ` +
+					`Use a block or an attribute with an object element type instead.`,
+				`Protocol Version 5 Unsupported Nested Attribute: Resource "test_resource" attribute "config.settings" is a nested attribute, which requires protocol version 6. ` +
+					`Use a block or an attribute with an object element type instead.`,
+			},
+		},
+		"schema-error": {
+			providerFunc: func() provider.Provider {
+				return &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							testResource,
+							testResource,
+						}
+					},
+				}
+			},
+			expected: []string{
+				"Duplicate Resource Type Defined: The test_resource resource type name was returned for multiple resources. Resource type names must be unique. This is always an issue with the provider and should be reported to the provider developers.",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := protocol5Problems(context.Background(), testCase.providerFunc)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			err := CheckProtocol5(context.Background(), testCase.providerFunc)
+
+			if (err != nil) != (len(testCase.expected) > 0) {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -6,8 +6,11 @@ package providerserver
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/proto5server"
 	"github.com/hashicorp/terraform-plugin-framework/internal/proto6server"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -75,7 +78,9 @@ func NewProtocol6WithError(p provider.Provider) func() (tfprotov6.ProviderServer
 	}
 }
 
-// Serve serves a provider, blocking until the context is canceled.
+// Serve serves a provider, blocking until the context is canceled. After the
+// provider server stops, the Shutdown method is called on providers which
+// implement provider.ProviderWithShutdown.
 func Serve(ctx context.Context, providerFunc func() provider.Provider, opts ServeOpts) error {
 	err := opts.validate(ctx)
 
@@ -83,6 +88,18 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 		return fmt.Errorf("unable to validate ServeOpts: %w", err)
 	}
 
+	if opts.ValidateOnly {
+		return Validate(ctx, providerFunc, opts.ProtocolVersion)
+	}
+
+	if opts.SchemaJSONOutput != nil {
+		return WriteSchemaJSON(ctx, providerFunc, opts.Address, opts.ProtocolVersion, opts.SchemaJSONOutput)
+	}
+
+	var servers shutdownServers
+
+	defer servers.shutdown(ctx)
+
 	switch opts.ProtocolVersion {
 	case 5:
 		var tf5serverOpts []tf5server.ServeOpt
@@ -96,11 +113,26 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 			func() tfprotov5.ProviderServer {
 				provider := providerFunc()
 
-				return &proto5server.Server{
+				server := &proto5server.Server{
 					FrameworkServer: fwserver.Server{
 						Provider: provider,
 					},
+					RPCStartHook:    opts.RPCStartHook,
+					RPCFinishHook:   opts.RPCFinishHook,
+					MetricsRecorder: opts.MetricsRecorder,
+					TracerProvider:  opts.TracerProvider,
+
+					AttributeAccessLogging:   opts.AttributeAccessLogging,
+					DecodedModelCaching:      opts.DecodedModelCaching,
+					ReadDriftLogging:         opts.ReadDriftLogging,
+					ReportAllModelMismatches: opts.ReportAllModelMismatches,
+					RPCConcurrencyLimits:     opts.RPCConcurrencyLimits,
+					MaxMessageSize:           opts.MaxMessageSize,
 				}
+
+				servers.add(&server.FrameworkServer)
+
+				return server
 			},
 			tf5serverOpts...,
 		)
@@ -116,13 +148,62 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 			func() tfprotov6.ProviderServer {
 				provider := providerFunc()
 
-				return &proto6server.Server{
+				server := &proto6server.Server{
 					FrameworkServer: fwserver.Server{
 						Provider: provider,
 					},
+					RPCStartHook:    opts.RPCStartHook,
+					RPCFinishHook:   opts.RPCFinishHook,
+					MetricsRecorder: opts.MetricsRecorder,
+					TracerProvider:  opts.TracerProvider,
+
+					AttributeAccessLogging:   opts.AttributeAccessLogging,
+					DecodedModelCaching:      opts.DecodedModelCaching,
+					ReadDriftLogging:         opts.ReadDriftLogging,
+					ReportAllModelMismatches: opts.ReportAllModelMismatches,
+					RPCConcurrencyLimits:     opts.RPCConcurrencyLimits,
+					MaxMessageSize:           opts.MaxMessageSize,
 				}
+
+				servers.add(&server.FrameworkServer)
+
+				return server
 			},
 			tf6serverOpts...,
 		)
 	}
 }
+
+// shutdownTimeout is the maximum duration of the context given to the
+// Shutdown method of all providers after the provider server stops.
+const shutdownTimeout = 30 * time.Second
+
+// shutdownServers collects the framework servers created by Serve, so their
+// providers can be shut down after the provider server stops.
+type shutdownServers struct {
+	mutex   sync.Mutex
+	servers []*fwserver.Server
+}
+
+func (s *shutdownServers) add(server *fwserver.Server) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.servers = append(s.servers, server)
+}
+
+func (s *shutdownServers) shutdown(ctx context.Context) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// The context is usually canceled when the provider server stops, so
+	// shutdown does not inherit its cancellation, only a bounded deadline.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+	defer cancel()
+
+	ctx = logging.InitContext(ctx)
+
+	for _, server := range s.servers {
+		server.Shutdown(ctx)
+	}
+}
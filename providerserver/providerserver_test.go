@@ -6,8 +6,11 @@ package providerserver
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -81,3 +84,45 @@ func TestNewProtocol6WithError(t *testing.T) {
 		t.Fatalf("unexpected error calling ProviderServer: %s", err)
 	}
 }
+
+func TestShutdownServers_canceledContext(t *testing.T) {
+	t.Parallel()
+
+	var called bool
+
+	p := &testprovider.ProviderWithShutdown{
+		Provider: &testprovider.Provider{},
+		ShutdownMethod: func(ctx context.Context, _ provider.ShutdownRequest, _ *provider.ShutdownResponse) {
+			called = true
+
+			if err := ctx.Err(); err != nil {
+				t.Errorf("unexpected context error: %s", err)
+			}
+
+			deadline, ok := ctx.Deadline()
+
+			if !ok {
+				t.Fatal("expected context deadline")
+			}
+
+			if remaining := time.Until(deadline); remaining > shutdownTimeout {
+				t.Errorf("expected deadline within %s, got: %s", shutdownTimeout, remaining)
+			}
+		},
+	}
+
+	var servers shutdownServers
+
+	servers.add(&fwserver.Server{
+		Provider: p,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	servers.shutdown(ctx)
+
+	if !called {
+		t.Error("expected Shutdown to be called")
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package providerservertest provides a harness for testing a provider at the
+// protocol level, by calling the protocol version 6 RPCs of a
+// provider.Provider directly with raw DynamicValue data. This is intended for
+// advanced use cases, such as protocol conformance testing. Most providers
+// should use terraform-plugin-testing acceptance testing instead.
+package providerservertest
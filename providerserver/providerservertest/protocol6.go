@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerservertest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+)
+
+// Protocol6Server is a protocol version 6 ProviderServer for a
+// provider.Provider. All RPCs can be called directly on the embedded
+// ProviderServer, while the additional methods simplify creating and reading
+// the DynamicValue data of those RPCs.
+type Protocol6Server struct {
+	tfprotov6.ProviderServer
+}
+
+// NewProtocol6Server returns a Protocol6Server for the given Provider.
+func NewProtocol6Server(p provider.Provider) *Protocol6Server {
+	return &Protocol6Server{
+		ProviderServer: providerserver.NewProtocol6(p)(),
+	}
+}
+
+// ProviderType returns the Terraform type of the provider schema.
+func (s *Protocol6Server) ProviderType(ctx context.Context) (tftypes.Type, error) {
+	resp, err := s.providerSchema(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Provider == nil {
+		return nil, fmt.Errorf("provider schema not found")
+	}
+
+	return resp.Provider.ValueType(), nil
+}
+
+// DataSourceType returns the Terraform type of the given data source type
+// schema.
+func (s *Protocol6Server) DataSourceType(ctx context.Context, typeName string) (tftypes.Type, error) {
+	resp, err := s.providerSchema(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schema, ok := resp.DataSourceSchemas[typeName]
+
+	if !ok || schema == nil {
+		return nil, fmt.Errorf("data source type %q not found", typeName)
+	}
+
+	return schema.ValueType(), nil
+}
+
+// ResourceType returns the Terraform type of the given resource type schema.
+func (s *Protocol6Server) ResourceType(ctx context.Context, typeName string) (tftypes.Type, error) {
+	resp, err := s.providerSchema(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schema, ok := resp.ResourceSchemas[typeName]
+
+	if !ok || schema == nil {
+		return nil, fmt.Errorf("resource type %q not found", typeName)
+	}
+
+	return schema.ValueType(), nil
+}
+
+// providerSchema returns the GetProviderSchema RPC response or an error if
+// the response contains error diagnostics.
+func (s *Protocol6Server) providerSchema(ctx context.Context) (*tfprotov6.GetProviderSchemaResponse, error) {
+	resp, err := s.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := DiagnosticsError(resp.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// NewDynamicValue returns a DynamicValue for the given value, which can be
+// used in RPC requests.
+func NewDynamicValue(value tftypes.Value) (*tfprotov6.DynamicValue, error) {
+	dynamicValue, err := tfprotov6.NewDynamicValue(value.Type(), value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &dynamicValue, nil
+}
+
+// DynamicValueToValue returns the value of the given DynamicValue, such as
+// from an RPC response, using the given type. A nil DynamicValue returns a
+// null value.
+func DynamicValueToValue(dynamicValue *tfprotov6.DynamicValue, typ tftypes.Type) (tftypes.Value, error) {
+	if dynamicValue == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	return dynamicValue.Unmarshal(typ)
+}
+
+// DiagnosticsError returns an error containing all error diagnostics, such as
+// from an RPC response, or nil if there are no error diagnostics.
+func DiagnosticsError(diagnostics []*tfprotov6.Diagnostic) error {
+	var errs []error
+
+	for _, diagnostic := range diagnostics {
+		if diagnostic == nil || diagnostic.Severity != tfprotov6.DiagnosticSeverityError {
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %s", diagnostic.Summary, diagnostic.Detail))
+	}
+
+	return errors.Join(errs...)
+}
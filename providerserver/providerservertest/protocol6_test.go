@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerservertest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver/providerservertest"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProtocol6Server_ReadResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	server := providerservertest.NewProtocol6Server(&testprovider.Provider{
+		MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+			resp.TypeName = "test"
+		},
+		ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+			return []func() resource.Resource{
+				func() resource.Resource {
+					return &testprovider.Resource{
+						MetadataMethod: func(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+							resp.TypeName = req.ProviderTypeName + "_resource"
+						},
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = schema.Schema{
+								Attributes: map[string]schema.Attribute{
+									"test_attribute": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							}
+						},
+						ReadMethod: func(ctx context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_attribute"), types.StringValue("new-value"))...)
+						},
+					}
+				},
+			}
+		},
+	})
+
+	resourceType, err := server.ResourceType(ctx, "test_resource")
+
+	if err != nil {
+		t.Fatalf("unexpected error getting resource type: %s", err)
+	}
+
+	currentState, err := providerservertest.NewDynamicValue(tftypes.NewValue(resourceType, map[string]tftypes.Value{
+		"test_attribute": tftypes.NewValue(tftypes.String, "old-value"),
+	}))
+
+	if err != nil {
+		t.Fatalf("unexpected error creating current state: %s", err)
+	}
+
+	resp, err := server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		CurrentState: currentState,
+		TypeName:     "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ReadResource: %s", err)
+	}
+
+	if err := providerservertest.DiagnosticsError(resp.Diagnostics); err != nil {
+		t.Fatalf("unexpected diagnostics: %s", err)
+	}
+
+	got, err := providerservertest.DynamicValueToValue(resp.NewState, resourceType)
+
+	if err != nil {
+		t.Fatalf("unexpected error reading new state: %s", err)
+	}
+
+	expected := tftypes.NewValue(resourceType, map[string]tftypes.Value{
+		"test_attribute": tftypes.NewValue(tftypes.String, "new-value"),
+	})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestProtocol6Server_ResourceType_not_found(t *testing.T) {
+	t.Parallel()
+
+	server := providerservertest.NewProtocol6Server(&testprovider.Provider{})
+
+	_, err := server.ResourceType(context.Background(), "test_resource")
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if diff := cmp.Diff(err.Error(), `resource type "test_resource" not found`); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestDiagnosticsError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diagnostics []*tfprotov6.Diagnostic
+		expected    string
+	}{
+		"nil": {},
+		"warning": {
+			diagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "warning summary",
+					Detail:   "warning detail",
+				},
+			},
+		},
+		"errors": {
+			diagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "error summary 1",
+					Detail:   "error detail 1",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "warning summary",
+					Detail:   "warning detail",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "error summary 2",
+					Detail:   "error detail 2",
+				},
+			},
+			expected: "error summary 1: error detail 1\nerror summary 2: error detail 2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+
+			if err := providerservertest.DiagnosticsError(testCase.diagnostics); err != nil {
+				got = err.Error()
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
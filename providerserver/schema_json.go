@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// schemaJSONFormatVersion is the format_version of the output of the
+// terraform providers schema -json command which WriteSchemaJSON implements.
+const schemaJSONFormatVersion = "1.0"
+
+// WriteSchemaJSON writes the schemas of the provider and all of its data
+// sources, functions, and resources to w, in the same format as the output of
+// the terraform providers schema -json command. The address is the full
+// provider address, such as registry.terraform.io/example-namespace/example,
+// which keys the provider in the output. The protocolVersion is the protocol
+// version the provider is served with, either 5 or 6, where 0 defaults to 6
+// like the ServeOpts ProtocolVersion field. Protocol version 5 providers
+// return an error for schemas using functionality which is only available in
+// protocol version 6, such as nested attributes. This enables documentation
+// generation and other tooling without requiring Terraform to install and run
+// the provider.
+//
+// The description_kind of every block and attribute is included, defaulting
+// to plain, like Terraform. An error describing every error diagnostic is
+// returned if the schemas could
+// not be built. Serve calls WriteSchemaJSON instead of serving the provider
+// when the ServeOpts SchemaJSONOutput field is set.
+func WriteSchemaJSON(ctx context.Context, providerFunc func() provider.Provider, address string, protocolVersion int, w io.Writer) error {
+	switch protocolVersion {
+	case 5:
+		// Protocol version 5 schemas are a subset of protocol version 6
+		// schemas, so the output is built from the protocol version 6 schemas
+		// once they are valid for protocol version 5.
+		if err := Validate(ctx, providerFunc, protocolVersion); err != nil {
+			return fmt.Errorf("unable to get provider schema: %w", err)
+		}
+	case 0, 6:
+	default:
+		return fmt.Errorf("ProtocolVersion, if set, must be 5 or 6")
+	}
+
+	resp, err := NewProtocol6(providerFunc())().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+
+	if err != nil {
+		return fmt.Errorf("unable to get provider schema: %w", err)
+	}
+
+	var problems []string
+
+	for _, d := range resp.Diagnostics {
+		if d.Severity != tfprotov6.DiagnosticSeverityError {
+			continue
+		}
+
+		problems = append(problems, validateProblem(d.Summary, d.Detail))
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("unable to get provider schema, found %d problem(s):\n\n%s", len(problems), strings.Join(problems, "\n\n"))
+	}
+
+	providerJSON := schemaJSONProvider{
+		Provider:          schemaJSONFromSchema(resp.Provider),
+		ResourceSchemas:   make(map[string]*schemaJSONSchema, len(resp.ResourceSchemas)),
+		DataSourceSchemas: make(map[string]*schemaJSONSchema, len(resp.DataSourceSchemas)),
+		Functions:         make(map[string]*schemaJSONFunction, len(resp.Functions)),
+	}
+
+	for typeName, schema := range resp.ResourceSchemas {
+		providerJSON.ResourceSchemas[typeName] = schemaJSONFromSchema(schema)
+	}
+
+	for typeName, schema := range resp.DataSourceSchemas {
+		providerJSON.DataSourceSchemas[typeName] = schemaJSONFromSchema(schema)
+	}
+
+	for name, function := range resp.Functions {
+		providerJSON.Functions[name] = schemaJSONFromFunction(function)
+	}
+
+	output := schemaJSONOutput{
+		FormatVersion: schemaJSONFormatVersion,
+		ProviderSchemas: map[string]*schemaJSONProvider{
+			address: &providerJSON,
+		},
+	}
+
+	err = json.NewEncoder(w).Encode(output)
+
+	if err != nil {
+		return fmt.Errorf("unable to write provider schema JSON: %w", err)
+	}
+
+	return nil
+}
+
+// The following types mirror the JSON format of the terraform providers
+// schema -json command.
+
+type schemaJSONOutput struct {
+	FormatVersion   string                         `json:"format_version"`
+	ProviderSchemas map[string]*schemaJSONProvider `json:"provider_schemas,omitempty"`
+}
+
+type schemaJSONProvider struct {
+	Provider          *schemaJSONSchema              `json:"provider,omitempty"`
+	ResourceSchemas   map[string]*schemaJSONSchema   `json:"resource_schemas,omitempty"`
+	DataSourceSchemas map[string]*schemaJSONSchema   `json:"data_source_schemas,omitempty"`
+	Functions         map[string]*schemaJSONFunction `json:"functions,omitempty"`
+}
+
+type schemaJSONSchema struct {
+	Version int64            `json:"version"`
+	Block   *schemaJSONBlock `json:"block,omitempty"`
+}
+
+type schemaJSONBlock struct {
+	Attributes      map[string]*schemaJSONAttribute `json:"attributes,omitempty"`
+	BlockTypes      map[string]*schemaJSONBlockType `json:"block_types,omitempty"`
+	Description     string                          `json:"description,omitempty"`
+	DescriptionKind string                          `json:"description_kind"`
+	Deprecated      bool                            `json:"deprecated,omitempty"`
+}
+
+type schemaJSONAttribute struct {
+	AttributeType       tftypes.Type          `json:"type,omitempty"`
+	AttributeNestedType *schemaJSONNestedType `json:"nested_type,omitempty"`
+	Description         string                `json:"description,omitempty"`
+	DescriptionKind     string                `json:"description_kind"`
+	Deprecated          bool                  `json:"deprecated,omitempty"`
+	Required            bool                  `json:"required,omitempty"`
+	Optional            bool                  `json:"optional,omitempty"`
+	Computed            bool                  `json:"computed,omitempty"`
+	Sensitive           bool                  `json:"sensitive,omitempty"`
+}
+
+type schemaJSONNestedType struct {
+	Attributes  map[string]*schemaJSONAttribute `json:"attributes,omitempty"`
+	NestingMode string                          `json:"nesting_mode,omitempty"`
+}
+
+type schemaJSONBlockType struct {
+	NestingMode string           `json:"nesting_mode,omitempty"`
+	Block       *schemaJSONBlock `json:"block,omitempty"`
+	MinItems    int64            `json:"min_items,omitempty"`
+	MaxItems    int64            `json:"max_items,omitempty"`
+}
+
+type schemaJSONFunction struct {
+	Description        string                         `json:"description,omitempty"`
+	Summary            string                         `json:"summary,omitempty"`
+	DeprecationMessage string                         `json:"deprecation_message,omitempty"`
+	ReturnType         tftypes.Type                   `json:"return_type"`
+	Parameters         []*schemaJSONFunctionParameter `json:"parameters,omitempty"`
+	VariadicParameter  *schemaJSONFunctionParameter   `json:"variadic_parameter,omitempty"`
+}
+
+type schemaJSONFunctionParameter struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	IsNullable  bool         `json:"is_nullable,omitempty"`
+	Type        tftypes.Type `json:"type"`
+}
+
+func schemaJSONFromSchema(schema *tfprotov6.Schema) *schemaJSONSchema {
+	if schema == nil {
+		return nil
+	}
+
+	return &schemaJSONSchema{
+		Version: schema.Version,
+		Block:   schemaJSONFromBlock(schema.Block),
+	}
+}
+
+func schemaJSONFromBlock(block *tfprotov6.SchemaBlock) *schemaJSONBlock {
+	if block == nil {
+		return &schemaJSONBlock{
+			DescriptionKind: schemaJSONStringKind(tfprotov6.StringKindPlain),
+		}
+	}
+
+	result := &schemaJSONBlock{
+		Deprecated:      block.Deprecated,
+		Description:     block.Description,
+		DescriptionKind: schemaJSONStringKind(block.DescriptionKind),
+	}
+
+	if len(block.Attributes) > 0 {
+		result.Attributes = schemaJSONFromAttributes(block.Attributes)
+	}
+
+	if len(block.BlockTypes) > 0 {
+		result.BlockTypes = make(map[string]*schemaJSONBlockType, len(block.BlockTypes))
+
+		for _, blockType := range block.BlockTypes {
+			result.BlockTypes[blockType.TypeName] = &schemaJSONBlockType{
+				NestingMode: schemaJSONBlockNestingMode(blockType.Nesting),
+				Block:       schemaJSONFromBlock(blockType.Block),
+				MinItems:    blockType.MinItems,
+				MaxItems:    blockType.MaxItems,
+			}
+		}
+	}
+
+	return result
+}
+
+func schemaJSONFromAttributes(attributes []*tfprotov6.SchemaAttribute) map[string]*schemaJSONAttribute {
+	result := make(map[string]*schemaJSONAttribute, len(attributes))
+
+	for _, attribute := range attributes {
+		attributeJSON := &schemaJSONAttribute{
+			AttributeType:   attribute.Type,
+			Deprecated:      attribute.Deprecated,
+			Description:     attribute.Description,
+			DescriptionKind: schemaJSONStringKind(attribute.DescriptionKind),
+			Required:        attribute.Required,
+			Optional:        attribute.Optional,
+			Computed:        attribute.Computed,
+			Sensitive:       attribute.Sensitive,
+		}
+
+		if attribute.NestedType != nil {
+			attributeJSON.AttributeNestedType = &schemaJSONNestedType{
+				Attributes:  schemaJSONFromAttributes(attribute.NestedType.Attributes),
+				NestingMode: schemaJSONObjectNestingMode(attribute.NestedType.Nesting),
+			}
+		}
+
+		result[attribute.Name] = attributeJSON
+	}
+
+	return result
+}
+
+func schemaJSONFromFunction(function *tfprotov6.Function) *schemaJSONFunction {
+	result := &schemaJSONFunction{
+		Description:        function.Description,
+		Summary:            function.Summary,
+		DeprecationMessage: function.DeprecationMessage,
+	}
+
+	if function.Return != nil {
+		result.ReturnType = function.Return.Type
+	}
+
+	for _, parameter := range function.Parameters {
+		result.Parameters = append(result.Parameters, schemaJSONFromFunctionParameter(parameter))
+	}
+
+	if function.VariadicParameter != nil {
+		result.VariadicParameter = schemaJSONFromFunctionParameter(function.VariadicParameter)
+	}
+
+	return result
+}
+
+func schemaJSONFromFunctionParameter(parameter *tfprotov6.FunctionParameter) *schemaJSONFunctionParameter {
+	return &schemaJSONFunctionParameter{
+		Name:        parameter.Name,
+		Description: parameter.Description,
+		IsNullable:  parameter.AllowNullValue,
+		Type:        parameter.Type,
+	}
+}
+
+func schemaJSONStringKind(kind tfprotov6.StringKind) string {
+	switch kind {
+	case tfprotov6.StringKindMarkdown:
+		return "markdown"
+	default:
+		return "plain"
+	}
+}
+
+func schemaJSONBlockNestingMode(mode tfprotov6.SchemaNestedBlockNestingMode) string {
+	switch mode {
+	case tfprotov6.SchemaNestedBlockNestingModeSingle:
+		return "single"
+	case tfprotov6.SchemaNestedBlockNestingModeList:
+		return "list"
+	case tfprotov6.SchemaNestedBlockNestingModeSet:
+		return "set"
+	case tfprotov6.SchemaNestedBlockNestingModeMap:
+		return "map"
+	case tfprotov6.SchemaNestedBlockNestingModeGroup:
+		return "group"
+	default:
+		return ""
+	}
+}
+
+func schemaJSONObjectNestingMode(mode tfprotov6.SchemaObjectNestingMode) string {
+	switch mode {
+	case tfprotov6.SchemaObjectNestingModeSingle:
+		return "single"
+	case tfprotov6.SchemaObjectNestingModeList:
+		return "list"
+	case tfprotov6.SchemaObjectNestingModeSet:
+		return "set"
+	case tfprotov6.SchemaObjectNestingModeMap:
+		return "map"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerserver
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestWriteSchemaJSON(t *testing.T) {
+	t.Parallel()
+
+	providerFunc := func() provider.Provider {
+		return &testprovider.ProviderWithFunctions{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = resourceschema.Schema{
+										Description: "Test resource.",
+										Version:     1,
+										Attributes: map[string]resourceschema.Attribute{
+											"id": resourceschema.StringAttribute{
+												Computed: true,
+											},
+											"nested": resourceschema.ListNestedAttribute{
+												NestedObject: resourceschema.NestedAttributeObject{
+													Attributes: map[string]resourceschema.Attribute{
+														"secret": resourceschema.StringAttribute{
+															MarkdownDescription: "The `secret`.",
+															Required:            true,
+															Sensitive:           true,
+														},
+													},
+												},
+												Optional: true,
+											},
+										},
+										Blocks: map[string]resourceschema.Block{
+											"block": resourceschema.ListNestedBlock{
+												NestedObject: resourceschema.NestedBlockObject{
+													Attributes: map[string]resourceschema.Attribute{
+														"count": resourceschema.Int64Attribute{
+															Optional: true,
+														},
+													},
+												},
+											},
+										},
+									}
+								},
+							}
+						},
+					}
+				},
+			},
+			FunctionsMethod: func(_ context.Context) []func() function.Function {
+				return []func() function.Function{
+					func() function.Function {
+						return &testprovider.Function{
+							MetadataMethod: func(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+								resp.Name = "test_function"
+							},
+							DefinitionMethod: func(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+								resp.Definition = function.Definition{
+									Summary: "Test function.",
+									Parameters: []function.Parameter{
+										function.StringParameter{
+											AllowNullValue: true,
+											Name:           "input",
+										},
+									},
+									Return: function.BoolReturn{},
+								}
+							},
+						}
+					},
+				}
+			},
+		}
+	}
+
+	var buf bytes.Buffer
+
+	err := WriteSchemaJSON(context.Background(), providerFunc, "registry.terraform.io/hashicorp/test", 6, &buf)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"format_version":"1.0","provider_schemas":{"registry.terraform.io/hashicorp/test":{` +
+		`"provider":{"version":0,"block":{"description_kind":"plain"}},` +
+		`"resource_schemas":{"test_resource":{"version":1,"block":{` +
+		`"attributes":{"id":{"type":"string","description_kind":"plain","computed":true},` +
+		`"nested":{"nested_type":{"attributes":{"secret":{"type":"string","description":"The ` + "`secret`" + `.","description_kind":"markdown","required":true,"sensitive":true}},"nesting_mode":"list"},"description_kind":"plain","optional":true}},` +
+		`"block_types":{"block":{"nesting_mode":"list","block":{"attributes":{"count":{"type":"number","description_kind":"plain","optional":true}},"description_kind":"plain"}}},` +
+		`"description":"Test resource.","description_kind":"plain"}}},` +
+		`"functions":{"test_function":{"summary":"Test function.","return_type":"bool","parameters":[{"name":"input","is_nullable":true,"type":"string"}]}}` +
+		"}}}\n"
+
+	if diff := cmp.Diff(buf.String(), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestWriteSchemaJSON_error(t *testing.T) {
+	t.Parallel()
+
+	providerFunc := func() provider.Provider {
+		return &testprovider.Provider{
+			SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+				resp.Diagnostics.AddError("Test Error", "Test detail.")
+			},
+		}
+	}
+
+	var buf bytes.Buffer
+
+	err := WriteSchemaJSON(context.Background(), providerFunc, "registry.terraform.io/hashicorp/test", 6, &buf)
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if !strings.Contains(err.Error(), "Test Error: Test detail.") {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if buf.Len() != 0 {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
+func TestWriteSchemaJSON_protocol5(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute     resourceschema.Attribute
+		expected      string
+		expectedError string
+	}{
+		"valid": {
+			attribute: resourceschema.StringAttribute{
+				Required: true,
+			},
+			expected: `{"format_version":"1.0","provider_schemas":{"registry.terraform.io/hashicorp/test":{` +
+				`"provider":{"version":0,"block":{"description_kind":"plain"}},` +
+				`"resource_schemas":{"test_resource":{"version":0,"block":{` +
+				`"attributes":{"test":{"type":"string","description_kind":"plain","required":true}},"description_kind":"plain"}}}` +
+				"}}}\n",
+		},
+		"nested-attribute": {
+			attribute: resourceschema.SingleNestedAttribute{
+				Attributes: map[string]resourceschema.Attribute{
+					"nested": resourceschema.StringAttribute{
+						Required: true,
+					},
+				},
+				Required: true,
+			},
+			expectedError: "Protocol Version 5 Unsupported Nested Attribute",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			providerFunc := func() provider.Provider {
+				return &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.Resource{
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource"
+									},
+									SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+										resp.Schema = resourceschema.Schema{
+											Attributes: map[string]resourceschema.Attribute{
+												"test": testCase.attribute,
+											},
+										}
+									},
+								}
+							},
+						}
+					},
+				}
+			}
+
+			var buf bytes.Buffer
+
+			err := WriteSchemaJSON(context.Background(), providerFunc, "registry.terraform.io/hashicorp/test", 5, &buf)
+
+			if testCase.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Errorf("expected error containing %q, got: %v", testCase.expectedError, err)
+				}
+
+				if buf.Len() != 0 {
+					t.Errorf("unexpected output: %s", buf.String())
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(buf.String(), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServe_SchemaJSONOutput(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	err := Serve(
+		context.Background(),
+		func() provider.Provider {
+			return &testprovider.Provider{}
+		},
+		ServeOpts{
+			Address:          "registry.terraform.io/hashicorp/test",
+			SchemaJSONOutput: &buf,
+		},
+	)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"format_version":"1.0","provider_schemas":{"registry.terraform.io/hashicorp/test":{"provider":{"version":0,"block":{"description_kind":"plain"}}}}}` + "\n"
+
+	if diff := cmp.Diff(buf.String(), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -6,7 +6,15 @@ package providerserver
 import (
 	"context"
 	"fmt"
+	"io"
+	"slices"
 	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
 )
 
 // ServeOpts are options for serving the provider.
@@ -34,6 +42,100 @@ type ServeOpts struct {
 	//     - tfsdk.Attribute cannot use Attributes field (nested attributes).
 	//
 	ProtocolVersion int
+
+	// RPCStartHook, if set, is called when the provider server starts
+	// handling each protocol RPC, such as "PlanResourceChange". This can be
+	// used to emit provider-defined metrics or tracing around framework
+	// handling of the RPC.
+	RPCStartHook func(ctx context.Context, rpc string)
+
+	// RPCFinishHook, if set, is called when the provider server finishes
+	// handling each protocol RPC, such as "PlanResourceChange", with the
+	// duration of handling and the response diagnostics. This can be used to
+	// emit provider-defined metrics or tracing around framework handling of
+	// the RPC. MetricsRecorder provides the same information for recorders
+	// which only need the RPC name, duration, and diagnostic counts.
+	RPCFinishHook func(ctx context.Context, rpc string, duration time.Duration, diags diag.Diagnostics)
+
+	// MetricsRecorder, if set, records the name, duration, and number of
+	// error and warning diagnostics of each protocol RPC handled by the
+	// provider server. Use metrics.NewCounters for an implementation which
+	// can be published with expvar or scraped by Prometheus.
+	MetricsRecorder metrics.Recorder
+
+	// TracerProvider, if set, enables OpenTelemetry tracing spans around the
+	// handling of each protocol RPC and around calls into provider defined
+	// logic, such as resource Create, Read, Update, and Delete methods,
+	// validators, and plan modifiers. Spans include the resource type, data
+	// source type, function name, or attribute path as attributes, where
+	// applicable. No spans are created if unset.
+	TracerProvider trace.TracerProvider
+
+	// AttributeAccessLogging, if true, enables DEBUG level framework logging
+	// of every provider read and write of configuration, plan, and state
+	// attribute values, such as GetAttribute and SetAttribute calls. Logs
+	// include the attribute path, value type, and value. Values of sensitive
+	// attributes, attributes nested under sensitive attributes, and objects or
+	// collections containing sensitive attributes are redacted. This is
+	// intended for troubleshooting provider development and should not be
+	// enabled in released providers.
+	AttributeAccessLogging bool
+
+	// DecodedModelCaching, if true, enables caching of the Go models decoded
+	// by configuration, plan, and state Get methods for the duration of each
+	// protocol RPC. When the same data is decoded into the same Go type more
+	// than once, such as in multiple validators or plan modifiers which each
+	// call Get on a large configuration, a copy of the previously decoded
+	// model is returned instead of repeating reflection decoding.
+	DecodedModelCaching bool
+
+	// ReadDriftLogging, if true, enables DEBUG level framework logging of
+	// every attribute value which differs between the prior state and the
+	// refreshed state after a resource Read, which can help troubleshoot
+	// perpetual differences in plans. Logs include the attribute path, prior
+	// value, and refreshed value. Values of sensitive attributes, or
+	// attributes nested under sensitive attributes, are redacted.
+	ReadDriftLogging bool
+
+	// ReportAllModelMismatches, if true, causes configuration, plan, and
+	// state Get methods to report every mismatch between schema attributes
+	// and target struct fields, including nested structs, at once. By
+	// default, only the first mismatch is reported.
+	ReportAllModelMismatches bool
+
+	// RPCConcurrencyLimits, if set, limits the number of concurrent
+	// executions of each protocol RPC by name, such as "PlanResourceChange"
+	// or "ApplyResourceChange", to the given value. Additional requests wait
+	// until an execution finishes. This can be used by providers which
+	// interact with APIs that have strict concurrency limits. RPCs not
+	// included, or with a limit of 0 or less, are not limited. Names which
+	// are not RPCs of the ProtocolVersion cause Serve to return an error.
+	// RPCs cancelled while waiting respond with an error diagnostic.
+	RPCConcurrencyLimits map[string]int
+
+	// ValidateOnly, if true, causes Serve to validate the provider with the
+	// Validate function and return its result instead of serving the
+	// provider. Provider binaries can set this from a command line flag, such
+	// as -validate, so CI can catch invalid schema definitions without
+	// running Terraform.
+	ValidateOnly bool
+
+	// SchemaJSONOutput, if set, causes Serve to write the provider schemas
+	// with the WriteSchemaJSON function to the given writer, such as
+	// os.Stdout, instead of serving the provider. The output matches the
+	// terraform providers schema -json command, so documentation pipelines
+	// can read schemas directly from the provider binary.
+	SchemaJSONOutput io.Writer
+
+	// MaxMessageSize, if greater than 0, is the size in bytes above which
+	// encoded state and plan data returns an error diagnostic with the
+	// largest attributes, rather than an opaque transport error, instead of
+	// the default of 64MB, which is the maximum message size Terraform
+	// receives from providers. It only changes the diagnostic threshold and
+	// cannot raise the gRPC message size limits of the provider server or
+	// Terraform. Only the encoded state and plan data is checked, not the
+	// whole response.
+	MaxMessageSize int
 }
 
 // Validate a given provider address. This is only used for the Address field
@@ -65,6 +167,9 @@ func (opts ServeOpts) validateAddress(_ context.Context) error {
 //   - If Address is not set
 //   - Address is a valid full provider address
 //   - ProtocolVersion, if set, is 5 or 6
+//   - RPCConcurrencyLimits, if set, only contains RPC names of the protocol
+//     version
+//   - MaxMessageSize, if set, is not negative
 func (opts ServeOpts) validate(ctx context.Context) error {
 	if opts.Address == "" {
 		return fmt.Errorf("Address must be provided")
@@ -83,5 +188,74 @@ func (opts ServeOpts) validate(ctx context.Context) error {
 		return fmt.Errorf("ProtocolVersion, if set, must be 5 or 6")
 	}
 
+	if err := opts.validateRPCConcurrencyLimits(ctx); err != nil {
+		return err
+	}
+
+	if opts.MaxMessageSize < 0 {
+		return fmt.Errorf("MaxMessageSize, if set, must be greater than 0")
+	}
+
+	return nil
+}
+
+// protocol5RPCNames are the names of the protocol version 5 RPCs which can be
+// used in RPCConcurrencyLimits.
+var protocol5RPCNames = []string{
+	"ApplyResourceChange",
+	"CallFunction",
+	"ConfigureProvider",
+	"GetFunctions",
+	"GetMetadata",
+	"GetProviderSchema",
+	"ImportResourceState",
+	"MoveResourceState",
+	"PlanResourceChange",
+	"PrepareProviderConfig",
+	"ReadDataSource",
+	"ReadResource",
+	"UpgradeResourceState",
+	"ValidateDataSourceConfig",
+	"ValidateResourceTypeConfig",
+}
+
+// protocol6RPCNames are the names of the protocol version 6 RPCs which can be
+// used in RPCConcurrencyLimits.
+var protocol6RPCNames = []string{
+	"ApplyResourceChange",
+	"CallFunction",
+	"ConfigureProvider",
+	"GetFunctions",
+	"GetMetadata",
+	"GetProviderSchema",
+	"ImportResourceState",
+	"MoveResourceState",
+	"PlanResourceChange",
+	"ReadDataSource",
+	"ReadResource",
+	"UpgradeResourceState",
+	"ValidateDataResourceConfig",
+	"ValidateProviderConfig",
+	"ValidateResourceConfig",
+}
+
+// validateRPCConcurrencyLimits returns an error if RPCConcurrencyLimits
+// contains a name which is not an RPC of the protocol version, such as a
+// misspelling, which would otherwise silently not be limited.
+func (opts ServeOpts) validateRPCConcurrencyLimits(_ context.Context) error {
+	rpcNames := protocol6RPCNames
+	protocolVersion := 6
+
+	if opts.ProtocolVersion == 5 {
+		rpcNames = protocol5RPCNames
+		protocolVersion = 5
+	}
+
+	for _, name := range sortedKeys(opts.RPCConcurrencyLimits) {
+		if !slices.Contains(rpcNames, name) {
+			return fmt.Errorf("RPCConcurrencyLimits contains unknown protocol version %d RPC name %q, expected one of: %s", protocolVersion, name, strings.Join(rpcNames, ", "))
+		}
+	}
+
 	return nil
 }
@@ -57,6 +57,58 @@ func TestServeOptsValidate(t *testing.T) {
 				ProtocolVersion: 6,
 			},
 		},
+		"RPCConcurrencyLimits": {
+			serveOpts: ServeOpts{
+				Address: "registry.terraform.io/hashicorp/testing",
+				RPCConcurrencyLimits: map[string]int{
+					"ApplyResourceChange":    2,
+					"ValidateResourceConfig": 4,
+				},
+			},
+		},
+		"RPCConcurrencyLimits-ProtocolVersion-5": {
+			serveOpts: ServeOpts{
+				Address:         "registry.terraform.io/hashicorp/testing",
+				ProtocolVersion: 5,
+				RPCConcurrencyLimits: map[string]int{
+					"ApplyResourceChange":        2,
+					"ValidateResourceTypeConfig": 4,
+				},
+			},
+		},
+		"RPCConcurrencyLimits-unknown": {
+			serveOpts: ServeOpts{
+				Address: "registry.terraform.io/hashicorp/testing",
+				RPCConcurrencyLimits: map[string]int{
+					"ApplyResourceChange": 2,
+					"PlanResourceChanges": 4,
+				},
+			},
+			expectedError: fmt.Errorf(`RPCConcurrencyLimits contains unknown protocol version 6 RPC name "PlanResourceChanges", expected one of: ` +
+				"ApplyResourceChange, CallFunction, ConfigureProvider, GetFunctions, GetMetadata, GetProviderSchema, ImportResourceState, " +
+				"MoveResourceState, PlanResourceChange, ReadDataSource, ReadResource, UpgradeResourceState, ValidateDataResourceConfig, " +
+				"ValidateProviderConfig, ValidateResourceConfig"),
+		},
+		"RPCConcurrencyLimits-ProtocolVersion-5-unknown": {
+			serveOpts: ServeOpts{
+				Address:         "registry.terraform.io/hashicorp/testing",
+				ProtocolVersion: 5,
+				RPCConcurrencyLimits: map[string]int{
+					"ValidateResourceConfig": 4,
+				},
+			},
+			expectedError: fmt.Errorf(`RPCConcurrencyLimits contains unknown protocol version 5 RPC name "ValidateResourceConfig", expected one of: ` +
+				"ApplyResourceChange, CallFunction, ConfigureProvider, GetFunctions, GetMetadata, GetProviderSchema, ImportResourceState, " +
+				"MoveResourceState, PlanResourceChange, PrepareProviderConfig, ReadDataSource, ReadResource, UpgradeResourceState, " +
+				"ValidateDataSourceConfig, ValidateResourceTypeConfig"),
+		},
+		"MaxMessageSize-negative": {
+			serveOpts: ServeOpts{
+				Address:        "registry.terraform.io/hashicorp/testing",
+				MaxMessageSize: -1,
+			},
+			expectedError: fmt.Errorf("MaxMessageSize, if set, must be greater than 0"),
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// Validate builds the schemas of the provider and all of its data sources,
+// functions, and resources, then runs the framework implementation validation
+// of those definitions, without serving the provider. The protocol version is
+// used to check for functionality which is unsupported by that version, such
+// as nested attributes in protocol version 5, and defaults to protocol
+// version 6 if 0. For protocol version 5, every unsupported use is reported,
+// as with CheckProtocol5.
+//
+// An error describing every error diagnostic is returned if there are any
+// problems. This enables provider CI to catch invalid definitions without
+// running Terraform. Serve calls Validate instead of serving the provider
+// when the ServeOpts ValidateOnly field is true.
+func Validate(ctx context.Context, providerFunc func() provider.Provider, protocolVersion int) error {
+	var problems []string
+
+	switch protocolVersion {
+	case 5:
+		// Report every protocol version 6 feature at once, rather than only
+		// the first schema conversion error.
+		problems = protocol5Problems(ctx, providerFunc)
+
+		if len(problems) > 0 {
+			break
+		}
+
+		resp, err := NewProtocol5(providerFunc())().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+		if err != nil {
+			return fmt.Errorf("unable to get provider schema: %w", err)
+		}
+
+		for _, d := range resp.Diagnostics {
+			if d.Severity != tfprotov5.DiagnosticSeverityError {
+				continue
+			}
+
+			problems = append(problems, validateProblem(d.Summary, d.Detail))
+		}
+	case 0, 6:
+		resp, err := NewProtocol6(providerFunc())().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+
+		if err != nil {
+			return fmt.Errorf("unable to get provider schema: %w", err)
+		}
+
+		for _, d := range resp.Diagnostics {
+			if d.Severity != tfprotov6.DiagnosticSeverityError {
+				continue
+			}
+
+			problems = append(problems, validateProblem(d.Summary, d.Detail))
+		}
+	default:
+		return fmt.Errorf("ProtocolVersion, if set, must be 5 or 6")
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("provider validation found %d problem(s):\n\n%s", len(problems), strings.Join(problems, "\n\n"))
+	}
+
+	return nil
+}
+
+func validateProblem(summary, detail string) string {
+	if detail == "" {
+		return summary
+	}
+
+	return summary + ": " + detail
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	invalidNameProvider := func() provider.Provider {
+		return &testprovider.Provider{
+			SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+				resp.Schema = schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"Invalid-Name": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				}
+			},
+		}
+	}
+
+	nestedAttributeProvider := func() provider.Provider {
+		return &testprovider.Provider{
+			SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+				resp.Schema = schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"nested": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"test": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+							Optional: true,
+						},
+					},
+				}
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		providerFunc    func() provider.Provider
+		protocolVersion int
+		expectedError   string
+	}{
+		"valid": {
+			providerFunc: func() provider.Provider {
+				return &testprovider.Provider{}
+			},
+		},
+		"valid-protocol-5": {
+			providerFunc: func() provider.Provider {
+				return &testprovider.Provider{}
+			},
+			protocolVersion: 5,
+		},
+		"invalid-attribute-name": {
+			providerFunc:  invalidNameProvider,
+			expectedError: "provider validation found 1 problem(s):\n\nInvalid Attribute/Block Name",
+		},
+		"invalid-attribute-name-protocol-5": {
+			providerFunc:    invalidNameProvider,
+			protocolVersion: 5,
+			expectedError:   "provider validation found 1 problem(s):\n\nInvalid Attribute/Block Name",
+		},
+		"nested-attribute-protocol-5": {
+			providerFunc:    nestedAttributeProvider,
+			protocolVersion: 5,
+			expectedError:   "provider validation found 1 problem(s):\n\nProtocol Version 5 Unsupported Nested Attribute: Provider attribute \"nested\"",
+		},
+		"nested-attribute-protocol-6": {
+			providerFunc:    nestedAttributeProvider,
+			protocolVersion: 6,
+		},
+		"invalid-protocol-version": {
+			providerFunc: func() provider.Provider {
+				return &testprovider.Provider{}
+			},
+			protocolVersion: 4,
+			expectedError:   "ProtocolVersion, if set, must be 5 or 6",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Validate(context.Background(), testCase.providerFunc, testCase.protocolVersion)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", testCase.expectedError)
+			}
+
+			if !strings.HasPrefix(err.Error(), testCase.expectedError) {
+				t.Errorf("expected error starting with %q, got: %s", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestServe_ValidateOnly(t *testing.T) {
+	t.Parallel()
+
+	err := Serve(
+		context.Background(),
+		func() provider.Provider {
+			return &testprovider.Provider{}
+		},
+		ServeOpts{
+			Address:      "registry.terraform.io/hashicorp/test",
+			ValidateOnly: true,
+		},
+	)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
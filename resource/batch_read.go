@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// DefaultBatchReadWindow is the duration the framework waits for further
+// ReadResource RPCs after the first RPC of a batch, when the BatchReadSettings
+// Window field is zero.
+const DefaultBatchReadWindow = 10 * time.Millisecond
+
+// BatchReadSettings controls how the framework aggregates ReadResource RPCs
+// for a resource implementing ResourceWithBatchRead.
+type BatchReadSettings struct {
+	// Window is the duration to wait for further ReadResource RPCs after the
+	// first RPC of a batch. Each RPC in the batch is delayed by up to this
+	// duration, so it should be small compared to the duration of Read.
+	// If zero, DefaultBatchReadWindow is used.
+	Window time.Duration
+
+	// MaxSize is the maximum number of requests in a batch, such as the
+	// maximum number of objects per remote system API call. A batch is read
+	// immediately when it reaches this size. If zero, batches are unlimited.
+	MaxSize int
+}
+
+// BatchReadRequest represents a request for the provider to read multiple
+// instances of a resource type. An instance of this request struct is
+// supplied as an argument to the resource's BatchRead function.
+type BatchReadRequest struct {
+	// Requests contains the ReadRequest of each aggregated ReadResource RPC,
+	// in order of arrival. Each is the same as the request which Read would
+	// receive.
+	Requests []ReadRequest
+}
+
+// BatchReadResponse represents a response to a BatchReadRequest. An instance
+// of this response struct is supplied as an argument to the resource's
+// BatchRead function, in which the provider should set values on each
+// ReadResponse as appropriate.
+type BatchReadResponse struct {
+	// Responses contains the ReadResponse for the ReadRequest at the same
+	// index of the BatchReadRequest Requests field. Each is pre-populated the
+	// same as the response which Read would receive and should be updated
+	// the same way, such as setting State or adding Diagnostics.
+	Responses []*ReadResponse
+
+	// Diagnostics report errors or warnings which relate to the whole batch,
+	// such as a failed remote system API call. These are appended to the
+	// Diagnostics of every response.
+	Diagnostics diag.Diagnostics
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/errorconverters"
+)
+
+// CreateErrorFunc is an error-returning variant of CreateFunc, for use with
+// CreateWithError.
+type CreateErrorFunc func(context.Context, CreateRequest, *CreateResponse) error
+
+// ReadErrorFunc is an error-returning variant of ReadFunc, for use with
+// ReadWithError.
+type ReadErrorFunc func(context.Context, ReadRequest, *ReadResponse) error
+
+// UpdateErrorFunc is an error-returning variant of UpdateFunc, for use with
+// UpdateWithError.
+type UpdateErrorFunc func(context.Context, UpdateRequest, *UpdateResponse) error
+
+// DeleteErrorFunc is an error-returning variant of DeleteFunc, for use with
+// DeleteWithError.
+type DeleteErrorFunc func(context.Context, DeleteRequest, *DeleteResponse) error
+
+// CreateWithError calls the given function and appends diagnostics for any
+// returned error to the response, using the error converters registered with
+// the provider.ProviderWithErrorConverters interface. Errors which are not
+// handled by any converter are added as a generic error diagnostic. Call it
+// from the Resource Create method:
+//
+//	func (r *ThingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+//		resource.CreateWithError(ctx, req, resp, r.create)
+//	}
+func CreateWithError(ctx context.Context, req CreateRequest, resp *CreateResponse, create CreateErrorFunc) {
+	err := create(ctx, req, resp)
+
+	resp.Diagnostics.Append(diag.ConvertError(ctx, err, "Error Creating Resource", errorconverters.Converters(ctx)...)...)
+}
+
+// ReadWithError calls the given function and appends diagnostics for any
+// returned error to the response, using the error converters registered with
+// the provider.ProviderWithErrorConverters interface. Errors which are not
+// handled by any converter are added as a generic error diagnostic.
+func ReadWithError(ctx context.Context, req ReadRequest, resp *ReadResponse, read ReadErrorFunc) {
+	err := read(ctx, req, resp)
+
+	resp.Diagnostics.Append(diag.ConvertError(ctx, err, "Error Reading Resource", errorconverters.Converters(ctx)...)...)
+}
+
+// UpdateWithError calls the given function and appends diagnostics for any
+// returned error to the response, using the error converters registered with
+// the provider.ProviderWithErrorConverters interface. Errors which are not
+// handled by any converter are added as a generic error diagnostic.
+func UpdateWithError(ctx context.Context, req UpdateRequest, resp *UpdateResponse, update UpdateErrorFunc) {
+	err := update(ctx, req, resp)
+
+	resp.Diagnostics.Append(diag.ConvertError(ctx, err, "Error Updating Resource", errorconverters.Converters(ctx)...)...)
+}
+
+// DeleteWithError calls the given function and appends diagnostics for any
+// returned error to the response, using the error converters registered with
+// the provider.ProviderWithErrorConverters interface. Errors which are not
+// handled by any converter are added as a generic error diagnostic.
+func DeleteWithError(ctx context.Context, req DeleteRequest, resp *DeleteResponse, deleteFunc DeleteErrorFunc) {
+	err := deleteFunc(ctx, req, resp)
+
+	resp.Diagnostics.Append(diag.ConvertError(ctx, err, "Error Deleting Resource", errorconverters.Converters(ctx)...)...)
+}
@@ -5,6 +5,8 @@ package resource
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
@@ -86,3 +88,67 @@ func ImportStatePassthroughID(ctx context.Context, attrPath path.Path, req Impor
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, req.ID)...)
 }
+
+// ImportStatePassthroughMultipartID is a helper function to split a composite
+// import identifier, such as "project/region/name", by the given separator
+// and set each part to the state attribute path at the same position. Each
+// attribute must accept a string value.
+//
+// An error diagnostic is returned if the import identifier does not contain
+// exactly one part for each attribute path or if any part is empty. The error
+// detail includes the expected import identifier format, based on the
+// attribute paths, e.g. "project/region/name".
+func ImportStatePassthroughMultipartID(ctx context.Context, separator string, attrPaths []path.Path, req ImportStateRequest, resp *ImportStateResponse) {
+	if separator == "" || len(attrPaths) == 0 {
+		resp.Diagnostics.AddError(
+			"Resource Import Passthrough Missing Separator or Attribute Paths",
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Resource ImportState method call to ImportStatePassthroughMultipartID separator and paths must be set.",
+		)
+
+		return
+	}
+
+	formatParts := make([]string, 0, len(attrPaths))
+
+	for _, attrPath := range attrPaths {
+		if attrPath.Equal(path.Empty()) {
+			resp.Diagnostics.AddError(
+				"Resource Import Passthrough Missing Attribute Path",
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"Resource ImportState method call to ImportStatePassthroughMultipartID paths must be set to valid attribute paths that can accept a string value.",
+			)
+
+			return
+		}
+
+		formatParts = append(formatParts, attrPath.String())
+	}
+
+	idParts := strings.Split(req.ID, separator)
+
+	if len(idParts) != len(attrPaths) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s. Got: %q", strings.Join(formatParts, separator), req.ID),
+		)
+
+		return
+	}
+
+	for idx, idPart := range idParts {
+		if idPart == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: %s. Got: %q\n\n", strings.Join(formatParts, separator), req.ID)+
+					fmt.Sprintf("The %s part of the import identifier must not be empty.", formatParts[idx]),
+			)
+
+			return
+		}
+	}
+
+	for idx, idPart := range idParts {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPaths[idx], idPart)...)
+	}
+}
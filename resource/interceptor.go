@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+)
+
+// CreateFunc is the signature of the Resource interface Create method.
+type CreateFunc func(context.Context, CreateRequest, *CreateResponse)
+
+// ReadFunc is the signature of the Resource interface Read method.
+type ReadFunc func(context.Context, ReadRequest, *ReadResponse)
+
+// UpdateFunc is the signature of the Resource interface Update method.
+type UpdateFunc func(context.Context, UpdateRequest, *UpdateResponse)
+
+// DeleteFunc is the signature of the Resource interface Delete method.
+type DeleteFunc func(context.Context, DeleteRequest, *DeleteResponse)
+
+// Interceptor wraps the Create, Read, Update, and Delete methods of every
+// resource in a provider, such as to implement logging, metrics, credential
+// refreshing, or serialization of operations. Interceptors are registered
+// with the provider.ProviderWithResourceInterceptors interface.
+//
+// Each field is optional. If a field is nil, the operation is passed through
+// to the next interceptor or the resource unchanged. Otherwise, the function
+// is responsible for calling next to continue the operation. Not calling next
+// skips the remaining interceptors and the resource method, in which case the
+// function should add diagnostics or set the response data itself.
+type Interceptor struct {
+	// Create wraps the resource Create method.
+	Create func(ctx context.Context, info InterceptorInfo, req CreateRequest, resp *CreateResponse, next CreateFunc)
+
+	// Read wraps the resource Read method.
+	Read func(ctx context.Context, info InterceptorInfo, req ReadRequest, resp *ReadResponse, next ReadFunc)
+
+	// Update wraps the resource Update method.
+	Update func(ctx context.Context, info InterceptorInfo, req UpdateRequest, resp *UpdateResponse, next UpdateFunc)
+
+	// Delete wraps the resource Delete method.
+	Delete func(ctx context.Context, info InterceptorInfo, req DeleteRequest, resp *DeleteResponse, next DeleteFunc)
+}
+
+// InterceptorInfo contains information about the intercepted resource
+// operation.
+type InterceptorInfo struct {
+	// TypeName is the type name of the resource, such as
+	// "examplecloud_thing".
+	TypeName string
+}
@@ -36,6 +36,10 @@ type ResourceBehavior struct {
 	// NOTE: This functionality is related to deferred action support, which is currently experimental and is subject
 	// to change or break without warning. It is not protected by version compatibility guarantees.
 	ProviderDeferred ProviderDeferredBehavior
+
+	// PlanNormalization controls framework-applied plan normalization logic,
+	// which runs before any schema-based or resource-level plan modification.
+	PlanNormalization PlanNormalizationBehavior
 }
 
 // ProviderDeferredBehavior enables provider-defined logic to be executed
@@ -50,3 +54,30 @@ type ProviderDeferredBehavior struct {
 	// deferred response along with the modified plan.
 	EnablePlanModification bool
 }
+
+// PlanNormalizationBehavior controls framework-applied plan normalization
+// logic for a resource.
+type PlanNormalizationBehavior struct {
+	// When DisableComputedUnknownMarking is true, framework will not
+	// automatically mark Computed attributes with null configuration values
+	// as unknown in the plan. Defaults are still applied.
+	//
+	// Resources which enable this must ensure, via schema-based or
+	// resource-level plan modification, that any Computed attribute value
+	// which may change during apply is marked as unknown in the plan,
+	// otherwise Terraform will raise errors about inconsistent results
+	// after apply.
+	DisableComputedUnknownMarking bool
+
+	// When EnableComputedNestedObjectUnknownChildren is true, framework will
+	// plan Computed single nested attributes with null configuration values
+	// as known objects with unknown attribute values when creating the
+	// resource, rather than as an unknown object. This enables resource-level
+	// plan modification to set individual attribute values and Terraform to
+	// render the object structure as known after apply.
+	//
+	// Resources which enable this must always set the object in the new state
+	// after apply, otherwise Terraform will raise errors about inconsistent
+	// results after apply.
+	EnableComputedNestedObjectUnknownChildren bool
+}
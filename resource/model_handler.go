@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+)
+
+// CreateModel reads the plan into a model of type M, calls the given function
+// with it, and saves the returned model as the new state. A returned error is
+// converted into diagnostics the same way as CreateWithError, and no state is
+// saved. Call it from the Resource Create method:
+//
+//	func (r *ThingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+//		resource.CreateModel(ctx, req, resp, r.create)
+//	}
+//
+//	func (r *ThingResource) create(ctx context.Context, plan ThingModel) (ThingModel, error) {
+//		// ... create the thing and set computed values ...
+//	}
+func CreateModel[M any](ctx context.Context, req CreateRequest, resp *CreateResponse, create func(ctx context.Context, plan M) (M, error)) {
+	var plan M
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	CreateWithError(ctx, req, resp, func(ctx context.Context, _ CreateRequest, resp *CreateResponse) error {
+		state, err := create(ctx, plan)
+
+		if err != nil {
+			return err
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+		return nil
+	})
+}
+
+// ReadModel reads the prior state into a model of type M, calls the given
+// function with it, and saves the returned model as the new state. If the
+// function returns a nil model, the resource is removed from state, such as
+// when it no longer exists. A returned error is converted into diagnostics
+// the same way as ReadWithError, and the prior state is kept.
+func ReadModel[M any](ctx context.Context, req ReadRequest, resp *ReadResponse, read func(ctx context.Context, state M) (*M, error)) {
+	var state M
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ReadWithError(ctx, req, resp, func(ctx context.Context, _ ReadRequest, resp *ReadResponse) error {
+		newState, err := read(ctx, state)
+
+		if err != nil {
+			return err
+		}
+
+		if newState == nil {
+			resp.State.RemoveResource(ctx)
+
+			return nil
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+
+		return nil
+	})
+}
+
+// UpdateModel reads the plan and prior state into models of type M, calls
+// the given function with them, and saves the returned model as the new
+// state. A returned error is converted into diagnostics the same way as
+// UpdateWithError, and the prior state is kept.
+func UpdateModel[M any](ctx context.Context, req UpdateRequest, resp *UpdateResponse, update func(ctx context.Context, plan M, state M) (M, error)) {
+	var plan, state M
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	UpdateWithError(ctx, req, resp, func(ctx context.Context, _ UpdateRequest, resp *UpdateResponse) error {
+		newState, err := update(ctx, plan, state)
+
+		if err != nil {
+			return err
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+
+		return nil
+	})
+}
+
+// DeleteModel reads the prior state into a model of type M and calls the
+// given function with it. The framework removes the resource from state if
+// no error is returned. A returned error is converted into diagnostics the
+// same way as DeleteWithError.
+func DeleteModel[M any](ctx context.Context, req DeleteRequest, resp *DeleteResponse, deleteFunc func(ctx context.Context, state M) error) {
+	var state M
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	DeleteWithError(ctx, req, resp, func(ctx context.Context, _ DeleteRequest, _ *DeleteResponse) error {
+		return deleteFunc(ctx, state)
+	})
+}
@@ -57,6 +57,21 @@ type ModifyPlanRequest struct {
 	ClientCapabilities ModifyPlanClientCapabilities
 }
 
+// IsCreate returns true if the request is planning to create the resource,
+// which is when the prior State is null.
+func (r ModifyPlanRequest) IsCreate() bool {
+	return r.State.Raw.IsNull()
+}
+
+// IsDestroy returns true if the request is planning to destroy the resource,
+// which is when the Plan is null. Terraform 1.3 and later supports resource
+// destroy planning, which the framework always enables, so ModifyPlan can
+// return warning diagnostics about destroying the resource, such as a
+// final snapshot not being taken. The Plan must remain null.
+func (r ModifyPlanRequest) IsDestroy() bool {
+	return r.Plan.Raw.IsNull()
+}
+
 // ModifyPlanResponse represents a response to a
 // ModifyPlanRequest. An instance of this response struct is supplied
 // as an argument to the resource's ModifyPlan function, in which the provider
@@ -19,6 +19,7 @@ import (
 //   - Plan Modification: Schema-based or entire plan
 //     via ResourceWithModifyPlan.
 //   - State Upgrades: ResourceWithUpgradeState
+//   - Batched Reads: ResourceWithBatchRead
 //
 // Although not required, it is conventional for resources to implement the
 // ResourceWithImportState interface.
@@ -54,6 +55,34 @@ type Resource interface {
 	Delete(context.Context, DeleteRequest, *DeleteResponse)
 }
 
+// ResourceWithBatchRead is an interface type that extends Resource to read
+// many instances of the resource type with one operation, such as a remote
+// system API which returns multiple objects per call.
+//
+// Terraform sends concurrent ReadResource RPCs when refreshing many resource
+// instances. The framework aggregates the RPCs for the resource type which
+// start within the BatchReadSettings Window of the first RPC and calls
+// BatchRead once with all of their requests instead of calling Read for each.
+// BatchRead is called on the resource instance of the first RPC, with the
+// context of the first RPC without its cancellation or deadline, as it serves
+// every RPC in the batch. Per-request cancellation and deadlines, such as
+// resource timeouts, only stop the waiting RPC, which then returns an error
+// diagnostic and discards the BatchRead result for its request. The
+// framework continues to handle each RPC separately before and after
+// BatchRead, such as applying resource interceptors, semantic equality, and
+// sensitive value transforms.
+type ResourceWithBatchRead interface {
+	Resource
+
+	// BatchReadSettings returns the settings for aggregating ReadResource
+	// RPCs. It is called for the first RPC of each batch.
+	BatchReadSettings(context.Context) BatchReadSettings
+
+	// BatchRead is called instead of Read to read the resource instances of
+	// the aggregated ReadResource RPCs.
+	BatchRead(context.Context, BatchReadRequest, *BatchReadResponse)
+}
+
 // ResourceWithConfigure is an interface type that extends Resource to
 // include a method which the framework will automatically call so provider
 // developers have the opportunity to setup any necessary provider-level data
@@ -182,6 +211,38 @@ type ResourceWithUpgradeState interface {
 	UpgradeState(context.Context) map[int64]StateUpgrader
 }
 
+// ResourceWithSensitiveValueTransform is an interface type that extends
+// Resource to transform sensitive attribute values before they are stored in
+// Terraform state and reverse the transformation before provider code
+// receives them, such as envelope encryption of secrets. Key material is
+// typically received from the provider configuration via the
+// ResourceWithConfigure interface Configure method ProviderData.
+//
+// The transformation only applies to Computed, non-Optional, Sensitive string
+// attributes, as Terraform requires configured values to be stored in state
+// unchanged. The framework decodes prior state and plan data before calling
+// the Read, Update, and Delete methods and encodes the new state after
+// calling the Create, Read, Update, Delete, and ImportState methods, so that
+// CRUD logic only uses decoded values. Validators, plan modifiers, the
+// ModifyPlan method, and state upgraders called by UpgradeResourceState
+// receive the stored, encoded values. ImportState has no prior state, so
+// nothing is decoded before calling it.
+//
+// When encoding, if the decoded prior state value is equal to the new value,
+// the encoded prior state value is kept, so non-deterministic encodings do
+// not cause differences between the plan and new state.
+type ResourceWithSensitiveValueTransform interface {
+	Resource
+
+	// EncodeSensitiveValue should transform the plaintext value into the
+	// value stored in Terraform state.
+	EncodeSensitiveValue(context.Context, SensitiveValueTransformRequest, *SensitiveValueTransformResponse)
+
+	// DecodeSensitiveValue should transform the value stored in Terraform
+	// state back into the plaintext value.
+	DecodeSensitiveValue(context.Context, SensitiveValueTransformRequest, *SensitiveValueTransformResponse)
+}
+
 // ResourceWithValidateConfig is an interface type that extends Resource to include imperative validation.
 //
 // Declaring validation using this methodology simplifies one-off
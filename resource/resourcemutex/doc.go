@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package resourcemutex provides a keyed mutex for serializing resource
+// operations which share a key, such as a parent object identifier, because
+// many remote APIs reject concurrent changes to the same parent object.
+package resourcemutex
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcemutex
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Interceptor returns a resource.Interceptor which serializes Create, Read,
+// Update, and Delete operations of all resources sharing the value at the
+// given attribute path, such as path.Root("cluster_id"). The key is read from
+// the plan for Create and Update operations, and from the prior state for
+// Read and Delete operations.
+//
+// Operations are not serialized for resources without an attribute at the
+// given path, or when the value is null or unknown. Operations cancelled while
+// waiting for the lock, such as by Terraform or a timeout, return an error
+// diagnostic without calling the resource method.
+func (m *Mutex) Interceptor(attributePath path.Path) resource.Interceptor {
+	return resource.Interceptor{
+		Create: func(ctx context.Context, _ resource.InterceptorInfo, req resource.CreateRequest, resp *resource.CreateResponse, next resource.CreateFunc) {
+			key, diags := keyAtPath(ctx, req.Plan.Schema, attributePath, req.Plan.GetAttribute)
+
+			resp.Diagnostics.Append(diags...)
+
+			if diags.HasError() {
+				return
+			}
+
+			if key != nil {
+				lockDiags := m.Lock(ctx, *key)
+
+				resp.Diagnostics.Append(lockDiags...)
+
+				if lockDiags.HasError() {
+					return
+				}
+
+				defer m.Unlock(ctx, *key)
+			}
+
+			next(ctx, req, resp)
+		},
+		Read: func(ctx context.Context, _ resource.InterceptorInfo, req resource.ReadRequest, resp *resource.ReadResponse, next resource.ReadFunc) {
+			key, diags := keyAtPath(ctx, req.State.Schema, attributePath, req.State.GetAttribute)
+
+			resp.Diagnostics.Append(diags...)
+
+			if diags.HasError() {
+				return
+			}
+
+			if key != nil {
+				lockDiags := m.Lock(ctx, *key)
+
+				resp.Diagnostics.Append(lockDiags...)
+
+				if lockDiags.HasError() {
+					return
+				}
+
+				defer m.Unlock(ctx, *key)
+			}
+
+			next(ctx, req, resp)
+		},
+		Update: func(ctx context.Context, _ resource.InterceptorInfo, req resource.UpdateRequest, resp *resource.UpdateResponse, next resource.UpdateFunc) {
+			key, diags := keyAtPath(ctx, req.Plan.Schema, attributePath, req.Plan.GetAttribute)
+
+			resp.Diagnostics.Append(diags...)
+
+			if diags.HasError() {
+				return
+			}
+
+			if key != nil {
+				lockDiags := m.Lock(ctx, *key)
+
+				resp.Diagnostics.Append(lockDiags...)
+
+				if lockDiags.HasError() {
+					return
+				}
+
+				defer m.Unlock(ctx, *key)
+			}
+
+			next(ctx, req, resp)
+		},
+		Delete: func(ctx context.Context, _ resource.InterceptorInfo, req resource.DeleteRequest, resp *resource.DeleteResponse, next resource.DeleteFunc) {
+			key, diags := keyAtPath(ctx, req.State.Schema, attributePath, req.State.GetAttribute)
+
+			resp.Diagnostics.Append(diags...)
+
+			if diags.HasError() {
+				return
+			}
+
+			if key != nil {
+				lockDiags := m.Lock(ctx, *key)
+
+				resp.Diagnostics.Append(lockDiags...)
+
+				if lockDiags.HasError() {
+					return
+				}
+
+				defer m.Unlock(ctx, *key)
+			}
+
+			next(ctx, req, resp)
+		},
+	}
+}
+
+// keyAtPath returns the lock key for the value at the given path, or nil if
+// the schema has no attribute at the path or the value is null or unknown.
+func keyAtPath(ctx context.Context, schema fwschema.Schema, attributePath path.Path, getAttribute func(context.Context, path.Path, interface{}) diag.Diagnostics) (*string, diag.Diagnostics) {
+	if schema == nil {
+		return nil, nil
+	}
+
+	if _, diags := schema.AttributeAtPath(ctx, attributePath); diags.HasError() {
+		return nil, nil
+	}
+
+	var value attr.Value
+
+	diags := getAttribute(ctx, attributePath, &value)
+
+	if diags.HasError() || value == nil || value.IsNull() || value.IsUnknown() {
+		return nil, diags
+	}
+
+	key := value.String()
+
+	return &key, diags
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcemutex_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/resourcemutex"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestMutexInterceptor(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testValue := func(clusterID interface{}) tftypes.Value {
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"cluster_id": tftypes.NewValue(tftypes.String, clusterID),
+			},
+		)
+	}
+
+	testCases := map[string]struct {
+		lockedKey     string
+		attributePath path.Path
+		plan          tftypes.Value
+		expectBlocked bool
+	}{
+		"same-key": {
+			lockedKey:     `"test-cluster"`,
+			attributePath: path.Root("cluster_id"),
+			plan:          testValue("test-cluster"),
+			expectBlocked: true,
+		},
+		"different-key": {
+			lockedKey:     `"other-cluster"`,
+			attributePath: path.Root("cluster_id"),
+			plan:          testValue("test-cluster"),
+			expectBlocked: false,
+		},
+		"null-value": {
+			lockedKey:     `"test-cluster"`,
+			attributePath: path.Root("cluster_id"),
+			plan:          testValue(nil),
+			expectBlocked: false,
+		},
+		"unknown-value": {
+			lockedKey:     `"test-cluster"`,
+			attributePath: path.Root("cluster_id"),
+			plan:          testValue(tftypes.UnknownValue),
+			expectBlocked: false,
+		},
+		"missing-attribute": {
+			lockedKey:     `"test-cluster"`,
+			attributePath: path.Root("parent_id"),
+			plan:          testValue("test-cluster"),
+			expectBlocked: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			var m resourcemutex.Mutex
+
+			m.Lock(ctx, testCase.lockedKey)
+
+			created := make(chan diag.Diagnostics)
+
+			go func() {
+				req := resource.CreateRequest{
+					Plan: tfsdk.Plan{
+						Raw:    testCase.plan,
+						Schema: testSchema,
+					},
+				}
+				resp := &resource.CreateResponse{}
+
+				m.Interceptor(testCase.attributePath).Create(ctx, resource.InterceptorInfo{}, req, resp, func(_ context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
+					resp.Diagnostics.AddWarning("resource", "create")
+				})
+
+				created <- resp.Diagnostics
+			}()
+
+			if testCase.expectBlocked {
+				select {
+				case <-created:
+					t.Fatal("expected create to be blocked")
+				case <-time.After(50 * time.Millisecond):
+				}
+			}
+
+			m.Unlock(ctx, testCase.lockedKey)
+
+			select {
+			case got := <-created:
+				expected := diag.Diagnostics{
+					diag.NewWarningDiagnostic("resource", "create"),
+				}
+
+				if diff := cmp.Diff(got, expected); diff != "" {
+					t.Errorf("unexpected difference: %s", diff)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("expected create to be unblocked")
+			}
+		})
+	}
+}
+
+func TestMutexInterceptor_cancelled(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	var m resourcemutex.Mutex
+
+	if diags := m.Lock(context.Background(), `"test-cluster"`); diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	defer m.Unlock(context.Background(), `"test-cluster"`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cancel()
+
+	req := resource.DeleteRequest{
+		State: tfsdk.State{
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"cluster_id": tftypes.NewValue(tftypes.String, "test-cluster"),
+				},
+			),
+			Schema: testSchema,
+		},
+	}
+	resp := &resource.DeleteResponse{}
+
+	m.Interceptor(path.Root("cluster_id")).Delete(ctx, resource.InterceptorInfo{}, req, resp, func(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+		resp.Diagnostics.AddError("resource", "should not be called")
+	})
+
+	expected := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Resource Operation Cancelled",
+			`The resource operation was cancelled while waiting for the lock of key "\"test-cluster\"", which is shared with operations of other resources. `+
+				"Terraform may have been cancelled or the operation may have exceeded its timeout.\n\n"+
+				"Error: context canceled",
+		),
+	}
+
+	if diff := cmp.Diff(resp.Diagnostics, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcemutex
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// Mutex is a set of mutual exclusion locks identified by a string key.
+// Operations holding the lock for a key are serialized, while operations for
+// different keys are not blocked by each other. The zero value is an unlocked
+// Mutex, ready to use. A Mutex must not be copied after first use.
+//
+// Share a single Mutex across all resources whose operations should be
+// serialized, such as in a package level variable.
+type Mutex struct {
+	// mu protects keys.
+	mu sync.Mutex
+
+	// keys contains the lock for each key currently in use.
+	keys map[string]*keyMutex
+}
+
+// keyMutex is the lock for a single key, which tracks the number of callers
+// holding or waiting on the lock, so it can be removed when unused. The lock
+// is held while the buffered channel contains a value, which allows waiting
+// on the lock to be cancelled.
+type keyMutex struct {
+	locked chan struct{}
+
+	refs int
+}
+
+// Lock locks the given key. If the key is already locked, Lock blocks until
+// it is unlocked or the context is cancelled. If the context is cancelled
+// first, an error diagnostic is returned and the key is not locked, so Unlock
+// must not be called.
+func (m *Mutex) Lock(ctx context.Context, key string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.mu.Lock()
+
+	if m.keys == nil {
+		m.keys = make(map[string]*keyMutex)
+	}
+
+	km, ok := m.keys[key]
+
+	if !ok {
+		km = &keyMutex{
+			locked: make(chan struct{}, 1),
+		}
+		m.keys[key] = km
+	}
+
+	km.refs++
+
+	m.mu.Unlock()
+
+	logging.FrameworkTrace(ctx, "Waiting for resource mutex lock", map[string]interface{}{logging.KeyResourceMutexKey: key})
+
+	select {
+	case km.locked <- struct{}{}:
+	case <-ctx.Done():
+		m.release(key, km)
+
+		logging.FrameworkTrace(ctx, "Cancelled waiting for resource mutex lock", map[string]interface{}{logging.KeyResourceMutexKey: key})
+
+		diags.AddError(
+			"Resource Operation Cancelled",
+			fmt.Sprintf("The resource operation was cancelled while waiting for the lock of key %q, which is shared with operations of other resources. ", key)+
+				"Terraform may have been cancelled or the operation may have exceeded its timeout.\n\n"+
+				"Error: "+ctx.Err().Error(),
+		)
+
+		return diags
+	}
+
+	logging.FrameworkTrace(ctx, "Obtained resource mutex lock", map[string]interface{}{logging.KeyResourceMutexKey: key})
+
+	return diags
+}
+
+// Unlock unlocks the given key. It is a run-time error if the key is not
+// locked on entry to Unlock.
+func (m *Mutex) Unlock(ctx context.Context, key string) {
+	m.mu.Lock()
+
+	km, ok := m.keys[key]
+
+	m.mu.Unlock()
+
+	if !ok {
+		panic("resourcemutex: unlock of unlocked key " + key)
+	}
+
+	select {
+	case <-km.locked:
+	default:
+		panic("resourcemutex: unlock of unlocked key " + key)
+	}
+
+	m.release(key, km)
+
+	logging.FrameworkTrace(ctx, "Released resource mutex lock", map[string]interface{}{logging.KeyResourceMutexKey: key})
+}
+
+// release removes a caller holding or waiting on the lock of the given key,
+// removing the lock when it is unused.
+func (m *Mutex) release(key string, km *keyMutex) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	km.refs--
+
+	if km.refs == 0 {
+		delete(m.keys, key)
+	}
+}
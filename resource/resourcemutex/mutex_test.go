@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcemutex_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/resourcemutex"
+)
+
+func TestMutexLock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var m resourcemutex.Mutex
+
+	if diags := m.Lock(ctx, "test-key"); diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	// A different key must not be blocked.
+	otherLocked := make(chan struct{})
+
+	go func() {
+		m.Lock(ctx, "other-key")
+		m.Unlock(ctx, "other-key")
+		close(otherLocked)
+	}()
+
+	select {
+	case <-otherLocked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected other key to not be blocked")
+	}
+
+	// The same key must be blocked until unlocked.
+	sameLocked := make(chan struct{})
+
+	go func() {
+		m.Lock(ctx, "test-key")
+		close(sameLocked)
+		m.Unlock(ctx, "test-key")
+	}()
+
+	select {
+	case <-sameLocked:
+		t.Fatal("expected same key to be blocked")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	m.Unlock(ctx, "test-key")
+
+	select {
+	case <-sameLocked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected same key to be unblocked")
+	}
+}
+
+func TestMutexLock_cancelled(t *testing.T) {
+	t.Parallel()
+
+	var m resourcemutex.Mutex
+
+	if diags := m.Lock(context.Background(), "test-key"); diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	locked := make(chan diag.Diagnostics)
+
+	go func() {
+		locked <- m.Lock(ctx, "test-key")
+	}()
+
+	cancel()
+
+	var diags diag.Diagnostics
+
+	select {
+	case diags = <-locked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected cancelled lock to return")
+	}
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Resource Operation Cancelled",
+			`The resource operation was cancelled while waiting for the lock of key "test-key", which is shared with operations of other resources. `+
+				"Terraform may have been cancelled or the operation may have exceeded its timeout.\n\n"+
+				"Error: context canceled",
+		),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	// The cancelled waiter must not hold the lock.
+	m.Unlock(context.Background(), "test-key")
+
+	if diags := m.Lock(context.Background(), "test-key"); diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	m.Unlock(context.Background(), "test-key")
+}
+
+func TestMutexUnlock_unlocked(t *testing.T) {
+	t.Parallel()
+
+	var m resourcemutex.Mutex
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+
+	m.Unlock(context.Background(), "test-key")
+}
@@ -20,11 +20,13 @@ import (
 
 // Ensure the implementation satisfies the desired interfaces.
 var (
-	_ Attribute                                    = BoolAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = BoolAttribute{}
-	_ fwschema.AttributeWithBoolDefaultValue       = BoolAttribute{}
-	_ fwxschema.AttributeWithBoolPlanModifiers     = BoolAttribute{}
-	_ fwxschema.AttributeWithBoolValidators        = BoolAttribute{}
+	_ Attribute                                      = BoolAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = BoolAttribute{}
+	_ fwschema.AttributeWithBoolDefaultValue         = BoolAttribute{}
+	_ fwxschema.AttributeWithBoolPlanModifiers       = BoolAttribute{}
+	_ fwxschema.AttributeWithBoolValidators          = BoolAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = BoolAttribute{}
+	_ fwschema.AttributeWithRenamedFrom              = BoolAttribute{}
 )
 
 // BoolAttribute represents a schema attribute that is a boolean. When
@@ -116,6 +118,9 @@ type BoolAttribute struct {
 	//
 	DeprecationMessage string
 
+	// RenamedFrom is the previous state name of this attribute, as described in the package documentation.
+	RenamedFrom string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -128,6 +133,11 @@ type BoolAttribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Bool
 
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -175,6 +185,11 @@ func (a BoolAttribute) BoolValidators() []validator.Bool {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a BoolAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // Equal returns true if the given Attribute is a BoolAttribute
 // and all fields are equal.
 func (a BoolAttribute) Equal(o fwschema.Attribute) bool {
@@ -190,6 +205,11 @@ func (a BoolAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetRenamedFrom returns the RenamedFrom field value.
+func (a BoolAttribute) GetRenamedFrom() string {
+	return a.RenamedFrom
+}
+
 // GetDescription returns the Description field value.
 func (a BoolAttribute) GetDescription() string {
 	return a.Description
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boolplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequiresReplaceIfTransitionModifierPlanModifyBool(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"testattr": schema.BoolAttribute{},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testPlan := func(value types.Bool) tfsdk.Plan {
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"testattr": tfValue,
+				},
+			),
+		}
+	}
+
+	testState := func(value types.Bool) tfsdk.State {
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"testattr": tfValue,
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		modifier planmodifier.Bool
+		request  planmodifier.BoolRequest
+		expected *planmodifier.BoolResponse
+	}{
+		"state-null": {
+			// resource creation
+			modifier: boolplanmodifier.RequiresReplaceIfTransition([]bool{false}, []bool{true}),
+			request: planmodifier.BoolRequest{
+				Plan:       testPlan(types.BoolValue(true)),
+				PlanValue:  types.BoolValue(true),
+				State:      nullState,
+				StateValue: types.BoolNull(),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(true),
+			},
+		},
+		"plan-null": {
+			// resource destroy
+			modifier: boolplanmodifier.RequiresReplaceIfTransition([]bool{false}, []bool{true}),
+			request: planmodifier.BoolRequest{
+				Plan:       nullPlan,
+				PlanValue:  types.BoolNull(),
+				State:      testState(types.BoolValue(false)),
+				StateValue: types.BoolValue(false),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolNull(),
+			},
+		},
+		"transition-matching": {
+			modifier: boolplanmodifier.RequiresReplaceIfTransition([]bool{false}, []bool{true}),
+			request: planmodifier.BoolRequest{
+				Plan:       testPlan(types.BoolValue(true)),
+				PlanValue:  types.BoolValue(true),
+				State:      testState(types.BoolValue(false)),
+				StateValue: types.BoolValue(false),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue:       types.BoolValue(true),
+				RequiresReplace: true,
+			},
+		},
+		"transition-not-matching": {
+			modifier: boolplanmodifier.RequiresReplaceIfTransition([]bool{false}, []bool{true}),
+			request: planmodifier.BoolRequest{
+				Plan:       testPlan(types.BoolValue(false)),
+				PlanValue:  types.BoolValue(false),
+				State:      testState(types.BoolValue(true)),
+				StateValue: types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(false),
+			},
+		},
+		"transition-any": {
+			modifier: boolplanmodifier.RequiresReplaceIfTransition(nil, nil),
+			request: planmodifier.BoolRequest{
+				Plan:       testPlan(types.BoolValue(false)),
+				PlanValue:  types.BoolValue(false),
+				State:      testState(types.BoolValue(true)),
+				StateValue: types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue:       types.BoolValue(false),
+				RequiresReplace: true,
+			},
+		},
+		"planvalue-unknown": {
+			modifier: boolplanmodifier.RequiresReplaceIfTransition([]bool{false}, []bool{true}),
+			request: planmodifier.BoolRequest{
+				Plan:       testPlan(types.BoolUnknown()),
+				PlanValue:  types.BoolUnknown(),
+				State:      testState(types.BoolValue(false)),
+				StateValue: types.BoolValue(false),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolUnknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.BoolResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			testCase.modifier.PlanModifyBool(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRequiresReplaceIfTransitionModifierDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		modifier         planmodifier.Bool
+		expected         string
+		expectedMarkdown string
+	}{
+		"single": {
+			modifier:         boolplanmodifier.RequiresReplaceIfTransition([]bool{false}, []bool{true}),
+			expected:         "If the value of this attribute changes from false to true, Terraform will destroy and recreate the resource.",
+			expectedMarkdown: "If the value of this attribute changes from `false` to `true`, Terraform will destroy and recreate the resource.",
+		},
+		"any": {
+			modifier:         boolplanmodifier.RequiresReplaceIfTransition(nil, nil),
+			expected:         "If the value of this attribute changes from any value to any value, Terraform will destroy and recreate the resource.",
+			expectedMarkdown: "If the value of this attribute changes from any value to any value, Terraform will destroy and recreate the resource.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testCase.expected, testCase.modifier.Description(context.Background())); diff != "" {
+				t.Errorf("unexpected description difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedMarkdown, testCase.modifier.MarkdownDescription(context.Background())); diff != "" {
+				t.Errorf("unexpected markdown description difference: %s", diff)
+			}
+		})
+	}
+}
@@ -5,4 +5,12 @@
 // Resource schemas define the structure and value types for configuration,
 // plan, and state data. Schemas are implemented via the resource.Resource type
 // Schema method.
+//
+// Attributes can set a RenamedFrom field to the previous name of the
+// attribute in resource state. The framework then moves the value of the
+// previous attribute name in prior state to the attribute when Terraform
+// upgrades resource state at the current schema version, so renaming an
+// attribute does not require a resource state upgrader. The previous name
+// must not be another attribute or block name at the same level of the
+// schema.
 package schema
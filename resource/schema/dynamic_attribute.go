@@ -20,11 +20,13 @@ import (
 
 // Ensure the implementation satisfies the desired interfaces.
 var (
-	_ Attribute                                    = DynamicAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = DynamicAttribute{}
-	_ fwschema.AttributeWithDynamicDefaultValue    = DynamicAttribute{}
-	_ fwxschema.AttributeWithDynamicPlanModifiers  = DynamicAttribute{}
-	_ fwxschema.AttributeWithDynamicValidators     = DynamicAttribute{}
+	_ Attribute                                      = DynamicAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = DynamicAttribute{}
+	_ fwschema.AttributeWithDynamicDefaultValue      = DynamicAttribute{}
+	_ fwxschema.AttributeWithDynamicPlanModifiers    = DynamicAttribute{}
+	_ fwxschema.AttributeWithDynamicValidators       = DynamicAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = DynamicAttribute{}
+	_ fwschema.AttributeWithRenamedFrom              = DynamicAttribute{}
 )
 
 // DynamicAttribute represents a schema attribute that is a dynamic, rather
@@ -117,6 +119,9 @@ type DynamicAttribute struct {
 	//
 	DeprecationMessage string
 
+	// RenamedFrom is the previous state name of this attribute, as described in the package documentation.
+	RenamedFrom string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -129,6 +134,11 @@ type DynamicAttribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Dynamic
 
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -176,6 +186,11 @@ func (a DynamicAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetRenamedFrom returns the RenamedFrom field value.
+func (a DynamicAttribute) GetRenamedFrom() string {
+	return a.RenamedFrom
+}
+
 // GetDescription returns the Description field value.
 func (a DynamicAttribute) GetDescription() string {
 	return a.Description
@@ -230,6 +245,11 @@ func (a DynamicAttribute) DynamicValidators() []validator.Dynamic {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a DynamicAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
@@ -20,11 +20,13 @@ import (
 
 // Ensure the implementation satisfies the desired interfaces.
 var (
-	_ Attribute                                    = Float32Attribute{}
-	_ fwschema.AttributeWithValidateImplementation = Float32Attribute{}
-	_ fwschema.AttributeWithFloat32DefaultValue    = Float32Attribute{}
-	_ fwxschema.AttributeWithFloat32PlanModifiers  = Float32Attribute{}
-	_ fwxschema.AttributeWithFloat32Validators     = Float32Attribute{}
+	_ Attribute                                      = Float32Attribute{}
+	_ fwschema.AttributeWithValidateImplementation   = Float32Attribute{}
+	_ fwschema.AttributeWithFloat32DefaultValue      = Float32Attribute{}
+	_ fwxschema.AttributeWithFloat32PlanModifiers    = Float32Attribute{}
+	_ fwxschema.AttributeWithFloat32Validators       = Float32Attribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = Float32Attribute{}
+	_ fwschema.AttributeWithRenamedFrom              = Float32Attribute{}
 )
 
 // Float32Attribute represents a schema attribute that is a 32-bit floating
@@ -119,6 +121,9 @@ type Float32Attribute struct {
 	//
 	DeprecationMessage string
 
+	// RenamedFrom is the previous state name of this attribute, as described in the package documentation.
+	RenamedFrom string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -131,6 +136,11 @@ type Float32Attribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Float32
 
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -188,11 +198,21 @@ func (a Float32Attribute) Float32Validators() []validator.Float32 {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a Float32Attribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a Float32Attribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetRenamedFrom returns the RenamedFrom field value.
+func (a Float32Attribute) GetRenamedFrom() string {
+	return a.RenamedFrom
+}
+
 // GetDescription returns the Description field value.
 func (a Float32Attribute) GetDescription() string {
 	return a.Description
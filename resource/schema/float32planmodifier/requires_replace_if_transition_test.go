@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float32planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequiresReplaceIfTransitionModifierPlanModifyFloat32(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"testattr": schema.Float32Attribute{},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testPlan := func(value types.Float32) tfsdk.Plan {
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"testattr": tfValue,
+				},
+			),
+		}
+	}
+
+	testState := func(value types.Float32) tfsdk.State {
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"testattr": tfValue,
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		modifier planmodifier.Float32
+		request  planmodifier.Float32Request
+		expected *planmodifier.Float32Response
+	}{
+		"state-null": {
+			// resource creation
+			modifier: float32planmodifier.RequiresReplaceIfTransition([]float32{1.5, 2.5}, []float32{3.5}),
+			request: planmodifier.Float32Request{
+				Plan:       testPlan(types.Float32Value(3.5)),
+				PlanValue:  types.Float32Value(3.5),
+				State:      nullState,
+				StateValue: types.Float32Null(),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(3.5),
+			},
+		},
+		"plan-null": {
+			// resource destroy
+			modifier: float32planmodifier.RequiresReplaceIfTransition([]float32{1.5, 2.5}, []float32{3.5}),
+			request: planmodifier.Float32Request{
+				Plan:       nullPlan,
+				PlanValue:  types.Float32Null(),
+				State:      testState(types.Float32Value(1.5)),
+				StateValue: types.Float32Value(1.5),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Null(),
+			},
+		},
+		"planvalue-statevalue-equal": {
+			modifier: float32planmodifier.RequiresReplaceIfTransition(nil, nil),
+			request: planmodifier.Float32Request{
+				Plan:       testPlan(types.Float32Value(1.5)),
+				PlanValue:  types.Float32Value(1.5),
+				State:      testState(types.Float32Value(1.5)),
+				StateValue: types.Float32Value(1.5),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(1.5),
+			},
+		},
+		"transition-matching": {
+			modifier: float32planmodifier.RequiresReplaceIfTransition([]float32{1.5, 2.5}, []float32{3.5}),
+			request: planmodifier.Float32Request{
+				Plan:       testPlan(types.Float32Value(3.5)),
+				PlanValue:  types.Float32Value(3.5),
+				State:      testState(types.Float32Value(2.5)),
+				StateValue: types.Float32Value(2.5),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue:       types.Float32Value(3.5),
+				RequiresReplace: true,
+			},
+		},
+		"transition-from-not-matching": {
+			modifier: float32planmodifier.RequiresReplaceIfTransition([]float32{1.5, 2.5}, []float32{3.5}),
+			request: planmodifier.Float32Request{
+				Plan:       testPlan(types.Float32Value(3.5)),
+				PlanValue:  types.Float32Value(3.5),
+				State:      testState(types.Float32Value(4.5)),
+				StateValue: types.Float32Value(4.5),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(3.5),
+			},
+		},
+		"transition-to-not-matching": {
+			modifier: float32planmodifier.RequiresReplaceIfTransition([]float32{1.5, 2.5}, []float32{3.5}),
+			request: planmodifier.Float32Request{
+				Plan:       testPlan(types.Float32Value(4.5)),
+				PlanValue:  types.Float32Value(4.5),
+				State:      testState(types.Float32Value(1.5)),
+				StateValue: types.Float32Value(1.5),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(4.5),
+			},
+		},
+		"transition-from-any": {
+			modifier: float32planmodifier.RequiresReplaceIfTransition(nil, []float32{3.5}),
+			request: planmodifier.Float32Request{
+				Plan:       testPlan(types.Float32Value(3.5)),
+				PlanValue:  types.Float32Value(3.5),
+				State:      testState(types.Float32Value(4.5)),
+				StateValue: types.Float32Value(4.5),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue:       types.Float32Value(3.5),
+				RequiresReplace: true,
+			},
+		},
+		"planvalue-unknown": {
+			modifier: float32planmodifier.RequiresReplaceIfTransition(nil, nil),
+			request: planmodifier.Float32Request{
+				Plan:       testPlan(types.Float32Unknown()),
+				PlanValue:  types.Float32Unknown(),
+				State:      testState(types.Float32Value(1.5)),
+				StateValue: types.Float32Value(1.5),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Unknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Float32Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			testCase.modifier.PlanModifyFloat32(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRequiresReplaceIfTransitionModifierDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		modifier         planmodifier.Float32
+		expected         string
+		expectedMarkdown string
+	}{
+		"values": {
+			modifier:         float32planmodifier.RequiresReplaceIfTransition([]float32{1.5, 2.5}, []float32{3.5}),
+			expected:         "If the value of this attribute changes from one of 1.5, 2.5 to 3.5, Terraform will destroy and recreate the resource.",
+			expectedMarkdown: "If the value of this attribute changes from one of `1.5`, `2.5` to `3.5`, Terraform will destroy and recreate the resource.",
+		},
+		"any": {
+			modifier:         float32planmodifier.RequiresReplaceIfTransition(nil, nil),
+			expected:         "If the value of this attribute changes from any value to any value, Terraform will destroy and recreate the resource.",
+			expectedMarkdown: "If the value of this attribute changes from any value to any value, Terraform will destroy and recreate the resource.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testCase.expected, testCase.modifier.Description(context.Background())); diff != "" {
+				t.Errorf("unexpected description difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedMarkdown, testCase.modifier.MarkdownDescription(context.Background())); diff != "" {
+				t.Errorf("unexpected markdown description difference: %s", diff)
+			}
+		})
+	}
+}
@@ -20,11 +20,13 @@ import (
 
 // Ensure the implementation satisfies the desired interfaces.
 var (
-	_ Attribute                                    = Float64Attribute{}
-	_ fwschema.AttributeWithValidateImplementation = Float64Attribute{}
-	_ fwschema.AttributeWithFloat64DefaultValue    = Float64Attribute{}
-	_ fwxschema.AttributeWithFloat64PlanModifiers  = Float64Attribute{}
-	_ fwxschema.AttributeWithFloat64Validators     = Float64Attribute{}
+	_ Attribute                                      = Float64Attribute{}
+	_ fwschema.AttributeWithValidateImplementation   = Float64Attribute{}
+	_ fwschema.AttributeWithFloat64DefaultValue      = Float64Attribute{}
+	_ fwxschema.AttributeWithFloat64PlanModifiers    = Float64Attribute{}
+	_ fwxschema.AttributeWithFloat64Validators       = Float64Attribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = Float64Attribute{}
+	_ fwschema.AttributeWithRenamedFrom              = Float64Attribute{}
 )
 
 // Float64Attribute represents a schema attribute that is a 64-bit floating
@@ -119,6 +121,9 @@ type Float64Attribute struct {
 	//
 	DeprecationMessage string
 
+	// RenamedFrom is the previous state name of this attribute, as described in the package documentation.
+	RenamedFrom string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -131,6 +136,11 @@ type Float64Attribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Float64
 
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -188,11 +198,21 @@ func (a Float64Attribute) Float64Validators() []validator.Float64 {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a Float64Attribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a Float64Attribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetRenamedFrom returns the RenamedFrom field value.
+func (a Float64Attribute) GetRenamedFrom() string {
+	return a.RenamedFrom
+}
+
 // GetDescription returns the Description field value.
 func (a Float64Attribute) GetDescription() string {
 	return a.Description
@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequiresReplaceIfTransitionModifierPlanModifyFloat64(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"testattr": schema.Float64Attribute{},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testPlan := func(value types.Float64) tfsdk.Plan {
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"testattr": tfValue,
+				},
+			),
+		}
+	}
+
+	testState := func(value types.Float64) tfsdk.State {
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"testattr": tfValue,
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		modifier planmodifier.Float64
+		request  planmodifier.Float64Request
+		expected *planmodifier.Float64Response
+	}{
+		"state-null": {
+			// resource creation
+			modifier: float64planmodifier.RequiresReplaceIfTransition([]float64{1.5, 2.5}, []float64{3.5}),
+			request: planmodifier.Float64Request{
+				Plan:       testPlan(types.Float64Value(3.5)),
+				PlanValue:  types.Float64Value(3.5),
+				State:      nullState,
+				StateValue: types.Float64Null(),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(3.5),
+			},
+		},
+		"plan-null": {
+			// resource destroy
+			modifier: float64planmodifier.RequiresReplaceIfTransition([]float64{1.5, 2.5}, []float64{3.5}),
+			request: planmodifier.Float64Request{
+				Plan:       nullPlan,
+				PlanValue:  types.Float64Null(),
+				State:      testState(types.Float64Value(1.5)),
+				StateValue: types.Float64Value(1.5),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Null(),
+			},
+		},
+		"planvalue-statevalue-equal": {
+			modifier: float64planmodifier.RequiresReplaceIfTransition(nil, nil),
+			request: planmodifier.Float64Request{
+				Plan:       testPlan(types.Float64Value(1.5)),
+				PlanValue:  types.Float64Value(1.5),
+				State:      testState(types.Float64Value(1.5)),
+				StateValue: types.Float64Value(1.5),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(1.5),
+			},
+		},
+		"transition-matching": {
+			modifier: float64planmodifier.RequiresReplaceIfTransition([]float64{1.5, 2.5}, []float64{3.5}),
+			request: planmodifier.Float64Request{
+				Plan:       testPlan(types.Float64Value(3.5)),
+				PlanValue:  types.Float64Value(3.5),
+				State:      testState(types.Float64Value(2.5)),
+				StateValue: types.Float64Value(2.5),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue:       types.Float64Value(3.5),
+				RequiresReplace: true,
+			},
+		},
+		"transition-from-not-matching": {
+			modifier: float64planmodifier.RequiresReplaceIfTransition([]float64{1.5, 2.5}, []float64{3.5}),
+			request: planmodifier.Float64Request{
+				Plan:       testPlan(types.Float64Value(3.5)),
+				PlanValue:  types.Float64Value(3.5),
+				State:      testState(types.Float64Value(4.5)),
+				StateValue: types.Float64Value(4.5),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(3.5),
+			},
+		},
+		"transition-to-not-matching": {
+			modifier: float64planmodifier.RequiresReplaceIfTransition([]float64{1.5, 2.5}, []float64{3.5}),
+			request: planmodifier.Float64Request{
+				Plan:       testPlan(types.Float64Value(4.5)),
+				PlanValue:  types.Float64Value(4.5),
+				State:      testState(types.Float64Value(1.5)),
+				StateValue: types.Float64Value(1.5),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(4.5),
+			},
+		},
+		"transition-from-any": {
+			modifier: float64planmodifier.RequiresReplaceIfTransition(nil, []float64{3.5}),
+			request: planmodifier.Float64Request{
+				Plan:       testPlan(types.Float64Value(3.5)),
+				PlanValue:  types.Float64Value(3.5),
+				State:      testState(types.Float64Value(4.5)),
+				StateValue: types.Float64Value(4.5),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue:       types.Float64Value(3.5),
+				RequiresReplace: true,
+			},
+		},
+		"planvalue-unknown": {
+			modifier: float64planmodifier.RequiresReplaceIfTransition(nil, nil),
+			request: planmodifier.Float64Request{
+				Plan:       testPlan(types.Float64Unknown()),
+				PlanValue:  types.Float64Unknown(),
+				State:      testState(types.Float64Value(1.5)),
+				StateValue: types.Float64Value(1.5),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Unknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Float64Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			testCase.modifier.PlanModifyFloat64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRequiresReplaceIfTransitionModifierDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		modifier         planmodifier.Float64
+		expected         string
+		expectedMarkdown string
+	}{
+		"values": {
+			modifier:         float64planmodifier.RequiresReplaceIfTransition([]float64{1.5, 2.5}, []float64{3.5}),
+			expected:         "If the value of this attribute changes from one of 1.5, 2.5 to 3.5, Terraform will destroy and recreate the resource.",
+			expectedMarkdown: "If the value of this attribute changes from one of `1.5`, `2.5` to `3.5`, Terraform will destroy and recreate the resource.",
+		},
+		"any": {
+			modifier:         float64planmodifier.RequiresReplaceIfTransition(nil, nil),
+			expected:         "If the value of this attribute changes from any value to any value, Terraform will destroy and recreate the resource.",
+			expectedMarkdown: "If the value of this attribute changes from any value to any value, Terraform will destroy and recreate the resource.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testCase.expected, testCase.modifier.Description(context.Background())); diff != "" {
+				t.Errorf("unexpected description difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedMarkdown, testCase.modifier.MarkdownDescription(context.Background())); diff != "" {
+				t.Errorf("unexpected markdown description difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package idattribute provides helpers for the conventional computed "id"
+// resource attribute, which Terraform testing tooling expects to be present
+// in resource state.
+package idattribute
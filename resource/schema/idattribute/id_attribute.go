@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package idattribute
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Name is the conventional name of the resource identifier attribute.
+const Name = "id"
+
+// Getter is implemented by tfsdk.Config, tfsdk.Plan, and tfsdk.State.
+type Getter interface {
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}
+
+// Setter is implemented by *tfsdk.Plan and *tfsdk.State.
+type Setter interface {
+	SetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}
+
+// Attribute returns a computed string attribute for the resource identifier,
+// which should be added to the resource schema attributes under Name. The
+// attribute uses the stringplanmodifier.UseStateForUnknown plan modifier, as
+// the identifier of an existing resource is not expected to change.
+//
+// Use the returned attribute as a starting point if the identifier requires
+// different behaviors, such as additional plan modifiers.
+func Attribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:            true,
+		Description:         "Identifier of the resource.",
+		MarkdownDescription: "Identifier of the resource.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// Path returns the path of the resource identifier attribute.
+func Path() path.Path {
+	return path.Root(Name)
+}
+
+// Get returns the resource identifier attribute value from the given data,
+// such as the resource configuration, plan, or state.
+func Get(ctx context.Context, data Getter) (types.String, diag.Diagnostics) {
+	var id types.String
+
+	diags := data.GetAttribute(ctx, Path(), &id)
+
+	return id, diags
+}
+
+// Set sets the resource identifier attribute value in the given data, such
+// as the resource plan or state.
+func Set(ctx context.Context, data Setter, id string) diag.Diagnostics {
+	return data.SetAttribute(ctx, Path(), id)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package idattribute_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/idattribute"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAttribute(t *testing.T) {
+	t.Parallel()
+
+	got := idattribute.Attribute()
+
+	if !got.IsComputed() || got.IsOptional() || got.IsRequired() {
+		t.Errorf("expected computed only attribute, got: %#v", got)
+	}
+
+	if len(got.StringPlanModifiers()) != 1 {
+		t.Errorf("expected one plan modifier, got: %d", len(got.StringPlanModifiers()))
+	}
+
+	if diff := cmp.Diff(idattribute.Path(), path.Root("id")); diff != "" {
+		t.Errorf("unexpected path difference: %s", diff)
+	}
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			idattribute.Name: idattribute.Attribute(),
+		},
+	}
+
+	testCases := map[string]struct {
+		state         tfsdk.State
+		expected      types.String
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			state: tfsdk.State{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(
+					testSchema.Type().TerraformType(context.Background()),
+					map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "test-id"),
+					},
+				),
+			},
+			expected: types.StringValue("test-id"),
+		},
+		"null": {
+			state: tfsdk.State{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(
+					testSchema.Type().TerraformType(context.Background()),
+					map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, nil),
+					},
+				),
+			},
+			expected: types.StringNull(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := idattribute.Get(context.Background(), testCase.state)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			idattribute.Name: idattribute.Attribute(),
+		},
+	}
+
+	state := &tfsdk.State{
+		Schema: testSchema,
+		Raw:    tftypes.NewValue(testSchema.Type().TerraformType(context.Background()), nil),
+	}
+
+	diags := idattribute.Set(context.Background(), state, "test-id")
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := tftypes.NewValue(
+		testSchema.Type().TerraformType(context.Background()),
+		map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "test-id"),
+		},
+	)
+
+	if diff := cmp.Diff(state.Raw, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -20,11 +20,13 @@ import (
 
 // Ensure the implementation satisfies the desired interfaces.
 var (
-	_ Attribute                                    = Int64Attribute{}
-	_ fwschema.AttributeWithValidateImplementation = Int64Attribute{}
-	_ fwschema.AttributeWithInt64DefaultValue      = Int64Attribute{}
-	_ fwxschema.AttributeWithInt64PlanModifiers    = Int64Attribute{}
-	_ fwxschema.AttributeWithInt64Validators       = Int64Attribute{}
+	_ Attribute                                      = Int64Attribute{}
+	_ fwschema.AttributeWithValidateImplementation   = Int64Attribute{}
+	_ fwschema.AttributeWithInt64DefaultValue        = Int64Attribute{}
+	_ fwxschema.AttributeWithInt64PlanModifiers      = Int64Attribute{}
+	_ fwxschema.AttributeWithInt64Validators         = Int64Attribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = Int64Attribute{}
+	_ fwschema.AttributeWithRenamedFrom              = Int64Attribute{}
 )
 
 // Int64Attribute represents a schema attribute that is a 64-bit integer.
@@ -119,6 +121,9 @@ type Int64Attribute struct {
 	//
 	DeprecationMessage string
 
+	// RenamedFrom is the previous state name of this attribute, as described in the package documentation.
+	RenamedFrom string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -131,6 +136,11 @@ type Int64Attribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Int64
 
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -178,6 +188,11 @@ func (a Int64Attribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetRenamedFrom returns the RenamedFrom field value.
+func (a Int64Attribute) GetRenamedFrom() string {
+	return a.RenamedFrom
+}
+
 // GetDescription returns the Description field value.
 func (a Int64Attribute) GetDescription() string {
 	return a.Description
@@ -212,6 +227,11 @@ func (a Int64Attribute) Int64Validators() []validator.Int64 {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a Int64Attribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // IsComputed returns the Computed field value.
 func (a Int64Attribute) IsComputed() bool {
 	return a.Computed
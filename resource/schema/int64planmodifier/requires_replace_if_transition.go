@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int64planmodifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// RequiresReplaceIfTransition returns a plan modifier that conditionally
+// requires resource replacement if:
+//
+//   - The resource is planned for update.
+//   - The plan and state values are not equal.
+//   - The state and plan values are known and not null.
+//   - The state value is one of the given from values, or from is empty.
+//   - The plan value is one of the given to values, or to is empty.
+//
+// Use RequiresReplaceIfTransition when only some value changes require
+// replacement, such as a remote system which can upgrade a resource in place
+// but must recreate it to downgrade. Use RequiresReplaceIf if the resource
+// replacement should check other provider-defined conditional logic.
+func RequiresReplaceIfTransition(from []int64, to []int64) planmodifier.Int64 {
+	description := fmt.Sprintf(
+		"If the value of this attribute changes from %s to %s, Terraform will destroy and recreate the resource.",
+		requiresReplaceIfTransitionValues(from, "%s"),
+		requiresReplaceIfTransitionValues(to, "%s"),
+	)
+	markdownDescription := fmt.Sprintf(
+		"If the value of this attribute changes from %s to %s, Terraform will destroy and recreate the resource.",
+		requiresReplaceIfTransitionValues(from, "`%s`"),
+		requiresReplaceIfTransitionValues(to, "`%s`"),
+	)
+
+	return RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.Int64Request, resp *RequiresReplaceIfFuncResponse) {
+			if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+				return
+			}
+
+			if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+				return
+			}
+
+			if !requiresReplaceIfTransitionContains(from, req.StateValue.ValueInt64()) {
+				return
+			}
+
+			if !requiresReplaceIfTransitionContains(to, req.PlanValue.ValueInt64()) {
+				return
+			}
+
+			resp.RequiresReplace = true
+		},
+		description,
+		markdownDescription,
+	)
+}
+
+// requiresReplaceIfTransitionContains returns true if values is empty or
+// contains value.
+func requiresReplaceIfTransitionContains(values []int64, value int64) bool {
+	if len(values) == 0 {
+		return true
+	}
+
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requiresReplaceIfTransitionValues returns a description of values, with
+// each value formatted by format.
+func requiresReplaceIfTransitionValues(values []int64, format string) string {
+	if len(values) == 0 {
+		return "any value"
+	}
+
+	formatted := make([]string, 0, len(values))
+
+	for _, v := range values {
+		formatted = append(formatted, fmt.Sprintf(format, fmt.Sprintf("%v", v)))
+	}
+
+	if len(formatted) == 1 {
+		return formatted[0]
+	}
+
+	return "one of " + strings.Join(formatted, ", ")
+}
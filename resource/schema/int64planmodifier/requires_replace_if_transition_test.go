@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int64planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequiresReplaceIfTransitionModifierPlanModifyInt64(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"testattr": schema.Int64Attribute{},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testPlan := func(value types.Int64) tfsdk.Plan {
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"testattr": tfValue,
+				},
+			),
+		}
+	}
+
+	testState := func(value types.Int64) tfsdk.State {
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"testattr": tfValue,
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		modifier planmodifier.Int64
+		request  planmodifier.Int64Request
+		expected *planmodifier.Int64Response
+	}{
+		"state-null": {
+			// resource creation
+			modifier: int64planmodifier.RequiresReplaceIfTransition([]int64{1, 2}, []int64{3}),
+			request: planmodifier.Int64Request{
+				Plan:       testPlan(types.Int64Value(3)),
+				PlanValue:  types.Int64Value(3),
+				State:      nullState,
+				StateValue: types.Int64Null(),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(3),
+			},
+		},
+		"plan-null": {
+			// resource destroy
+			modifier: int64planmodifier.RequiresReplaceIfTransition([]int64{1, 2}, []int64{3}),
+			request: planmodifier.Int64Request{
+				Plan:       nullPlan,
+				PlanValue:  types.Int64Null(),
+				State:      testState(types.Int64Value(1)),
+				StateValue: types.Int64Value(1),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Null(),
+			},
+		},
+		"planvalue-statevalue-equal": {
+			modifier: int64planmodifier.RequiresReplaceIfTransition(nil, nil),
+			request: planmodifier.Int64Request{
+				Plan:       testPlan(types.Int64Value(1)),
+				PlanValue:  types.Int64Value(1),
+				State:      testState(types.Int64Value(1)),
+				StateValue: types.Int64Value(1),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(1),
+			},
+		},
+		"transition-matching": {
+			modifier: int64planmodifier.RequiresReplaceIfTransition([]int64{1, 2}, []int64{3}),
+			request: planmodifier.Int64Request{
+				Plan:       testPlan(types.Int64Value(3)),
+				PlanValue:  types.Int64Value(3),
+				State:      testState(types.Int64Value(2)),
+				StateValue: types.Int64Value(2),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue:       types.Int64Value(3),
+				RequiresReplace: true,
+			},
+		},
+		"transition-from-not-matching": {
+			modifier: int64planmodifier.RequiresReplaceIfTransition([]int64{1, 2}, []int64{3}),
+			request: planmodifier.Int64Request{
+				Plan:       testPlan(types.Int64Value(3)),
+				PlanValue:  types.Int64Value(3),
+				State:      testState(types.Int64Value(4)),
+				StateValue: types.Int64Value(4),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(3),
+			},
+		},
+		"transition-to-not-matching": {
+			modifier: int64planmodifier.RequiresReplaceIfTransition([]int64{1, 2}, []int64{3}),
+			request: planmodifier.Int64Request{
+				Plan:       testPlan(types.Int64Value(4)),
+				PlanValue:  types.Int64Value(4),
+				State:      testState(types.Int64Value(1)),
+				StateValue: types.Int64Value(1),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(4),
+			},
+		},
+		"transition-from-any": {
+			modifier: int64planmodifier.RequiresReplaceIfTransition(nil, []int64{3}),
+			request: planmodifier.Int64Request{
+				Plan:       testPlan(types.Int64Value(3)),
+				PlanValue:  types.Int64Value(3),
+				State:      testState(types.Int64Value(4)),
+				StateValue: types.Int64Value(4),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue:       types.Int64Value(3),
+				RequiresReplace: true,
+			},
+		},
+		"planvalue-unknown": {
+			modifier: int64planmodifier.RequiresReplaceIfTransition(nil, nil),
+			request: planmodifier.Int64Request{
+				Plan:       testPlan(types.Int64Unknown()),
+				PlanValue:  types.Int64Unknown(),
+				State:      testState(types.Int64Value(1)),
+				StateValue: types.Int64Value(1),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Unknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Int64Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			testCase.modifier.PlanModifyInt64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRequiresReplaceIfTransitionModifierDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		modifier         planmodifier.Int64
+		expected         string
+		expectedMarkdown string
+	}{
+		"values": {
+			modifier:         int64planmodifier.RequiresReplaceIfTransition([]int64{1, 2}, []int64{3}),
+			expected:         "If the value of this attribute changes from one of 1, 2 to 3, Terraform will destroy and recreate the resource.",
+			expectedMarkdown: "If the value of this attribute changes from one of `1`, `2` to `3`, Terraform will destroy and recreate the resource.",
+		},
+		"any": {
+			modifier:         int64planmodifier.RequiresReplaceIfTransition(nil, nil),
+			expected:         "If the value of this attribute changes from any value to any value, Terraform will destroy and recreate the resource.",
+			expectedMarkdown: "If the value of this attribute changes from any value to any value, Terraform will destroy and recreate the resource.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testCase.expected, testCase.modifier.Description(context.Background())); diff != "" {
+				t.Errorf("unexpected description difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedMarkdown, testCase.modifier.MarkdownDescription(context.Background())); diff != "" {
+				t.Errorf("unexpected markdown description difference: %s", diff)
+			}
+		})
+	}
+}
@@ -21,11 +21,13 @@ import (
 
 // Ensure the implementation satisfies the desired interfaces.
 var (
-	_ Attribute                                    = ListAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = ListAttribute{}
-	_ fwschema.AttributeWithListDefaultValue       = ListAttribute{}
-	_ fwxschema.AttributeWithListPlanModifiers     = ListAttribute{}
-	_ fwxschema.AttributeWithListValidators        = ListAttribute{}
+	_ Attribute                                      = ListAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = ListAttribute{}
+	_ fwschema.AttributeWithListDefaultValue         = ListAttribute{}
+	_ fwxschema.AttributeWithListPlanModifiers       = ListAttribute{}
+	_ fwxschema.AttributeWithListValidators          = ListAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = ListAttribute{}
+	_ fwschema.AttributeWithRenamedFrom              = ListAttribute{}
 )
 
 // ListAttribute represents a schema attribute that is a list with a single
@@ -132,6 +134,9 @@ type ListAttribute struct {
 	//
 	DeprecationMessage string
 
+	// RenamedFrom is the previous state name of this attribute, as described in the package documentation.
+	RenamedFrom string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -144,6 +149,11 @@ type ListAttribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.List
 
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -191,6 +201,11 @@ func (a ListAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetRenamedFrom returns the RenamedFrom field value.
+func (a ListAttribute) GetRenamedFrom() string {
+	return a.RenamedFrom
+}
+
 // GetDescription returns the Description field value.
 func (a ListAttribute) GetDescription() string {
 	return a.Description
@@ -247,6 +262,11 @@ func (a ListAttribute) ListValidators() []validator.List {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a ListAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
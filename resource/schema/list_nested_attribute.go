@@ -22,11 +22,13 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ NestedAttribute                              = ListNestedAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = ListNestedAttribute{}
-	_ fwschema.AttributeWithListDefaultValue       = ListNestedAttribute{}
-	_ fwxschema.AttributeWithListPlanModifiers     = ListNestedAttribute{}
-	_ fwxschema.AttributeWithListValidators        = ListNestedAttribute{}
+	_ NestedAttribute                                = ListNestedAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = ListNestedAttribute{}
+	_ fwschema.AttributeWithListDefaultValue         = ListNestedAttribute{}
+	_ fwxschema.AttributeWithListPlanModifiers       = ListNestedAttribute{}
+	_ fwxschema.AttributeWithListValidators          = ListNestedAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = ListNestedAttribute{}
+	_ fwschema.AttributeWithRenamedFrom              = ListNestedAttribute{}
 )
 
 // ListNestedAttribute represents an attribute that is a list of objects where
@@ -142,6 +144,9 @@ type ListNestedAttribute struct {
 	//
 	DeprecationMessage string
 
+	// RenamedFrom is the previous state name of this attribute, as described in the package documentation.
+	RenamedFrom string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -154,6 +159,11 @@ type ListNestedAttribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.List
 
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -209,6 +219,11 @@ func (a ListNestedAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetRenamedFrom returns the RenamedFrom field value.
+func (a ListNestedAttribute) GetRenamedFrom() string {
+	return a.RenamedFrom
+}
+
 // GetDescription returns the Description field value.
 func (a ListNestedAttribute) GetDescription() string {
 	return a.Description
@@ -275,6 +290,11 @@ func (a ListNestedAttribute) ListValidators() []validator.List {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a ListNestedAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
@@ -21,11 +21,13 @@ import (
 
 // Ensure the implementation satisfies the desired interfaces.
 var (
-	_ Attribute                                    = MapAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = MapAttribute{}
-	_ fwschema.AttributeWithMapDefaultValue        = MapAttribute{}
-	_ fwxschema.AttributeWithMapPlanModifiers      = MapAttribute{}
-	_ fwxschema.AttributeWithMapValidators         = MapAttribute{}
+	_ Attribute                                      = MapAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = MapAttribute{}
+	_ fwschema.AttributeWithMapDefaultValue          = MapAttribute{}
+	_ fwxschema.AttributeWithMapPlanModifiers        = MapAttribute{}
+	_ fwxschema.AttributeWithMapValidators           = MapAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = MapAttribute{}
+	_ fwschema.AttributeWithRenamedFrom              = MapAttribute{}
 )
 
 // MapAttribute represents a schema attribute that is a list with a single
@@ -135,6 +137,9 @@ type MapAttribute struct {
 	//
 	DeprecationMessage string
 
+	// RenamedFrom is the previous state name of this attribute, as described in the package documentation.
+	RenamedFrom string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -147,6 +152,11 @@ type MapAttribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Map
 
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -194,6 +204,11 @@ func (a MapAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetRenamedFrom returns the RenamedFrom field value.
+func (a MapAttribute) GetRenamedFrom() string {
+	return a.RenamedFrom
+}
+
 // GetDescription returns the Description field value.
 func (a MapAttribute) GetDescription() string {
 	return a.Description
@@ -250,6 +265,11 @@ func (a MapAttribute) MapValidators() []validator.Map {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a MapAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
@@ -22,11 +22,13 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ NestedAttribute                              = MapNestedAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = MapNestedAttribute{}
-	_ fwschema.AttributeWithMapDefaultValue        = MapNestedAttribute{}
-	_ fwxschema.AttributeWithMapPlanModifiers      = MapNestedAttribute{}
-	_ fwxschema.AttributeWithMapValidators         = MapNestedAttribute{}
+	_ NestedAttribute                                = MapNestedAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = MapNestedAttribute{}
+	_ fwschema.AttributeWithMapDefaultValue          = MapNestedAttribute{}
+	_ fwxschema.AttributeWithMapPlanModifiers        = MapNestedAttribute{}
+	_ fwxschema.AttributeWithMapValidators           = MapNestedAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = MapNestedAttribute{}
+	_ fwschema.AttributeWithRenamedFrom              = MapNestedAttribute{}
 )
 
 // MapNestedAttribute represents an attribute that is a set of objects where
@@ -142,6 +144,9 @@ type MapNestedAttribute struct {
 	//
 	DeprecationMessage string
 
+	// RenamedFrom is the previous state name of this attribute, as described in the package documentation.
+	RenamedFrom string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -154,6 +159,11 @@ type MapNestedAttribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Map
 
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -209,6 +219,11 @@ func (a MapNestedAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetRenamedFrom returns the RenamedFrom field value.
+func (a MapNestedAttribute) GetRenamedFrom() string {
+	return a.RenamedFrom
+}
+
 // GetDescription returns the Description field value.
 func (a MapNestedAttribute) GetDescription() string {
 	return a.Description
@@ -275,6 +290,11 @@ func (a MapNestedAttribute) MapValidators() []validator.Map {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a MapNestedAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
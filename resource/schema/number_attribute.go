@@ -20,11 +20,13 @@ import (
 
 // Ensure the implementation satisfies the desired interfaces.
 var (
-	_ Attribute                                    = NumberAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = NumberAttribute{}
-	_ fwschema.AttributeWithNumberDefaultValue     = NumberAttribute{}
-	_ fwxschema.AttributeWithNumberPlanModifiers   = NumberAttribute{}
-	_ fwxschema.AttributeWithNumberValidators      = NumberAttribute{}
+	_ Attribute                                      = NumberAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = NumberAttribute{}
+	_ fwschema.AttributeWithNumberDefaultValue       = NumberAttribute{}
+	_ fwxschema.AttributeWithNumberPlanModifiers     = NumberAttribute{}
+	_ fwxschema.AttributeWithNumberValidators        = NumberAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = NumberAttribute{}
+	_ fwschema.AttributeWithRenamedFrom              = NumberAttribute{}
 )
 
 // NumberAttribute represents a schema attribute that is a generic number with
@@ -120,6 +122,9 @@ type NumberAttribute struct {
 	//
 	DeprecationMessage string
 
+	// RenamedFrom is the previous state name of this attribute, as described in the package documentation.
+	RenamedFrom string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -132,6 +137,11 @@ type NumberAttribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Number
 
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -179,6 +189,11 @@ func (a NumberAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetRenamedFrom returns the RenamedFrom field value.
+func (a NumberAttribute) GetRenamedFrom() string {
+	return a.RenamedFrom
+}
+
 // GetDescription returns the Description field value.
 func (a NumberAttribute) GetDescription() string {
 	return a.Description
@@ -233,6 +248,11 @@ func (a NumberAttribute) NumberValidators() []validator.Number {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a NumberAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
@@ -21,11 +21,13 @@ import (
 
 // Ensure the implementation satisfies the desired interfaces.
 var (
-	_ Attribute                                    = ObjectAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = ObjectAttribute{}
-	_ fwschema.AttributeWithObjectDefaultValue     = ObjectAttribute{}
-	_ fwxschema.AttributeWithObjectPlanModifiers   = ObjectAttribute{}
-	_ fwxschema.AttributeWithObjectValidators      = ObjectAttribute{}
+	_ Attribute                                      = ObjectAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = ObjectAttribute{}
+	_ fwschema.AttributeWithObjectDefaultValue       = ObjectAttribute{}
+	_ fwxschema.AttributeWithObjectPlanModifiers     = ObjectAttribute{}
+	_ fwxschema.AttributeWithObjectValidators        = ObjectAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = ObjectAttribute{}
+	_ fwschema.AttributeWithRenamedFrom              = ObjectAttribute{}
 )
 
 // ObjectAttribute represents a schema attribute that is an object with only
@@ -134,6 +136,9 @@ type ObjectAttribute struct {
 	//
 	DeprecationMessage string
 
+	// RenamedFrom is the previous state name of this attribute, as described in the package documentation.
+	RenamedFrom string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -146,6 +151,11 @@ type ObjectAttribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Object
 
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -193,6 +203,11 @@ func (a ObjectAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetRenamedFrom returns the RenamedFrom field value.
+func (a ObjectAttribute) GetRenamedFrom() string {
+	return a.RenamedFrom
+}
+
 // GetDescription returns the Description field value.
 func (a ObjectAttribute) GetDescription() string {
 	return a.Description
@@ -249,6 +264,11 @@ func (a ObjectAttribute) ObjectValidators() []validator.Object {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a ObjectAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
@@ -31,12 +31,22 @@ type Int32Request struct {
 	// of the attribute for modification.
 	PathExpression path.Expression
 
+	// Attribute contains the schema definition of the attribute for
+	// plan modification, such as whether it is Required, Optional, or Computed.
+	Attribute Attribute
+
 	// Config contains the entire configuration of the resource.
 	Config tfsdk.Config
 
 	// ConfigValue contains the value of the attribute for modification from the configuration.
 	ConfigValue types.Int32
 
+	// ConfigValueSource is where the value of the attribute in the proposed
+	// new state came from, such as the configuration or the Default field of
+	// the attribute. Use this to distinguish a configured value from the
+	// same value set by a default.
+	ConfigValueSource ValueSource
+
 	// Plan contains the entire proposed new state of the resource.
 	Plan tfsdk.Plan
 
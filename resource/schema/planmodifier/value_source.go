@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifier
+
+// ValueSource represents where the planned value of an attribute came from
+// before plan modification, which enables plan modifiers to distinguish a
+// value explicitly set in the configuration from the same value set by a
+// schema default.
+type ValueSource int
+
+const (
+	// ValueSourceInvalid represents an undefined value source.
+	//
+	// It should not be used directly in implementations.
+	ValueSourceInvalid ValueSource = 0
+
+	// ValueSourceConfig represents a value which is set in the
+	// configuration, including unknown values.
+	ValueSourceConfig ValueSource = 1
+
+	// ValueSourceDefault represents a value which is null in the
+	// configuration and set by the Default field of the attribute.
+	ValueSourceDefault ValueSource = 2
+
+	// ValueSourceComputed represents a value which is null in the
+	// configuration of a Computed attribute without a Default, so the value
+	// is determined by the provider.
+	ValueSourceComputed ValueSource = 3
+
+	// ValueSourceNull represents a value which is null in the configuration
+	// and not otherwise set, such as an unconfigured Optional attribute
+	// without a Default or an unconfigured block.
+	ValueSourceNull ValueSource = 4
+)
+
+// String returns a textual representation of the value source.
+func (s ValueSource) String() string {
+	switch s {
+	case ValueSourceConfig:
+		return "Config"
+	case ValueSourceDefault:
+		return "Default"
+	case ValueSourceComputed:
+		return "Computed"
+	case ValueSourceNull:
+		return "Null"
+	default:
+		return "Invalid"
+	}
+}
@@ -152,7 +152,20 @@ func (s Schema) Validate() diag.Diagnostics {
 func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	for attributeName, attribute := range s.GetAttributes() {
+	if s.Version < 0 {
+		diags.AddError(
+			"Invalid Schema Implementation",
+			"When validating the schema, an implementation issue was found. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Version is %d, but must be greater than or equal to 0.", s.Version),
+		)
+	}
+
+	attributes := s.GetAttributes()
+
+	for _, attributeName := range fwschema.SortedNames(attributes) {
+		attribute := attributes[attributeName]
+
 		req := fwschema.ValidateImplementationRequest{
 			Name: attributeName,
 			Path: path.Root(attributeName),
@@ -162,7 +175,11 @@ func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 		diags.Append(fwschema.ValidateAttributeImplementation(ctx, attribute, req)...)
 	}
 
-	for blockName, block := range s.GetBlocks() {
+	blocks := s.GetBlocks()
+
+	for _, blockName := range fwschema.SortedNames(blocks) {
+		block := blocks[blockName]
+
 		req := fwschema.ValidateImplementationRequest{
 			Name: blockName,
 			Path: path.Root(blockName),
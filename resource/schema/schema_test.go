@@ -1062,6 +1062,19 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		"empty-schema": {
 			schema: schema.Schema{},
 		},
+		"version-negative": {
+			schema: schema.Schema{
+				Version: -1,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Version is -1, but must be greater than or equal to 0.",
+				),
+			},
+		},
 		"attribute-using-reserved-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
@@ -1078,6 +1091,46 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				),
 			},
 		},
+		"attributes-using-reserved-field-names-sorted": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"provider":   schema.StringAttribute{},
+					"count":      schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{},
+					"lifecycle":  schema.StringAttribute{},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Reserved Root Attribute/Block Name",
+					"When validating the resource or data source schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"count\" is a reserved root attribute/block name. "+
+						"This is to prevent practitioners from needing special Terraform configuration syntax.",
+				),
+				diag.NewErrorDiagnostic(
+					"Reserved Root Attribute/Block Name",
+					"When validating the resource or data source schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"depends_on\" is a reserved root attribute/block name. "+
+						"This is to prevent practitioners from needing special Terraform configuration syntax.",
+				),
+				diag.NewErrorDiagnostic(
+					"Reserved Root Attribute/Block Name",
+					"When validating the resource or data source schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"lifecycle\" is a reserved root attribute/block name. "+
+						"This is to prevent practitioners from needing special Terraform configuration syntax.",
+				),
+				diag.NewErrorDiagnostic(
+					"Reserved Root Attribute/Block Name",
+					"When validating the resource or data source schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"provider\" is a reserved root attribute/block name. "+
+						"This is to prevent practitioners from needing special Terraform configuration syntax.",
+				),
+			},
+		},
 		"block-using-reserved-field-name": {
 			schema: schema.Schema{
 				Blocks: map[string]schema.Block{
@@ -1252,6 +1305,44 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				),
 			},
 		},
+		"attribute-using-invalid-renamed-from": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Optional:    true,
+						RenamedFrom: "^",
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute/Block Name",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"^\" at schema path \"test\" is an invalid attribute/block name. "+
+						"Names must only contain lowercase alphanumeric characters (a-z, 0-9) and underscores (_).",
+				),
+			},
+		},
+		"attribute-using-renamed-from-self": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Optional:    true,
+						RenamedFrom: "test",
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" has a RenamedFrom field value matching the attribute name. "+
+						"RenamedFrom must be the previous name of the attribute in resource state.",
+				),
+			},
+		},
 		"attribute-with-validate-attribute-implementation-error": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Bool returns a builder for a schema.BoolAttribute.
+func Bool() AttributeBuilder[schema.BoolAttribute, validator.Bool, planmodifier.Bool, defaults.Bool] {
+	return AttributeBuilder[schema.BoolAttribute, validator.Bool, planmodifier.Bool, defaults.Bool]{
+		build: func(o attributeOptions[validator.Bool, planmodifier.Bool, defaults.Bool]) schema.BoolAttribute {
+			return schema.BoolAttribute{
+				Required:            o.required,
+				Optional:            o.optional,
+				Computed:            o.computed,
+				Sensitive:           o.sensitive,
+				Description:         o.description,
+				MarkdownDescription: o.markdownDescription,
+				DeprecationMessage:  o.deprecationMessage,
+				Validators:          o.validators,
+				PlanModifiers:       o.planModifiers,
+				Default:             o.defaultValue,
+			}
+		},
+	}
+}
+
+// Float32 returns a builder for a schema.Float32Attribute.
+func Float32() AttributeBuilder[schema.Float32Attribute, validator.Float32, planmodifier.Float32, defaults.Float32] {
+	return AttributeBuilder[schema.Float32Attribute, validator.Float32, planmodifier.Float32, defaults.Float32]{
+		build: func(o attributeOptions[validator.Float32, planmodifier.Float32, defaults.Float32]) schema.Float32Attribute {
+			return schema.Float32Attribute{
+				Required:            o.required,
+				Optional:            o.optional,
+				Computed:            o.computed,
+				Sensitive:           o.sensitive,
+				Description:         o.description,
+				MarkdownDescription: o.markdownDescription,
+				DeprecationMessage:  o.deprecationMessage,
+				Validators:          o.validators,
+				PlanModifiers:       o.planModifiers,
+				Default:             o.defaultValue,
+			}
+		},
+	}
+}
+
+// Float64 returns a builder for a schema.Float64Attribute.
+func Float64() AttributeBuilder[schema.Float64Attribute, validator.Float64, planmodifier.Float64, defaults.Float64] {
+	return AttributeBuilder[schema.Float64Attribute, validator.Float64, planmodifier.Float64, defaults.Float64]{
+		build: func(o attributeOptions[validator.Float64, planmodifier.Float64, defaults.Float64]) schema.Float64Attribute {
+			return schema.Float64Attribute{
+				Required:            o.required,
+				Optional:            o.optional,
+				Computed:            o.computed,
+				Sensitive:           o.sensitive,
+				Description:         o.description,
+				MarkdownDescription: o.markdownDescription,
+				DeprecationMessage:  o.deprecationMessage,
+				Validators:          o.validators,
+				PlanModifiers:       o.planModifiers,
+				Default:             o.defaultValue,
+			}
+		},
+	}
+}
+
+// Int32 returns a builder for an schema.Int32Attribute.
+func Int32() AttributeBuilder[schema.Int32Attribute, validator.Int32, planmodifier.Int32, defaults.Int32] {
+	return AttributeBuilder[schema.Int32Attribute, validator.Int32, planmodifier.Int32, defaults.Int32]{
+		build: func(o attributeOptions[validator.Int32, planmodifier.Int32, defaults.Int32]) schema.Int32Attribute {
+			return schema.Int32Attribute{
+				Required:            o.required,
+				Optional:            o.optional,
+				Computed:            o.computed,
+				Sensitive:           o.sensitive,
+				Description:         o.description,
+				MarkdownDescription: o.markdownDescription,
+				DeprecationMessage:  o.deprecationMessage,
+				Validators:          o.validators,
+				PlanModifiers:       o.planModifiers,
+				Default:             o.defaultValue,
+			}
+		},
+	}
+}
+
+// Int64 returns a builder for an schema.Int64Attribute.
+func Int64() AttributeBuilder[schema.Int64Attribute, validator.Int64, planmodifier.Int64, defaults.Int64] {
+	return AttributeBuilder[schema.Int64Attribute, validator.Int64, planmodifier.Int64, defaults.Int64]{
+		build: func(o attributeOptions[validator.Int64, planmodifier.Int64, defaults.Int64]) schema.Int64Attribute {
+			return schema.Int64Attribute{
+				Required:            o.required,
+				Optional:            o.optional,
+				Computed:            o.computed,
+				Sensitive:           o.sensitive,
+				Description:         o.description,
+				MarkdownDescription: o.markdownDescription,
+				DeprecationMessage:  o.deprecationMessage,
+				Validators:          o.validators,
+				PlanModifiers:       o.planModifiers,
+				Default:             o.defaultValue,
+			}
+		},
+	}
+}
+
+// Number returns a builder for a schema.NumberAttribute.
+func Number() AttributeBuilder[schema.NumberAttribute, validator.Number, planmodifier.Number, defaults.Number] {
+	return AttributeBuilder[schema.NumberAttribute, validator.Number, planmodifier.Number, defaults.Number]{
+		build: func(o attributeOptions[validator.Number, planmodifier.Number, defaults.Number]) schema.NumberAttribute {
+			return schema.NumberAttribute{
+				Required:            o.required,
+				Optional:            o.optional,
+				Computed:            o.computed,
+				Sensitive:           o.sensitive,
+				Description:         o.description,
+				MarkdownDescription: o.markdownDescription,
+				DeprecationMessage:  o.deprecationMessage,
+				Validators:          o.validators,
+				PlanModifiers:       o.planModifiers,
+				Default:             o.defaultValue,
+			}
+		},
+	}
+}
+
+// String returns a builder for a schema.StringAttribute.
+func String() AttributeBuilder[schema.StringAttribute, validator.String, planmodifier.String, defaults.String] {
+	return AttributeBuilder[schema.StringAttribute, validator.String, planmodifier.String, defaults.String]{
+		build: func(o attributeOptions[validator.String, planmodifier.String, defaults.String]) schema.StringAttribute {
+			return schema.StringAttribute{
+				Required:            o.required,
+				Optional:            o.optional,
+				Computed:            o.computed,
+				Sensitive:           o.sensitive,
+				Description:         o.description,
+				MarkdownDescription: o.markdownDescription,
+				DeprecationMessage:  o.deprecationMessage,
+				Validators:          o.validators,
+				PlanModifiers:       o.planModifiers,
+				Default:             o.defaultValue,
+			}
+		},
+	}
+}
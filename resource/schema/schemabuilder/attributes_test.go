@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemabuilder_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/schemabuilder"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.StringAttribute
+		expected  schema.StringAttribute
+	}{
+		"required": {
+			attribute: schemabuilder.String().Required().
+				Description("test description").
+				MarkdownDescription("test markdown description").
+				Validators(testvalidator.String{}).
+				Build(),
+			expected: schema.StringAttribute{
+				Required:            true,
+				Description:         "test description",
+				MarkdownDescription: "test markdown description",
+				Validators:          []validator.String{testvalidator.String{}},
+			},
+		},
+		"optional": {
+			attribute: schemabuilder.String().Optional().
+				DeprecationMessage("test deprecation").
+				Sensitive().
+				Build(),
+			expected: schema.StringAttribute{
+				Optional:           true,
+				Sensitive:          true,
+				DeprecationMessage: "test deprecation",
+			},
+		},
+		"optional-computed": {
+			attribute: schemabuilder.String().Optional().Computed().
+				Default(stringdefault.StaticString("test")).
+				PlanModifiers(stringplanmodifier.RequiresReplace()).
+				Build(),
+			expected: schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString("test"),
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+		},
+		"computed": {
+			attribute: schemabuilder.String().Computed().
+				PlanModifiers(stringplanmodifier.UseStateForUnknown()).
+				Build(),
+			expected: schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testCase.attribute, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInt64(t *testing.T) {
+	t.Parallel()
+
+	got := schemabuilder.Int64().Computed().Default(int64default.StaticInt64(1)).Build()
+	expected := schema.Int64Attribute{
+		Computed: true,
+		Default:  int64default.StaticInt64(1),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestBuilderCopies(t *testing.T) {
+	t.Parallel()
+
+	base := schemabuilder.String().Optional().PlanModifiers(stringplanmodifier.RequiresReplace())
+
+	first := base.PlanModifiers(stringplanmodifier.UseStateForUnknown()).Build()
+	second := base.Description("test").Build()
+
+	expectedFirst := schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	expectedSecond := schema.StringAttribute{
+		Optional:      true,
+		Description:   "test",
+		PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+	}
+
+	if diff := cmp.Diff(first, expectedFirst); diff != "" {
+		t.Errorf("unexpected first difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(second, expectedSecond); diff != "" {
+		t.Errorf("unexpected second difference: %s", diff)
+	}
+}
@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// attributeOptions contains the options collected by a builder, where V is
+// the validator type, P is the plan modifier type, and D is the default
+// value type of the attribute.
+type attributeOptions[V, P, D any] struct {
+	required            bool
+	optional            bool
+	computed            bool
+	sensitive           bool
+	description         string
+	markdownDescription string
+	deprecationMessage  string
+	validators          []V
+	planModifiers       []P
+	defaultValue        D
+}
+
+// buildFunc converts the collected options into the attribute type.
+type buildFunc[A schema.Attribute, V, P, D any] func(attributeOptions[V, P, D]) A
+
+// AttributeBuilder is the starting point for building an attribute. Choose
+// whether the attribute is Required, Optional, or Computed to set the
+// remaining options.
+type AttributeBuilder[A schema.Attribute, V, P, D any] struct {
+	build buildFunc[A, V, P, D]
+}
+
+// Required marks the attribute as required in configuration.
+func (b AttributeBuilder[A, V, P, D]) Required() RequiredAttributeBuilder[A, V, P, D] {
+	return RequiredAttributeBuilder[A, V, P, D]{
+		build:   b.build,
+		options: attributeOptions[V, P, D]{required: true},
+	}
+}
+
+// Optional marks the attribute as optional in configuration. Call Computed
+// on the result if the provider can also set the value.
+func (b AttributeBuilder[A, V, P, D]) Optional() OptionalAttributeBuilder[A, V, P, D] {
+	return OptionalAttributeBuilder[A, V, P, D]{
+		build:   b.build,
+		options: attributeOptions[V, P, D]{optional: true},
+	}
+}
+
+// Computed marks the attribute as only set by the provider.
+func (b AttributeBuilder[A, V, P, D]) Computed() ComputedAttributeBuilder[A, V, P, D] {
+	return ComputedAttributeBuilder[A, V, P, D]{
+		build:   b.build,
+		options: attributeOptions[V, P, D]{computed: true},
+	}
+}
+
+// RequiredAttributeBuilder builds a Required attribute. Required attributes
+// cannot be Computed or have a Default.
+type RequiredAttributeBuilder[A schema.Attribute, V, P, D any] struct {
+	build   buildFunc[A, V, P, D]
+	options attributeOptions[V, P, D]
+}
+
+// Build returns the attribute.
+func (b RequiredAttributeBuilder[A, V, P, D]) Build() A {
+	return b.build(b.options)
+}
+
+// DeprecationMessage sets the attribute DeprecationMessage.
+func (b RequiredAttributeBuilder[A, V, P, D]) DeprecationMessage(message string) RequiredAttributeBuilder[A, V, P, D] {
+	b.options.deprecationMessage = message
+	return b
+}
+
+// Description sets the attribute Description.
+func (b RequiredAttributeBuilder[A, V, P, D]) Description(description string) RequiredAttributeBuilder[A, V, P, D] {
+	b.options.description = description
+	return b
+}
+
+// MarkdownDescription sets the attribute MarkdownDescription.
+func (b RequiredAttributeBuilder[A, V, P, D]) MarkdownDescription(description string) RequiredAttributeBuilder[A, V, P, D] {
+	b.options.markdownDescription = description
+	return b
+}
+
+// PlanModifiers appends to the attribute PlanModifiers.
+func (b RequiredAttributeBuilder[A, V, P, D]) PlanModifiers(planModifiers ...P) RequiredAttributeBuilder[A, V, P, D] {
+	b.options.planModifiers = appendCopy(b.options.planModifiers, planModifiers...)
+	return b
+}
+
+// Sensitive marks the attribute as Sensitive.
+func (b RequiredAttributeBuilder[A, V, P, D]) Sensitive() RequiredAttributeBuilder[A, V, P, D] {
+	b.options.sensitive = true
+	return b
+}
+
+// Validators appends to the attribute Validators.
+func (b RequiredAttributeBuilder[A, V, P, D]) Validators(validators ...V) RequiredAttributeBuilder[A, V, P, D] {
+	b.options.validators = appendCopy(b.options.validators, validators...)
+	return b
+}
+
+// OptionalAttributeBuilder builds an Optional attribute. Call Computed to
+// allow the provider to set the value when it is not configured, which is
+// required to set a Default.
+type OptionalAttributeBuilder[A schema.Attribute, V, P, D any] struct {
+	build   buildFunc[A, V, P, D]
+	options attributeOptions[V, P, D]
+}
+
+// Build returns the attribute.
+func (b OptionalAttributeBuilder[A, V, P, D]) Build() A {
+	return b.build(b.options)
+}
+
+// Computed marks the attribute as also set by the provider.
+func (b OptionalAttributeBuilder[A, V, P, D]) Computed() OptionalComputedAttributeBuilder[A, V, P, D] {
+	b.options.computed = true
+
+	return OptionalComputedAttributeBuilder[A, V, P, D](b)
+}
+
+// DeprecationMessage sets the attribute DeprecationMessage.
+func (b OptionalAttributeBuilder[A, V, P, D]) DeprecationMessage(message string) OptionalAttributeBuilder[A, V, P, D] {
+	b.options.deprecationMessage = message
+	return b
+}
+
+// Description sets the attribute Description.
+func (b OptionalAttributeBuilder[A, V, P, D]) Description(description string) OptionalAttributeBuilder[A, V, P, D] {
+	b.options.description = description
+	return b
+}
+
+// MarkdownDescription sets the attribute MarkdownDescription.
+func (b OptionalAttributeBuilder[A, V, P, D]) MarkdownDescription(description string) OptionalAttributeBuilder[A, V, P, D] {
+	b.options.markdownDescription = description
+	return b
+}
+
+// PlanModifiers appends to the attribute PlanModifiers.
+func (b OptionalAttributeBuilder[A, V, P, D]) PlanModifiers(planModifiers ...P) OptionalAttributeBuilder[A, V, P, D] {
+	b.options.planModifiers = appendCopy(b.options.planModifiers, planModifiers...)
+	return b
+}
+
+// Sensitive marks the attribute as Sensitive.
+func (b OptionalAttributeBuilder[A, V, P, D]) Sensitive() OptionalAttributeBuilder[A, V, P, D] {
+	b.options.sensitive = true
+	return b
+}
+
+// Validators appends to the attribute Validators.
+func (b OptionalAttributeBuilder[A, V, P, D]) Validators(validators ...V) OptionalAttributeBuilder[A, V, P, D] {
+	b.options.validators = appendCopy(b.options.validators, validators...)
+	return b
+}
+
+// OptionalComputedAttributeBuilder builds an Optional and Computed
+// attribute.
+type OptionalComputedAttributeBuilder[A schema.Attribute, V, P, D any] struct {
+	build   buildFunc[A, V, P, D]
+	options attributeOptions[V, P, D]
+}
+
+// Build returns the attribute.
+func (b OptionalComputedAttributeBuilder[A, V, P, D]) Build() A {
+	return b.build(b.options)
+}
+
+// Default sets the attribute Default, which is used when the attribute is
+// not configured.
+func (b OptionalComputedAttributeBuilder[A, V, P, D]) Default(defaultValue D) OptionalComputedAttributeBuilder[A, V, P, D] {
+	b.options.defaultValue = defaultValue
+	return b
+}
+
+// DeprecationMessage sets the attribute DeprecationMessage.
+func (b OptionalComputedAttributeBuilder[A, V, P, D]) DeprecationMessage(message string) OptionalComputedAttributeBuilder[A, V, P, D] {
+	b.options.deprecationMessage = message
+	return b
+}
+
+// Description sets the attribute Description.
+func (b OptionalComputedAttributeBuilder[A, V, P, D]) Description(description string) OptionalComputedAttributeBuilder[A, V, P, D] {
+	b.options.description = description
+	return b
+}
+
+// MarkdownDescription sets the attribute MarkdownDescription.
+func (b OptionalComputedAttributeBuilder[A, V, P, D]) MarkdownDescription(description string) OptionalComputedAttributeBuilder[A, V, P, D] {
+	b.options.markdownDescription = description
+	return b
+}
+
+// PlanModifiers appends to the attribute PlanModifiers.
+func (b OptionalComputedAttributeBuilder[A, V, P, D]) PlanModifiers(planModifiers ...P) OptionalComputedAttributeBuilder[A, V, P, D] {
+	b.options.planModifiers = appendCopy(b.options.planModifiers, planModifiers...)
+	return b
+}
+
+// Sensitive marks the attribute as Sensitive.
+func (b OptionalComputedAttributeBuilder[A, V, P, D]) Sensitive() OptionalComputedAttributeBuilder[A, V, P, D] {
+	b.options.sensitive = true
+	return b
+}
+
+// Validators appends to the attribute Validators.
+func (b OptionalComputedAttributeBuilder[A, V, P, D]) Validators(validators ...V) OptionalComputedAttributeBuilder[A, V, P, D] {
+	b.options.validators = appendCopy(b.options.validators, validators...)
+	return b
+}
+
+// ComputedAttributeBuilder builds a Computed attribute which is only set by
+// the provider. Computed attributes cannot have Validators, since there is
+// no configuration value to validate.
+type ComputedAttributeBuilder[A schema.Attribute, V, P, D any] struct {
+	build   buildFunc[A, V, P, D]
+	options attributeOptions[V, P, D]
+}
+
+// Build returns the attribute.
+func (b ComputedAttributeBuilder[A, V, P, D]) Build() A {
+	return b.build(b.options)
+}
+
+// Default sets the attribute Default, which is used when planning the
+// creation of the resource.
+func (b ComputedAttributeBuilder[A, V, P, D]) Default(defaultValue D) ComputedAttributeBuilder[A, V, P, D] {
+	b.options.defaultValue = defaultValue
+	return b
+}
+
+// DeprecationMessage sets the attribute DeprecationMessage.
+func (b ComputedAttributeBuilder[A, V, P, D]) DeprecationMessage(message string) ComputedAttributeBuilder[A, V, P, D] {
+	b.options.deprecationMessage = message
+	return b
+}
+
+// Description sets the attribute Description.
+func (b ComputedAttributeBuilder[A, V, P, D]) Description(description string) ComputedAttributeBuilder[A, V, P, D] {
+	b.options.description = description
+	return b
+}
+
+// MarkdownDescription sets the attribute MarkdownDescription.
+func (b ComputedAttributeBuilder[A, V, P, D]) MarkdownDescription(description string) ComputedAttributeBuilder[A, V, P, D] {
+	b.options.markdownDescription = description
+	return b
+}
+
+// PlanModifiers appends to the attribute PlanModifiers.
+func (b ComputedAttributeBuilder[A, V, P, D]) PlanModifiers(planModifiers ...P) ComputedAttributeBuilder[A, V, P, D] {
+	b.options.planModifiers = appendCopy(b.options.planModifiers, planModifiers...)
+	return b
+}
+
+// Sensitive marks the attribute as Sensitive.
+func (b ComputedAttributeBuilder[A, V, P, D]) Sensitive() ComputedAttributeBuilder[A, V, P, D] {
+	b.options.sensitive = true
+	return b
+}
+
+// appendCopy appends to a copy of the slice, so builders which share a
+// common starting point do not share the underlying array.
+func appendCopy[T any](s []T, elems ...T) []T {
+	result := make([]T, 0, len(s)+len(elems))
+	result = append(result, s...)
+
+	return append(result, elems...)
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schemabuilder provides a fluent, generics-based alternative to
+// declaring resource schema attributes with struct literals, such as:
+//
+//	schemabuilder.String().Required().Description("...").Build()
+//
+// Each builder method returns a builder type which only exposes the options
+// that are valid at that point, so invalid combinations, such as a Required
+// and Computed attribute or a Default on an attribute which is not Computed,
+// are rejected at compile time rather than by schema validation at runtime.
+//
+// The Build method returns the same attribute type as the equivalent struct
+// literal, such as schema.StringAttribute, which can be further customized
+// with options this package does not cover, such as CustomType.
+package schemabuilder
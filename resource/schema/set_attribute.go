@@ -21,11 +21,13 @@ import (
 
 // Ensure the implementation satisfies the desired interfaces.
 var (
-	_ Attribute                                    = SetAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = SetAttribute{}
-	_ fwschema.AttributeWithSetDefaultValue        = SetAttribute{}
-	_ fwxschema.AttributeWithSetPlanModifiers      = SetAttribute{}
-	_ fwxschema.AttributeWithSetValidators         = SetAttribute{}
+	_ Attribute                                      = SetAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = SetAttribute{}
+	_ fwschema.AttributeWithSetDefaultValue          = SetAttribute{}
+	_ fwxschema.AttributeWithSetPlanModifiers        = SetAttribute{}
+	_ fwxschema.AttributeWithSetValidators           = SetAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = SetAttribute{}
+	_ fwschema.AttributeWithRenamedFrom              = SetAttribute{}
 )
 
 // SetAttribute represents a schema attribute that is a set with a single
@@ -130,6 +132,9 @@ type SetAttribute struct {
 	//
 	DeprecationMessage string
 
+	// RenamedFrom is the previous state name of this attribute, as described in the package documentation.
+	RenamedFrom string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -142,6 +147,11 @@ type SetAttribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Set
 
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -189,6 +199,11 @@ func (a SetAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetRenamedFrom returns the RenamedFrom field value.
+func (a SetAttribute) GetRenamedFrom() string {
+	return a.RenamedFrom
+}
+
 // GetDescription returns the Description field value.
 func (a SetAttribute) GetDescription() string {
 	return a.Description
@@ -245,6 +260,11 @@ func (a SetAttribute) SetValidators() []validator.Set {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a SetAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
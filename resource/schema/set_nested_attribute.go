@@ -22,11 +22,13 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ NestedAttribute                              = SetNestedAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = SetNestedAttribute{}
-	_ fwschema.AttributeWithSetDefaultValue        = SetNestedAttribute{}
-	_ fwxschema.AttributeWithSetPlanModifiers      = SetNestedAttribute{}
-	_ fwxschema.AttributeWithSetValidators         = SetNestedAttribute{}
+	_ NestedAttribute                                = SetNestedAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = SetNestedAttribute{}
+	_ fwschema.AttributeWithSetDefaultValue          = SetNestedAttribute{}
+	_ fwxschema.AttributeWithSetPlanModifiers        = SetNestedAttribute{}
+	_ fwxschema.AttributeWithSetValidators           = SetNestedAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = SetNestedAttribute{}
+	_ fwschema.AttributeWithRenamedFrom              = SetNestedAttribute{}
 )
 
 // SetNestedAttribute represents an attribute that is a set of objects where
@@ -137,6 +139,9 @@ type SetNestedAttribute struct {
 	//
 	DeprecationMessage string
 
+	// RenamedFrom is the previous state name of this attribute, as described in the package documentation.
+	RenamedFrom string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -149,6 +154,11 @@ type SetNestedAttribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Set
 
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -204,6 +214,11 @@ func (a SetNestedAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetRenamedFrom returns the RenamedFrom field value.
+func (a SetNestedAttribute) GetRenamedFrom() string {
+	return a.RenamedFrom
+}
+
 // GetDescription returns the Description field value.
 func (a SetNestedAttribute) GetDescription() string {
 	return a.Description
@@ -270,6 +285,11 @@ func (a SetNestedAttribute) SetValidators() []validator.Set {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a SetNestedAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
@@ -21,11 +21,13 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ NestedAttribute                              = SingleNestedAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = SingleNestedAttribute{}
-	_ fwschema.AttributeWithObjectDefaultValue     = SingleNestedAttribute{}
-	_ fwxschema.AttributeWithObjectPlanModifiers   = SingleNestedAttribute{}
-	_ fwxschema.AttributeWithObjectValidators      = SingleNestedAttribute{}
+	_ NestedAttribute                                = SingleNestedAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = SingleNestedAttribute{}
+	_ fwschema.AttributeWithObjectDefaultValue       = SingleNestedAttribute{}
+	_ fwxschema.AttributeWithObjectPlanModifiers     = SingleNestedAttribute{}
+	_ fwxschema.AttributeWithObjectValidators        = SingleNestedAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = SingleNestedAttribute{}
+	_ fwschema.AttributeWithRenamedFrom              = SingleNestedAttribute{}
 )
 
 // SingleNestedAttribute represents an attribute that is a single object where
@@ -131,6 +133,9 @@ type SingleNestedAttribute struct {
 	//
 	DeprecationMessage string
 
+	// RenamedFrom is the previous state name of this attribute, as described in the package documentation.
+	RenamedFrom string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -143,6 +148,11 @@ type SingleNestedAttribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Object
 
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -209,6 +219,11 @@ func (a SingleNestedAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetRenamedFrom returns the RenamedFrom field value.
+func (a SingleNestedAttribute) GetRenamedFrom() string {
+	return a.RenamedFrom
+}
+
 // GetDescription returns the Description field value.
 func (a SingleNestedAttribute) GetDescription() string {
 	return a.Description
@@ -286,6 +301,11 @@ func (a SingleNestedAttribute) ObjectValidators() []validator.Object {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a SingleNestedAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
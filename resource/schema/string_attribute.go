@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/transformer"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -20,11 +21,14 @@ import (
 
 // Ensure the implementation satisfies the desired interfaces.
 var (
-	_ Attribute                                    = StringAttribute{}
-	_ fwschema.AttributeWithValidateImplementation = StringAttribute{}
-	_ fwschema.AttributeWithStringDefaultValue     = StringAttribute{}
-	_ fwxschema.AttributeWithStringPlanModifiers   = StringAttribute{}
-	_ fwxschema.AttributeWithStringValidators      = StringAttribute{}
+	_ Attribute                                      = StringAttribute{}
+	_ fwschema.AttributeWithValidateImplementation   = StringAttribute{}
+	_ fwschema.AttributeWithStringDefaultValue       = StringAttribute{}
+	_ fwxschema.AttributeWithStringPlanModifiers     = StringAttribute{}
+	_ fwxschema.AttributeWithStringValidators        = StringAttribute{}
+	_ fwxschema.AttributeWithValidatorsUnknownPolicy = StringAttribute{}
+	_ fwschema.AttributeWithRenamedFrom              = StringAttribute{}
+	_ fwschema.AttributeWithStringConfigTransformers = StringAttribute{}
 )
 
 // StringAttribute represents a schema attribute that is a string. When
@@ -116,6 +120,9 @@ type StringAttribute struct {
 	//
 	DeprecationMessage string
 
+	// RenamedFrom is the previous state name of this attribute, as described in the package documentation.
+	RenamedFrom string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -128,6 +135,24 @@ type StringAttribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.String
 
+	// ValidatorsUnknownPolicy controls whether Validators are called when the
+	// configuration value is unknown. By default, validators are called and
+	// are responsible for handling unknown values.
+	ValidatorsUnknownPolicy validator.UnknownPolicy
+
+	// ConfigTransformers define transformations of the configuration value
+	// when provider logic reads the configuration, such as with the
+	// tfsdk.Config type Get and GetAttribute methods. All elements of the
+	// slice are run in order, each receiving the value returned by the
+	// previous transformer, until a transformer returns an error diagnostic.
+	// Null and unknown values are not transformed. The transformers run once
+	// for each configuration in an RPC.
+	//
+	// Transformed values are never sent to Terraform, therefore validators,
+	// plan modifiers, and the plan and state are unaffected. Plan and state
+	// values are not transformed, so they can be written back unchanged.
+	ConfigTransformers []transformer.String
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -175,6 +200,11 @@ func (a StringAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetRenamedFrom returns the RenamedFrom field value.
+func (a StringAttribute) GetRenamedFrom() string {
+	return a.RenamedFrom
+}
+
 // GetDescription returns the Description field value.
 func (a StringAttribute) GetDescription() string {
 	return a.Description
@@ -224,11 +254,21 @@ func (a StringAttribute) StringPlanModifiers() []planmodifier.String {
 	return a.PlanModifiers
 }
 
+// StringConfigTransformers returns the ConfigTransformers field value.
+func (a StringAttribute) StringConfigTransformers() []transformer.String {
+	return a.ConfigTransformers
+}
+
 // StringValidators returns the Validators field value.
 func (a StringAttribute) StringValidators() []validator.String {
 	return a.Validators
 }
 
+// GetValidatorsUnknownPolicy returns the ValidatorsUnknownPolicy field value.
+func (a StringAttribute) GetValidatorsUnknownPolicy() validator.UnknownPolicy {
+	return a.ValidatorsUnknownPolicy
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DerivedFrom returns a plan modifier that sets the planned value to the
+// result of the given function, which computes it from the planned values of
+// other attributes, such as deriving an identifier from a name and region.
+// The path expressions are merged with the attribute path, so relative
+// expressions such as path.MatchRelative().AtParent().AtName("name") can be
+// used to refer to sibling attributes.
+//
+// The plan modifier:
+//
+//   - Does nothing on resource destroy or when there is a configuration value.
+//   - Sets the planned value to unknown if any matched value is unknown or,
+//     for values such as lists and objects, contains unknown values.
+//   - Otherwise, sets the planned value to the function result.
+//
+// This plan modifier is intended for Computed String attributes. Since the
+// derived value is known during planning, it is not marked unknown when other
+// attributes change, unlike the framework default for Computed attributes.
+// The matched attributes can be of any type, however there is no equivalent
+// plan modifier for deriving values of other attribute types.
+func DerivedFrom(f DerivedFromFunc, description, markdownDescription string, expressions ...path.Expression) planmodifier.String {
+	return derivedFromModifier{
+		derivedFunc:         f,
+		description:         description,
+		expressions:         expressions,
+		markdownDescription: markdownDescription,
+	}
+}
+
+// derivedFromModifier is a plan modifier that sets the planned value to the
+// result of a given function once all of its inputs are known.
+type derivedFromModifier struct {
+	derivedFunc         DerivedFromFunc
+	description         string
+	expressions         path.Expressions
+	markdownDescription string
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m derivedFromModifier) Description(_ context.Context) string {
+	return m.description
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m derivedFromModifier) MarkdownDescription(_ context.Context) string {
+	return m.markdownDescription
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m derivedFromModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing on resource destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing if there is a configuration value.
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	for _, expression := range req.PathExpression.MergeExpressions(m.expressions...) {
+		matchedPaths, diags := req.Plan.PathMatches(ctx, expression)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		for _, matchedPath := range matchedPaths {
+			var matchedValue attr.Value
+
+			diags := req.Plan.GetAttribute(ctx, matchedPath, &matchedValue)
+
+			resp.Diagnostics.Append(diags...)
+
+			if diags.HasError() {
+				return
+			}
+
+			// The derived value cannot be determined until all inputs are
+			// known, including any values nested in collections or objects.
+			if !isFullyKnown(matchedValue) {
+				resp.PlanValue = types.StringUnknown()
+
+				return
+			}
+		}
+	}
+
+	derivedFuncResp := &DerivedFromFuncResponse{
+		PlanValue: resp.PlanValue,
+	}
+
+	m.derivedFunc(ctx, req, derivedFuncResp)
+
+	resp.Diagnostics.Append(derivedFuncResp.Diagnostics...)
+
+	if derivedFuncResp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.PlanValue = derivedFuncResp.PlanValue
+}
+
+// fullyKnownValuable is implemented by value types which can contain other
+// values, such as collections, objects, and tuples.
+type fullyKnownValuable interface {
+	IsFullyKnown() bool
+}
+
+// isFullyKnown returns true if the given value is not unknown and, for values
+// which contain other values, all nested values are not unknown.
+func isFullyKnown(value attr.Value) bool {
+	if value.IsUnknown() {
+		return false
+	}
+
+	if v, ok := value.(fullyKnownValuable); ok {
+		return v.IsFullyKnown()
+	}
+
+	return true
+}
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DerivedFromFunc is a function used in the DerivedFrom plan modifier to
+// compute the planned value from other planned values. It is only called when
+// all values matched by the DerivedFrom path expressions are known, so they
+// can be safely read from the request Plan.
+type DerivedFromFunc func(context.Context, planmodifier.StringRequest, *DerivedFromFuncResponse)
+
+// DerivedFromFuncResponse is the response type for a DerivedFromFunc.
+type DerivedFromFuncResponse struct {
+	// Diagnostics report errors or warnings related to this logic. An empty
+	// or unset slice indicates success, with no warnings or errors generated.
+	Diagnostics diag.Diagnostics
+
+	// PlanValue is the derived value to set as the planned value.
+	PlanValue types.String
+}
@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDerivedFromModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"region": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testPlan := func(name, region tftypes.Value) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"arn":    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"name":   name,
+					"region": region,
+				},
+			),
+		}
+	}
+
+	testExpressions := []path.Expression{
+		path.MatchRelative().AtParent().AtName("name"),
+		path.MatchRelative().AtParent().AtName("region"),
+	}
+
+	testFunc := func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.DerivedFromFuncResponse) {
+		var name, region types.String
+
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("region"), &region)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.PlanValue = types.StringValue("arn:" + region.ValueString() + ":" + name.ValueString())
+	}
+
+	testCases := map[string]struct {
+		request     planmodifier.StringRequest
+		derivedFunc stringplanmodifier.DerivedFromFunc
+		expected    *planmodifier.StringResponse
+	}{
+		"plan-null": {
+			// resource destroy
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("arn"),
+				PathExpression: path.MatchRoot("arn"),
+				Plan:           nullPlan,
+				PlanValue:      types.StringNull(),
+			},
+			derivedFunc: func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.DerivedFromFuncResponse) {
+				resp.Diagnostics.AddError("test", "should never reach here")
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"config-value": {
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringValue("configured"),
+				Path:           path.Root("arn"),
+				PathExpression: path.MatchRoot("arn"),
+				Plan: testPlan(
+					tftypes.NewValue(tftypes.String, "test-name"),
+					tftypes.NewValue(tftypes.String, "test-region"),
+				),
+				PlanValue: types.StringValue("configured"),
+			},
+			derivedFunc: func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.DerivedFromFuncResponse) {
+				resp.Diagnostics.AddError("test", "should never reach here")
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("configured"),
+			},
+		},
+		"inputs-known": {
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("arn"),
+				PathExpression: path.MatchRoot("arn"),
+				Plan: testPlan(
+					tftypes.NewValue(tftypes.String, "test-name"),
+					tftypes.NewValue(tftypes.String, "test-region"),
+				),
+				PlanValue: types.StringUnknown(),
+			},
+			derivedFunc: testFunc,
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("arn:test-region:test-name"),
+			},
+		},
+		"inputs-known-prior-value": {
+			// plan value from prior state, such as when the resource has no
+			// other changes
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("arn"),
+				PathExpression: path.MatchRoot("arn"),
+				Plan: testPlan(
+					tftypes.NewValue(tftypes.String, "new-name"),
+					tftypes.NewValue(tftypes.String, "test-region"),
+				),
+				PlanValue: types.StringValue("arn:test-region:old-name"),
+			},
+			derivedFunc: testFunc,
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("arn:test-region:new-name"),
+			},
+		},
+		"inputs-unknown": {
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("arn"),
+				PathExpression: path.MatchRoot("arn"),
+				Plan: testPlan(
+					tftypes.NewValue(tftypes.String, "test-name"),
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				),
+				PlanValue: types.StringValue("arn:test-region:test-name"),
+			},
+			derivedFunc: func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.DerivedFromFuncResponse) {
+				resp.Diagnostics.AddError("test", "should never reach here")
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"func-diagnostics": {
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("arn"),
+				PathExpression: path.MatchRoot("arn"),
+				Plan: testPlan(
+					tftypes.NewValue(tftypes.String, "test-name"),
+					tftypes.NewValue(tftypes.String, "test-region"),
+				),
+				PlanValue: types.StringUnknown(),
+			},
+			derivedFunc: func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.DerivedFromFuncResponse) {
+				resp.Diagnostics.AddError("test summary", "test detail")
+				resp.PlanValue = types.StringValue("should not be set")
+			},
+			expected: &planmodifier.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test summary", "test detail"),
+				},
+				PlanValue: types.StringUnknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			stringplanmodifier.DerivedFrom(testCase.derivedFunc, "test", "test", testExpressions...).PlanModifyString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDerivedFromModifierPlanModifyString_partiallyUnknown(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+
+	request := planmodifier.StringRequest{
+		ConfigValue:    types.StringNull(),
+		Path:           path.Root("arn"),
+		PathExpression: path.MatchRoot("arn"),
+		Plan: tfsdk.Plan{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"arn": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"names": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "test-name"),
+						tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+				},
+			),
+		},
+		PlanValue: types.StringValue("arn:test-name"),
+	}
+
+	derivedFunc := func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.DerivedFromFuncResponse) {
+		resp.Diagnostics.AddError("test", "should never reach here")
+	}
+
+	expected := &planmodifier.StringResponse{
+		PlanValue: types.StringUnknown(),
+	}
+
+	resp := &planmodifier.StringResponse{
+		PlanValue: request.PlanValue,
+	}
+
+	stringplanmodifier.DerivedFrom(derivedFunc, "test", "test", path.MatchRelative().AtParent().AtName("names")).PlanModifyString(context.Background(), request, resp)
+
+	if diff := cmp.Diff(expected, resp); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// NormalizeCase returns a plan modifier that copies a known prior state value
+// into the planned value if the two values only differ by case, as determined
+// by strings.EqualFold. Use this for remote system values which are
+// case-insensitive, such as DNS names.
+//
+// See SuppressDiffIf for the Terraform requirements on the planned value.
+func NormalizeCase() planmodifier.String {
+	return SuppressDiffIf(
+		func(_ context.Context, req planmodifier.StringRequest, resp *SuppressDiffIfFuncResponse) {
+			resp.SuppressDiff = strings.EqualFold(req.PlanValue.ValueString(), req.StateValue.ValueString())
+		},
+		"Differences only in case will not change the value of this attribute in state.",
+		"Differences only in case will not change the value of this attribute in state.",
+	)
+}
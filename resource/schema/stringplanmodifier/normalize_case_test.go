@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeCaseModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  planmodifier.StringRequest
+		expected *planmodifier.StringResponse
+	}{
+		"null-state": {
+			request: planmodifier.StringRequest{
+				PlanValue:  types.StringValue("Example.com"),
+				StateValue: types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("Example.com"),
+			},
+		},
+		"unknown-plan": {
+			request: planmodifier.StringRequest{
+				PlanValue:  types.StringUnknown(),
+				StateValue: types.StringValue("example.com"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"case-difference": {
+			request: planmodifier.StringRequest{
+				PlanValue:  types.StringValue("Example.com"),
+				StateValue: types.StringValue("example.com"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("example.com"),
+			},
+		},
+		"value-difference": {
+			request: planmodifier.StringRequest{
+				PlanValue:  types.StringValue("Example.org"),
+				StateValue: types.StringValue("example.com"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("Example.org"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			stringplanmodifier.NormalizeCase().PlanModifyString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
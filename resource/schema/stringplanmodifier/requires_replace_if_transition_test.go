@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequiresReplaceIfTransitionModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"testattr": schema.StringAttribute{},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testPlan := func(value types.String) tfsdk.Plan {
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"testattr": tfValue,
+				},
+			),
+		}
+	}
+
+	testState := func(value types.String) tfsdk.State {
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"testattr": tfValue,
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		modifier planmodifier.String
+		request  planmodifier.StringRequest
+		expected *planmodifier.StringResponse
+	}{
+		"state-null": {
+			// resource creation
+			modifier: stringplanmodifier.RequiresReplaceIfTransition([]string{"a", "b"}, []string{"c"}),
+			request: planmodifier.StringRequest{
+				Plan:       testPlan(types.StringValue("c")),
+				PlanValue:  types.StringValue("c"),
+				State:      nullState,
+				StateValue: types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("c"),
+			},
+		},
+		"plan-null": {
+			// resource destroy
+			modifier: stringplanmodifier.RequiresReplaceIfTransition([]string{"a", "b"}, []string{"c"}),
+			request: planmodifier.StringRequest{
+				Plan:       nullPlan,
+				PlanValue:  types.StringNull(),
+				State:      testState(types.StringValue("a")),
+				StateValue: types.StringValue("a"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"planvalue-statevalue-equal": {
+			modifier: stringplanmodifier.RequiresReplaceIfTransition(nil, nil),
+			request: planmodifier.StringRequest{
+				Plan:       testPlan(types.StringValue("a")),
+				PlanValue:  types.StringValue("a"),
+				State:      testState(types.StringValue("a")),
+				StateValue: types.StringValue("a"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("a"),
+			},
+		},
+		"transition-matching": {
+			modifier: stringplanmodifier.RequiresReplaceIfTransition([]string{"a", "b"}, []string{"c"}),
+			request: planmodifier.StringRequest{
+				Plan:       testPlan(types.StringValue("c")),
+				PlanValue:  types.StringValue("c"),
+				State:      testState(types.StringValue("b")),
+				StateValue: types.StringValue("b"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue:       types.StringValue("c"),
+				RequiresReplace: true,
+			},
+		},
+		"transition-from-not-matching": {
+			modifier: stringplanmodifier.RequiresReplaceIfTransition([]string{"a", "b"}, []string{"c"}),
+			request: planmodifier.StringRequest{
+				Plan:       testPlan(types.StringValue("c")),
+				PlanValue:  types.StringValue("c"),
+				State:      testState(types.StringValue("d")),
+				StateValue: types.StringValue("d"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("c"),
+			},
+		},
+		"transition-to-not-matching": {
+			modifier: stringplanmodifier.RequiresReplaceIfTransition([]string{"a", "b"}, []string{"c"}),
+			request: planmodifier.StringRequest{
+				Plan:       testPlan(types.StringValue("d")),
+				PlanValue:  types.StringValue("d"),
+				State:      testState(types.StringValue("a")),
+				StateValue: types.StringValue("a"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("d"),
+			},
+		},
+		"transition-from-any": {
+			modifier: stringplanmodifier.RequiresReplaceIfTransition(nil, []string{"c"}),
+			request: planmodifier.StringRequest{
+				Plan:       testPlan(types.StringValue("c")),
+				PlanValue:  types.StringValue("c"),
+				State:      testState(types.StringValue("d")),
+				StateValue: types.StringValue("d"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue:       types.StringValue("c"),
+				RequiresReplace: true,
+			},
+		},
+		"planvalue-unknown": {
+			modifier: stringplanmodifier.RequiresReplaceIfTransition(nil, nil),
+			request: planmodifier.StringRequest{
+				Plan:       testPlan(types.StringUnknown()),
+				PlanValue:  types.StringUnknown(),
+				State:      testState(types.StringValue("a")),
+				StateValue: types.StringValue("a"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			testCase.modifier.PlanModifyString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRequiresReplaceIfTransitionModifierDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		modifier         planmodifier.String
+		expected         string
+		expectedMarkdown string
+	}{
+		"values": {
+			modifier:         stringplanmodifier.RequiresReplaceIfTransition([]string{"a", "b"}, []string{"c"}),
+			expected:         "If the value of this attribute changes from one of \"a\", \"b\" to \"c\", Terraform will destroy and recreate the resource.",
+			expectedMarkdown: "If the value of this attribute changes from one of `\"a\"`, `\"b\"` to `\"c\"`, Terraform will destroy and recreate the resource.",
+		},
+		"any": {
+			modifier:         stringplanmodifier.RequiresReplaceIfTransition(nil, nil),
+			expected:         "If the value of this attribute changes from any value to any value, Terraform will destroy and recreate the resource.",
+			expectedMarkdown: "If the value of this attribute changes from any value to any value, Terraform will destroy and recreate the resource.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testCase.expected, testCase.modifier.Description(context.Background())); diff != "" {
+				t.Errorf("unexpected description difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedMarkdown, testCase.modifier.MarkdownDescription(context.Background())); diff != "" {
+				t.Errorf("unexpected markdown description difference: %s", diff)
+			}
+		})
+	}
+}
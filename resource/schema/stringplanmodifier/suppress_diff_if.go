@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// SuppressDiffIf returns a plan modifier that conditionally copies the prior
+// state value into the planned value if:
+//
+//   - The plan and state values are both known and not null.
+//   - The plan and state values are not equal.
+//   - The given function returns true.
+//
+// Use this in place of terraform-plugin-sdk DiffSuppressFunc implementations.
+// Terraform accepts a planned value which differs from the configuration
+// value if it is equal to the non-null prior state value, whether or not the
+// attribute is Computed, which this plan modifier relies on. Resource logic
+// must save the planned value, rather than the configuration value, into the
+// new state, otherwise Terraform reports an inconsistent result after apply.
+func SuppressDiffIf(f SuppressDiffIfFunc, description, markdownDescription string) planmodifier.String {
+	return suppressDiffIfModifier{
+		ifFunc:              f,
+		description:         description,
+		markdownDescription: markdownDescription,
+	}
+}
+
+// suppressDiffIfModifier is an plan modifier that sets the planned value to
+// the prior state value if a given function is true.
+type suppressDiffIfModifier struct {
+	ifFunc              SuppressDiffIfFunc
+	description         string
+	markdownDescription string
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m suppressDiffIfModifier) Description(_ context.Context) string {
+	return m.description
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m suppressDiffIfModifier) MarkdownDescription(_ context.Context) string {
+	return m.markdownDescription
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m suppressDiffIfModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if there is no state value, such as resource creation.
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+
+	// Do nothing if there is no known planned value.
+	if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	// Do nothing if the plan and state values are equal.
+	if req.PlanValue.Equal(req.StateValue) {
+		return
+	}
+
+	ifFuncResp := &SuppressDiffIfFuncResponse{}
+
+	m.ifFunc(ctx, req, ifFuncResp)
+
+	resp.Diagnostics.Append(ifFuncResp.Diagnostics...)
+
+	if ifFuncResp.SuppressDiff {
+		resp.PlanValue = req.StateValue
+	}
+}
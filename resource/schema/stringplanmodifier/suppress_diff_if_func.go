@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// SuppressDiffIfFunc is a conditional function used in the SuppressDiffIf
+// plan modifier to determine whether the planned value is equivalent to the
+// prior state value.
+type SuppressDiffIfFunc func(context.Context, planmodifier.StringRequest, *SuppressDiffIfFuncResponse)
+
+// SuppressDiffIfFuncResponse is the response type for a SuppressDiffIfFunc.
+type SuppressDiffIfFuncResponse struct {
+	// Diagnostics report errors or warnings related to this logic. An empty
+	// or unset slice indicates success, with no warnings or errors generated.
+	Diagnostics diag.Diagnostics
+
+	// SuppressDiff should be enabled if the planned value is equivalent to
+	// the prior state value.
+	SuppressDiff bool
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSuppressDiffIfModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  planmodifier.StringRequest
+		ifFunc   stringplanmodifier.SuppressDiffIfFunc
+		expected *planmodifier.StringResponse
+	}{
+		"null-state": {
+			request: planmodifier.StringRequest{
+				PlanValue:  types.StringValue("test"),
+				StateValue: types.StringNull(),
+			},
+			ifFunc: func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.SuppressDiffIfFuncResponse) {
+				resp.SuppressDiff = true // should never reach here
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+		"null-plan": {
+			request: planmodifier.StringRequest{
+				PlanValue:  types.StringNull(),
+				StateValue: types.StringValue("test"),
+			},
+			ifFunc: func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.SuppressDiffIfFuncResponse) {
+				resp.SuppressDiff = true // should never reach here
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"unknown-plan": {
+			request: planmodifier.StringRequest{
+				PlanValue:  types.StringUnknown(),
+				StateValue: types.StringValue("test"),
+			},
+			ifFunc: func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.SuppressDiffIfFuncResponse) {
+				resp.SuppressDiff = true // should never reach here
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"planvalue-statevalue-equal": {
+			request: planmodifier.StringRequest{
+				PlanValue:  types.StringValue("test"),
+				StateValue: types.StringValue("test"),
+			},
+			ifFunc: func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.SuppressDiffIfFuncResponse) {
+				resp.Diagnostics.AddError("test", "should never reach here")
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+		"planvalue-statevalue-different-if-false": {
+			request: planmodifier.StringRequest{
+				PlanValue:  types.StringValue("test"),
+				StateValue: types.StringValue("other"),
+			},
+			ifFunc: func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.SuppressDiffIfFuncResponse) {
+				resp.SuppressDiff = false // should reach here
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+		"planvalue-statevalue-different-if-true": {
+			request: planmodifier.StringRequest{
+				PlanValue:  types.StringValue("test"),
+				StateValue: types.StringValue("other"),
+			},
+			ifFunc: func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.SuppressDiffIfFuncResponse) {
+				resp.SuppressDiff = true // should reach here
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("other"),
+			},
+		},
+		"diagnostics": {
+			request: planmodifier.StringRequest{
+				PlanValue:  types.StringValue("test"),
+				StateValue: types.StringValue("other"),
+			},
+			ifFunc: func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.SuppressDiffIfFuncResponse) {
+				resp.Diagnostics.AddWarning("test summary", "test detail")
+			},
+			expected: &planmodifier.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("test summary", "test detail"),
+				},
+				PlanValue: types.StringValue("test"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			stringplanmodifier.SuppressDiffIf(testCase.ifFunc, "test", "test").PlanModifyString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// SuppressWhitespaceDiff returns a plan modifier that copies a known prior
+// state value into the planned value if the two values only differ by
+// whitespace. Leading and trailing whitespace is ignored and any consecutive
+// whitespace characters are considered equal to a single space, as determined
+// by strings.Fields.
+//
+// See SuppressDiffIf for the Terraform requirements on the planned value.
+func SuppressWhitespaceDiff() planmodifier.String {
+	return SuppressDiffIf(
+		func(_ context.Context, req planmodifier.StringRequest, resp *SuppressDiffIfFuncResponse) {
+			resp.SuppressDiff = normalizeWhitespace(req.PlanValue.ValueString()) == normalizeWhitespace(req.StateValue.ValueString())
+		},
+		"Differences only in whitespace will not change the value of this attribute in state.",
+		"Differences only in whitespace will not change the value of this attribute in state.",
+	)
+}
+
+// normalizeWhitespace returns the given string with leading and trailing
+// whitespace removed and consecutive whitespace replaced by a single space.
+func normalizeWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
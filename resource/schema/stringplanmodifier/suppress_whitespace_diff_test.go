@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSuppressWhitespaceDiffModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  planmodifier.StringRequest
+		expected *planmodifier.StringResponse
+	}{
+		"null-state": {
+			request: planmodifier.StringRequest{
+				PlanValue:  types.StringValue(" test "),
+				StateValue: types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue(" test "),
+			},
+		},
+		"leading-trailing-whitespace": {
+			request: planmodifier.StringRequest{
+				PlanValue:  types.StringValue("  test value\n"),
+				StateValue: types.StringValue("test value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test value"),
+			},
+		},
+		"consecutive-whitespace": {
+			request: planmodifier.StringRequest{
+				PlanValue:  types.StringValue("test\t\tvalue"),
+				StateValue: types.StringValue("test value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test value"),
+			},
+		},
+		"removed-whitespace": {
+			request: planmodifier.StringRequest{
+				PlanValue:  types.StringValue("testvalue"),
+				StateValue: types.StringValue("test value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("testvalue"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			stringplanmodifier.SuppressWhitespaceDiff().PlanModifyString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
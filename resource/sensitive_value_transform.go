@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SensitiveValueTransformRequest represents a request for the provider to
+// encode or decode a sensitive attribute value. An instance of this request
+// struct is supplied as an argument to the ResourceWithSensitiveValueTransform
+// interface EncodeSensitiveValue and DecodeSensitiveValue methods.
+type SensitiveValueTransformRequest struct {
+	// Path is the schema-based path of the attribute.
+	Path path.Path
+
+	// Value is the known, non-null value of the attribute. It is the
+	// plaintext value for EncodeSensitiveValue and the stored value for
+	// DecodeSensitiveValue.
+	Value types.String
+}
+
+// SensitiveValueTransformResponse represents a response to a
+// SensitiveValueTransformRequest. An instance of this response struct is
+// supplied as an argument to the ResourceWithSensitiveValueTransform
+// interface EncodeSensitiveValue and DecodeSensitiveValue methods.
+type SensitiveValueTransformResponse struct {
+	// Value is the transformed value of the attribute. It is initially set
+	// to the request value.
+	Value types.String
+
+	// Diagnostics report errors or warnings related to transforming the
+	// value. An empty slice indicates success, with no warnings or errors
+	// generated.
+	Diagnostics diag.Diagnostics
+}
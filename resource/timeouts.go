@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"time"
+)
+
+// Timeouts are default operation timeouts for every resource in a provider,
+// set in the [provider.ConfigureResponse] ResourceTimeouts field. The
+// framework applies the effective timeout to the context of the resource
+// Create, Read, Update, and Delete methods as a deadline.
+//
+// A resource overrides a default timeout when its data has a known, non-empty
+// string value in the "timeouts" attribute or block for the operation, such
+// as the "create" attribute of the terraform-plugin-framework-timeouts module.
+// The value must be a valid time.ParseDuration string. Create and Update read
+// the value from the plan, while Read and Delete read it from the prior state.
+//
+// A zero duration means the operation has no default deadline.
+type Timeouts struct {
+	// Create is the default timeout of the Create method.
+	Create time.Duration
+
+	// Read is the default timeout of the Read method.
+	Read time.Duration
+
+	// Update is the default timeout of the Update method.
+	Update time.Duration
+
+	// Delete is the default timeout of the Delete method.
+	Delete time.Duration
+}
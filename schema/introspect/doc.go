@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package introspect provides access to the human-readable descriptions of
+// the validators and plan modifiers attached to schema attributes, such as
+// "value must be between 1 and 100", for provider tooling like documentation
+// generators.
+package introspect
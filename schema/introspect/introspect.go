@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package introspect
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+)
+
+// Description is the human-readable description of a validator or plan
+// modifier.
+type Description struct {
+	// Description is the plain text description, such as "value must be
+	// between 1 and 100".
+	Description string
+
+	// MarkdownDescription is the Markdown formatted description.
+	MarkdownDescription string
+}
+
+// describer is implemented by validators and plan modifiers.
+type describer interface {
+	Description(context.Context) string
+	MarkdownDescription(context.Context) string
+}
+
+// Validators returns the descriptions of the validators of the given schema
+// attribute, such as a datasource/schema, provider/schema, or
+// resource/schema StringAttribute, in validator order. Attributes without
+// validators return no descriptions.
+func Validators(ctx context.Context, attribute any) []Description {
+	switch a := attribute.(type) {
+	case fwxschema.AttributeWithBoolValidators:
+		return describe(ctx, a.BoolValidators())
+	case fwxschema.AttributeWithFloat32Validators:
+		return describe(ctx, a.Float32Validators())
+	case fwxschema.AttributeWithFloat64Validators:
+		return describe(ctx, a.Float64Validators())
+	case fwxschema.AttributeWithInt32Validators:
+		return describe(ctx, a.Int32Validators())
+	case fwxschema.AttributeWithInt64Validators:
+		return describe(ctx, a.Int64Validators())
+	case fwxschema.AttributeWithListValidators:
+		return describe(ctx, a.ListValidators())
+	case fwxschema.AttributeWithMapValidators:
+		return describe(ctx, a.MapValidators())
+	case fwxschema.AttributeWithNumberValidators:
+		return describe(ctx, a.NumberValidators())
+	case fwxschema.AttributeWithObjectValidators:
+		return describe(ctx, a.ObjectValidators())
+	case fwxschema.AttributeWithSetValidators:
+		return describe(ctx, a.SetValidators())
+	case fwxschema.AttributeWithStringValidators:
+		return describe(ctx, a.StringValidators())
+	case fwxschema.AttributeWithDynamicValidators:
+		return describe(ctx, a.DynamicValidators())
+	}
+
+	return nil
+}
+
+// PlanModifiers returns the descriptions of the plan modifiers of the given
+// resource/schema attribute, such as StringAttribute, in plan modifier
+// order. Attributes without plan modifiers return no descriptions.
+func PlanModifiers(ctx context.Context, attribute any) []Description {
+	switch a := attribute.(type) {
+	case fwxschema.AttributeWithBoolPlanModifiers:
+		return describe(ctx, a.BoolPlanModifiers())
+	case fwxschema.AttributeWithFloat32PlanModifiers:
+		return describe(ctx, a.Float32PlanModifiers())
+	case fwxschema.AttributeWithFloat64PlanModifiers:
+		return describe(ctx, a.Float64PlanModifiers())
+	case fwxschema.AttributeWithInt32PlanModifiers:
+		return describe(ctx, a.Int32PlanModifiers())
+	case fwxschema.AttributeWithInt64PlanModifiers:
+		return describe(ctx, a.Int64PlanModifiers())
+	case fwxschema.AttributeWithListPlanModifiers:
+		return describe(ctx, a.ListPlanModifiers())
+	case fwxschema.AttributeWithMapPlanModifiers:
+		return describe(ctx, a.MapPlanModifiers())
+	case fwxschema.AttributeWithNumberPlanModifiers:
+		return describe(ctx, a.NumberPlanModifiers())
+	case fwxschema.AttributeWithObjectPlanModifiers:
+		return describe(ctx, a.ObjectPlanModifiers())
+	case fwxschema.AttributeWithSetPlanModifiers:
+		return describe(ctx, a.SetPlanModifiers())
+	case fwxschema.AttributeWithStringPlanModifiers:
+		return describe(ctx, a.StringPlanModifiers())
+	case fwxschema.AttributeWithDynamicPlanModifiers:
+		return describe(ctx, a.DynamicPlanModifiers())
+	}
+
+	return nil
+}
+
+// describe returns the descriptions of the given validators or plan
+// modifiers. Nil values are skipped.
+func describe[T describer](ctx context.Context, describers []T) []Description {
+	if len(describers) == 0 {
+		return nil
+	}
+
+	descriptions := make([]Description, 0, len(describers))
+
+	for _, d := range describers {
+		if any(d) == nil {
+			continue
+		}
+
+		descriptions = append(descriptions, Description{
+			Description:         d.Description(ctx),
+			MarkdownDescription: d.MarkdownDescription(ctx),
+		})
+	}
+
+	return descriptions
+}
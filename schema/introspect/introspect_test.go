@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package introspect_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/introspect"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/int64validator"
+)
+
+func TestValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute any
+		expected  []introspect.Description
+	}{
+		"nil": {},
+		"no-validators": {
+			attribute: schema.StringAttribute{Required: true},
+		},
+		"datasource-validators": {
+			attribute: datasourceschema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeastAttribute(path.MatchRoot("minimum")),
+					int64validator.AtMostAttribute(path.MatchRoot("maximum")),
+				},
+			},
+			expected: []introspect.Description{
+				{
+					Description:         "value must be at least the value of minimum",
+					MarkdownDescription: "value must be at least the value of `minimum`",
+				},
+				{
+					Description:         "value must be at most the value of maximum",
+					MarkdownDescription: "value must be at most the value of `maximum`",
+				},
+			},
+		},
+		"resource-validators": {
+			attribute: schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					nil,
+					int64validator.AtLeastAttribute(path.MatchRoot("minimum")),
+				},
+			},
+			expected: []introspect.Description{
+				{
+					Description:         "value must be at least the value of minimum",
+					MarkdownDescription: "value must be at least the value of `minimum`",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := introspect.Validators(context.Background(), testCase.attribute)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanModifiers(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute any
+		expected  []introspect.Description
+	}{
+		"nil": {},
+		"datasource": {
+			attribute: datasourceschema.StringAttribute{Computed: true},
+		},
+		"no-plan-modifiers": {
+			attribute: schema.StringAttribute{Required: true},
+		},
+		"plan-modifiers": {
+			attribute: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, _ planmodifier.StringRequest, _ *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						},
+						"changes to the value require replacement",
+						"changes to the value require **replacement**",
+					),
+				},
+			},
+			expected: []introspect.Description{
+				{
+					Description:         "changes to the value require replacement",
+					MarkdownDescription: "changes to the value require **replacement**",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := introspect.PlanModifiers(context.Background(), testCase.attribute)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
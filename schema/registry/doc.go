@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package registry contains a registry of named schema definitions, such as
+// a common tags attribute or timeouts block, which large providers can share
+// across many data source and resource schemas to keep them consistent. The
+// registry works with the concept specific schema types, such as
+// datasource/schema and resource/schema Attribute and Block.
+//
+// For example:
+//
+//	var sharedAttributes registry.Registry[schema.Attribute]
+//
+//	func init() {
+//		sharedAttributes.MustRegister("tags", schema.MapAttribute{
+//			ElementType: types.StringType,
+//			Optional:    true,
+//		})
+//	}
+//
+//	func (r *ThingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+//		resp.Schema = schema.Schema{
+//			Attributes: map[string]schema.Attribute{
+//				"name": schema.StringAttribute{
+//					Required: true,
+//				},
+//				"tags": sharedAttributes.MustGet("tags"),
+//			},
+//		}
+//	}
+package registry
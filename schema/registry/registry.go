@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Registry contains named schema definitions of type T, such as
+// resource/schema Attribute or Block, which can be referenced by name from
+// many schemas. The zero value is an empty registry ready to use, and it is
+// safe for concurrent use.
+//
+// Definitions are intended to be registered during package initialization.
+// The MustRegister, MustGet, and MustDefinitions methods panic on invalid
+// usage, such as duplicate or unknown names, so mistakes are caught as soon
+// as any schema referencing the definition is built, such as in provider unit
+// tests. The Register, Get, and Definitions methods instead return an error,
+// boolean, or diagnostics respectively.
+type Registry[T any] struct {
+	mutex       sync.RWMutex
+	definitions map[string]T
+}
+
+// Register adds the definition with the given name. It returns an error if
+// the name is empty or already registered.
+func (r *Registry[T]) Register(name string, definition T) error {
+	if name == "" {
+		return errors.New("registry: definition name must not be empty")
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.definitions[name]; ok {
+		return fmt.Errorf("registry: definition %q is already registered", name)
+	}
+
+	if r.definitions == nil {
+		r.definitions = make(map[string]T)
+	}
+
+	r.definitions[name] = definition
+
+	return nil
+}
+
+// MustRegister adds the definition with the given name. It panics if the
+// name is empty or already registered.
+func (r *Registry[T]) MustRegister(name string, definition T) {
+	if err := r.Register(name, definition); err != nil {
+		panic(err.Error())
+	}
+}
+
+// Get returns the definition with the given name and true, or the zero value
+// of T and false if the name is not registered.
+func (r *Registry[T]) Get(name string) (T, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	definition, ok := r.definitions[name]
+
+	return definition, ok
+}
+
+// MustGet returns the definition with the given name. It panics if the name
+// is not registered.
+func (r *Registry[T]) MustGet(name string) T {
+	definition, ok := r.Get(name)
+
+	if !ok {
+		panic(r.notRegisteredMessage(name))
+	}
+
+	return definition
+}
+
+// Definitions returns a new map of the definitions with the given names,
+// keyed by name, which can be copied into the Attributes or Blocks of a
+// schema. An error diagnostic is returned for each name that is not
+// registered.
+func (r *Registry[T]) Definitions(names ...string) (map[string]T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := make(map[string]T, len(names))
+
+	for _, name := range names {
+		definition, ok := r.Get(name)
+
+		if !ok {
+			diags.AddError(
+				"Unknown Schema Definition",
+				"While building the schema, an unknown shared schema definition was referenced. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					r.notRegisteredMessage(name),
+			)
+
+			continue
+		}
+
+		result[name] = definition
+	}
+
+	return result, diags
+}
+
+// MustDefinitions returns a new map of the definitions with the given names,
+// keyed by name, which can be copied into the Attributes or Blocks of a
+// schema. It panics if any name is not registered.
+func (r *Registry[T]) MustDefinitions(names ...string) map[string]T {
+	result := make(map[string]T, len(names))
+
+	for _, name := range names {
+		result[name] = r.MustGet(name)
+	}
+
+	return result
+}
+
+// Names returns the sorted names of all registered definitions.
+func (r *Registry[T]) Names() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.namesLocked()
+}
+
+func (r *Registry[T]) namesLocked() []string {
+	names := make([]string, 0, len(r.definitions))
+
+	for name := range r.definitions {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// notRegisteredMessage returns the message for a name that is not
+// registered, including the registered names.
+func (r *Registry[T]) notRegisteredMessage(name string) string {
+	return fmt.Sprintf("registry: definition %q is not registered, expected one of: %s", name, strings.Join(r.Names(), ", "))
+}
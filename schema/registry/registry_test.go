@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package registry_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/registry"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	var attributes registry.Registry[schema.Attribute]
+
+	tags := schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+	id := schema.StringAttribute{
+		Computed: true,
+	}
+
+	attributes.MustRegister("tags", tags)
+
+	if err := attributes.Register("id", id); err != nil {
+		t.Fatalf("unexpected Register error: %s", err)
+	}
+
+	got, ok := attributes.Get("tags")
+
+	if !ok {
+		t.Fatal("expected Get to find registered definition")
+	}
+
+	if diff := cmp.Diff(got, schema.Attribute(tags)); diff != "" {
+		t.Errorf("unexpected Get difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(attributes.MustGet("tags"), schema.Attribute(tags)); diff != "" {
+		t.Errorf("unexpected MustGet difference: %s", diff)
+	}
+
+	expectedDefinitions := map[string]schema.Attribute{
+		"id":   id,
+		"tags": tags,
+	}
+
+	gotDefinitions, diags := attributes.Definitions("id", "tags")
+
+	if diags.HasError() {
+		t.Fatalf("unexpected Definitions diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(gotDefinitions, expectedDefinitions); diff != "" {
+		t.Errorf("unexpected Definitions difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(attributes.MustDefinitions("id", "tags"), expectedDefinitions); diff != "" {
+		t.Errorf("unexpected MustDefinitions difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(attributes.Names(), []string{"id", "tags"}); diff != "" {
+		t.Errorf("unexpected Names difference: %s", diff)
+	}
+}
+
+func TestRegistry_errors(t *testing.T) {
+	t.Parallel()
+
+	var blocks registry.Registry[schema.Block]
+
+	if err := blocks.Register("", schema.ListNestedBlock{}); err == nil || err.Error() != "registry: definition name must not be empty" {
+		t.Errorf("unexpected Register empty name error: %v", err)
+	}
+
+	blocks.MustRegister("one", schema.ListNestedBlock{})
+
+	if err := blocks.Register("one", schema.SetNestedBlock{}); err == nil || err.Error() != `registry: definition "one" is already registered` {
+		t.Errorf("unexpected Register duplicate error: %v", err)
+	}
+
+	if got, ok := blocks.Get("two"); ok || got != nil {
+		t.Errorf("unexpected Get result for unregistered definition: %v, %t", got, ok)
+	}
+
+	gotDefinitions, diags := blocks.Definitions("one", "two")
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Unknown Schema Definition",
+			"While building the schema, an unknown shared schema definition was referenced. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				`registry: definition "two" is not registered, expected one of: one`,
+		),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected Definitions diagnostics difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(gotDefinitions, map[string]schema.Block{"one": schema.ListNestedBlock{}}); diff != "" {
+		t.Errorf("unexpected Definitions difference: %s", diff)
+	}
+}
+
+func TestRegistry_panics(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		f        func(*registry.Registry[schema.Block])
+		expected string
+	}{
+		"MustRegister-empty-name": {
+			f: func(r *registry.Registry[schema.Block]) {
+				r.MustRegister("", schema.ListNestedBlock{})
+			},
+			expected: "registry: definition name must not be empty",
+		},
+		"MustRegister-duplicate": {
+			f: func(r *registry.Registry[schema.Block]) {
+				r.MustRegister("test", schema.ListNestedBlock{})
+				r.MustRegister("test", schema.SetNestedBlock{})
+			},
+			expected: `registry: definition "test" is already registered`,
+		},
+		"MustGet-unregistered": {
+			f: func(r *registry.Registry[schema.Block]) {
+				r.MustRegister("one", schema.ListNestedBlock{})
+				r.MustRegister("two", schema.ListNestedBlock{})
+				r.MustGet("three")
+			},
+			expected: `registry: definition "three" is not registered, expected one of: one, two`,
+		},
+		"MustDefinitions-unregistered": {
+			f: func(r *registry.Registry[schema.Block]) {
+				r.MustDefinitions("test")
+			},
+			expected: `registry: definition "test" is not registered, expected one of: `,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			defer func() {
+				got := recover()
+
+				if diff := cmp.Diff(got, testCase.expected); diff != "" {
+					t.Errorf("unexpected panic difference: %s", diff)
+				}
+			}()
+
+			testCase.f(&registry.Registry[schema.Block]{})
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transformer
+
+import (
+	"context"
+)
+
+// Describer is the common documentation interface for extensible schema
+// configuration transformer functionality.
+type Describer interface {
+	// Description should describe the transformation in plain text
+	// formatting. This information is used by provider logging and provider
+	// tooling such as documentation generation.
+	//
+	// The description should:
+	//  - Begin with a lowercase or other character suitable for the middle of
+	//    a sentence.
+	//  - End without punctuation.
+	//  - Avoid newlines. Prefer separate transformers instead.
+	//
+	// For example, "a leading ~ is expanded to the home directory".
+	Description(context.Context) string
+
+	// MarkdownDescription should describe the transformation in Markdown
+	// formatting. This information is used by provider logging and provider
+	// tooling such as documentation generation.
+	//
+	// The description should:
+	//  - Begin with a lowercase or other character suitable for the middle of
+	//    a sentence.
+	//  - End without punctuation.
+	//  - Avoid newlines. Prefer separate transformers instead.
+	//
+	// For example, "a leading `~` is expanded to the home directory".
+	MarkdownDescription(context.Context) string
+}
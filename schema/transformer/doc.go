@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package transformer contains common schema configuration transformer
+// interfaces. These transformers are used by concept specific packages such
+// as datasource/schema, provider/schema, and resource/schema.
+//
+// Configuration transformers modify configuration values when provider logic
+// reads configuration into Go types, such as with the tfsdk.Config type Get
+// and GetAttribute methods. Common use cases include expanding a leading ~
+// in file paths or resolving the contents of a file. Transformed values are
+// never sent to Terraform, so the configuration, plan, and state remain
+// unchanged.
+package transformer
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transformer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// String is a schema configuration transformer for types.String attributes.
+type String interface {
+	Describer
+
+	// TransformString should set the transformed value.
+	TransformString(context.Context, StringRequest, *StringResponse)
+}
+
+// StringRequest is a request for types.String schema configuration
+// transformation.
+type StringRequest struct {
+	// Path contains the path of the attribute for transformation. Use this
+	// path for any response diagnostics.
+	Path path.Path
+
+	// ConfigValue contains the value of the attribute for transformation,
+	// which is the configuration value or the value returned by the prior
+	// transformer. It is always known and not null.
+	ConfigValue types.String
+}
+
+// StringResponse is a response to a StringRequest.
+type StringResponse struct {
+	// Diagnostics report errors or warnings related to transforming the
+	// configuration value. An empty slice indicates success, with no warnings
+	// or errors generated.
+	Diagnostics diag.Diagnostics
+
+	// Value is the transformed value. It is initialized to the request
+	// ConfigValue.
+	Value types.String
+}
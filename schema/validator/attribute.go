@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validator
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// Attribute is a read-only view of the schema definition of an attribute,
+// which enables generic validators to inspect the attribute without separate
+// schema lookups.
+type Attribute interface {
+	// GetDeprecationMessage returns the deprecation message of the attribute.
+	GetDeprecationMessage() string
+
+	// GetDescription returns the plaintext description of the attribute.
+	GetDescription() string
+
+	// GetMarkdownDescription returns the Markdown description of the
+	// attribute.
+	GetMarkdownDescription() string
+
+	// GetType returns the type of the attribute.
+	GetType() attr.Type
+
+	// IsComputed returns true if the attribute is Computed.
+	IsComputed() bool
+
+	// IsOptional returns true if the attribute is Optional.
+	IsOptional() bool
+
+	// IsRequired returns true if the attribute is Required.
+	IsRequired() bool
+
+	// IsSensitive returns true if the attribute is Sensitive.
+	IsSensitive() bool
+}
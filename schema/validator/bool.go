@@ -30,6 +30,10 @@ type BoolRequest struct {
 	// of the attribute for validation.
 	PathExpression path.Expression
 
+	// Attribute contains the schema definition of the attribute for
+	// validation, such as whether it is Required, Optional, or Computed.
+	Attribute Attribute
+
 	// Config contains the entire configuration of the data source, provider, or resource.
 	Config tfsdk.Config
 
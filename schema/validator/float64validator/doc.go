@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package float64validator provides validators for types.Float64 attributes,
+// such as validators which compare against other attributes.
+package float64validator
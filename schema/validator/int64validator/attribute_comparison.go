@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ validator.Int64 = AttributeComparisonValidator{}
+
+// AtLeastAttribute returns a validator which ensures that the attribute value
+// is greater than or equal to the value of every attribute matching the given
+// path expression. Relative path expressions are resolved from the attribute
+// being validated, e.g. path.MatchRelative().AtParent().AtName("min") refers
+// to a sibling attribute named min.
+//
+// Validation is skipped if the attribute value is null or unknown. Matched
+// attribute values which are null or unknown are not compared.
+func AtLeastAttribute(expression path.Expression) AttributeComparisonValidator {
+	return AttributeComparisonValidator{
+		expression: expression,
+		atLeast:    true,
+	}
+}
+
+// AtMostAttribute returns a validator which ensures that the attribute value
+// is less than or equal to the value of every attribute matching the given
+// path expression. Relative path expressions are resolved from the attribute
+// being validated, e.g. path.MatchRelative().AtParent().AtName("max") refers
+// to a sibling attribute named max.
+//
+// Validation is skipped if the attribute value is null or unknown. Matched
+// attribute values which are null or unknown are not compared.
+func AtMostAttribute(expression path.Expression) AttributeComparisonValidator {
+	return AttributeComparisonValidator{
+		expression: expression,
+	}
+}
+
+// AttributeComparisonValidator is the validator returned by AtLeastAttribute
+// and AtMostAttribute.
+type AttributeComparisonValidator struct {
+	expression path.Expression
+	atLeast    bool
+}
+
+// Description returns a plaintext description of the validator.
+func (v AttributeComparisonValidator) Description(_ context.Context) string {
+	if v.atLeast {
+		return fmt.Sprintf("value must be at least the value of %s", v.expression)
+	}
+
+	return fmt.Sprintf("value must be at most the value of %s", v.expression)
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v AttributeComparisonValidator) MarkdownDescription(_ context.Context) string {
+	if v.atLeast {
+		return fmt.Sprintf("value must be at least the value of `%s`", v.expression)
+	}
+
+	return fmt.Sprintf("value must be at most the value of `%s`", v.expression)
+}
+
+// ValidateInt64 performs the validation.
+func (v AttributeComparisonValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	matchedPaths, diags := req.Config.PathMatches(ctx, req.PathExpression.Merge(v.expression))
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
+	}
+
+	for _, matchedPath := range matchedPaths {
+		// Ensure the attribute is not comparing against itself.
+		if matchedPath.Equal(req.Path) {
+			continue
+		}
+
+		var matchedValue attr.Value
+
+		diags := req.Config.GetAttribute(ctx, matchedPath, &matchedValue)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		// The comparison cannot be determined until the value is known.
+		if matchedValue.IsNull() || matchedValue.IsUnknown() {
+			continue
+		}
+
+		matchedValuable, ok := matchedValue.(basetypes.Int64Valuable)
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				matchedPath,
+				"Invalid Validator Usage",
+				"When validating the attribute, an implementation issue was found. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("The validator for %q requires the matched attribute to be an Int64 attribute, got: %T", req.Path, matchedValue),
+			)
+
+			return
+		}
+
+		matchedInt64, diags := matchedValuable.ToInt64Value(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		value := req.ConfigValue.ValueInt64()
+		otherValue := matchedInt64.ValueInt64()
+
+		if v.atLeast && value < otherValue {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute %s value must be at least the value of %s (%d), got: %d", req.Path, matchedPath, otherValue, value),
+			)
+		}
+
+		if !v.atLeast && value > otherValue {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute %s value must be at most the value of %s (%d), got: %d", req.Path, matchedPath, otherValue, value),
+			)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int64validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAttributeComparisonValidatorValidateInt64(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"min": schema.Int64Attribute{
+				Optional: true,
+			},
+			"max": schema.Int64Attribute{
+				Optional: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testConfig := func(minValue, maxValue tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"min":  minValue,
+					"max":  maxValue,
+					"name": tftypes.NewValue(tftypes.String, "test"),
+				},
+			),
+		}
+	}
+
+	testRequest := func(minValue, maxValue tftypes.Value, configValue types.Int64) validator.Int64Request {
+		return validator.Int64Request{
+			Config:         testConfig(minValue, maxValue),
+			ConfigValue:    configValue,
+			Path:           path.Root("max"),
+			PathExpression: path.MatchRoot("max"),
+		}
+	}
+
+	testCases := map[string]struct {
+		validator int64validator.AttributeComparisonValidator
+		request   validator.Int64Request
+		expected  *validator.Int64Response
+	}{
+		"at-least-valid": {
+			validator: int64validator.AtLeastAttribute(path.MatchRelative().AtParent().AtName("min")),
+			request: testRequest(
+				tftypes.NewValue(tftypes.Number, 1),
+				tftypes.NewValue(tftypes.Number, 2),
+				types.Int64Value(2),
+			),
+			expected: &validator.Int64Response{},
+		},
+		"at-least-equal": {
+			validator: int64validator.AtLeastAttribute(path.MatchRelative().AtParent().AtName("min")),
+			request: testRequest(
+				tftypes.NewValue(tftypes.Number, 2),
+				tftypes.NewValue(tftypes.Number, 2),
+				types.Int64Value(2),
+			),
+			expected: &validator.Int64Response{},
+		},
+		"at-least-invalid": {
+			validator: int64validator.AtLeastAttribute(path.MatchRelative().AtParent().AtName("min")),
+			request: testRequest(
+				tftypes.NewValue(tftypes.Number, 3),
+				tftypes.NewValue(tftypes.Number, 2),
+				types.Int64Value(2),
+			),
+			expected: &validator.Int64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("max"),
+						"Invalid Attribute Value",
+						"Attribute max value must be at least the value of min (3), got: 2",
+					),
+				},
+			},
+		},
+		"at-least-matched-null": {
+			validator: int64validator.AtLeastAttribute(path.MatchRelative().AtParent().AtName("min")),
+			request: testRequest(
+				tftypes.NewValue(tftypes.Number, nil),
+				tftypes.NewValue(tftypes.Number, 2),
+				types.Int64Value(2),
+			),
+			expected: &validator.Int64Response{},
+		},
+		"at-least-matched-unknown": {
+			validator: int64validator.AtLeastAttribute(path.MatchRelative().AtParent().AtName("min")),
+			request: testRequest(
+				tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+				tftypes.NewValue(tftypes.Number, 2),
+				types.Int64Value(2),
+			),
+			expected: &validator.Int64Response{},
+		},
+		"at-least-null": {
+			validator: int64validator.AtLeastAttribute(path.MatchRelative().AtParent().AtName("min")),
+			request: testRequest(
+				tftypes.NewValue(tftypes.Number, 3),
+				tftypes.NewValue(tftypes.Number, nil),
+				types.Int64Null(),
+			),
+			expected: &validator.Int64Response{},
+		},
+		"at-least-unknown": {
+			validator: int64validator.AtLeastAttribute(path.MatchRelative().AtParent().AtName("min")),
+			request: testRequest(
+				tftypes.NewValue(tftypes.Number, 3),
+				tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+				types.Int64Unknown(),
+			),
+			expected: &validator.Int64Response{},
+		},
+		"at-most-valid": {
+			validator: int64validator.AtMostAttribute(path.MatchRelative().AtParent().AtName("min")),
+			request: testRequest(
+				tftypes.NewValue(tftypes.Number, 3),
+				tftypes.NewValue(tftypes.Number, 2),
+				types.Int64Value(2),
+			),
+			expected: &validator.Int64Response{},
+		},
+		"at-most-invalid": {
+			validator: int64validator.AtMostAttribute(path.MatchRelative().AtParent().AtName("min")),
+			request: testRequest(
+				tftypes.NewValue(tftypes.Number, 1),
+				tftypes.NewValue(tftypes.Number, 2),
+				types.Int64Value(2),
+			),
+			expected: &validator.Int64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("max"),
+						"Invalid Attribute Value",
+						"Attribute max value must be at most the value of min (1), got: 2",
+					),
+				},
+			},
+		},
+		"self": {
+			validator: int64validator.AtMostAttribute(path.MatchRelative()),
+			request: testRequest(
+				tftypes.NewValue(tftypes.Number, 1),
+				tftypes.NewValue(tftypes.Number, 2),
+				types.Int64Value(2),
+			),
+			expected: &validator.Int64Response{},
+		},
+		"invalid-usage": {
+			validator: int64validator.AtMostAttribute(path.MatchRelative().AtParent().AtName("name")),
+			request: testRequest(
+				tftypes.NewValue(tftypes.Number, 1),
+				tftypes.NewValue(tftypes.Number, 2),
+				types.Int64Value(2),
+			),
+			expected: &validator.Int64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("name"),
+						"Invalid Validator Usage",
+						"When validating the attribute, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"The validator for \"max\" requires the matched attribute to be an Int64 attribute, got: basetypes.StringValue",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := &validator.Int64Response{}
+
+			testCase.validator.ValidateInt64(context.Background(), testCase.request, got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
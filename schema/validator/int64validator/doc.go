@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package int64validator provides validators for types.Int64 attributes,
+// such as validators which compare against other attributes.
+package int64validator
@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package listvalidator provides validators for types.List attributes and
+// list nested attributes, such as validators which treat the list elements
+// as a set of uniquely identified objects.
+package listvalidator
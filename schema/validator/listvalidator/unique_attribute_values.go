@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ validator.List = UniqueAttributeValuesValidator{}
+
+// UniqueAttributeValues returns a validator which ensures that no two
+// elements of a list of objects have the same value for the given object
+// attribute, such as a name identifying each rule of an ordered rule list.
+// The validator can be used with list nested attributes and list nested
+// blocks.
+//
+// Each duplicate is reported at the path of the duplicate element attribute,
+// such as rule[2].name, and the diagnostic refers to the path of the first
+// element with the same value. The value itself is not included, since it
+// may be sensitive.
+//
+// Validation is skipped if the list value is null or unknown. Elements and
+// attribute values which are null or unknown are not compared.
+func UniqueAttributeValues(attributeName string) UniqueAttributeValuesValidator {
+	return UniqueAttributeValuesValidator{
+		attributeName: attributeName,
+	}
+}
+
+// UniqueAttributeValuesValidator is the validator returned by
+// UniqueAttributeValues.
+type UniqueAttributeValuesValidator struct {
+	attributeName string
+}
+
+// Description returns a plaintext description of the validator.
+func (v UniqueAttributeValuesValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("list elements must have unique %q values", v.attributeName)
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v UniqueAttributeValuesValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("list elements must have unique `%s` values", v.attributeName)
+}
+
+// ValidateList performs the validation.
+func (v UniqueAttributeValuesValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// Indices of the first element with each distinct value, in order.
+	var seenIndices []int
+	var seenValues []attr.Value
+
+	for index, element := range req.ConfigValue.Elements() {
+		elementPath := req.Path.AtListIndex(index)
+
+		objectValuable, ok := element.(basetypes.ObjectValuable)
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				elementPath,
+				"Invalid Validator Usage",
+				"When validating the list, an implementation issue was found. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("The UniqueAttributeValues validator requires object elements, got: %T", element),
+			)
+
+			return
+		}
+
+		objectValue, diags := objectValuable.ToObjectValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		if objectValue.IsNull() || objectValue.IsUnknown() {
+			continue
+		}
+
+		value, ok := objectValue.Attributes()[v.attributeName]
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				elementPath,
+				"Invalid Validator Usage",
+				"When validating the list, an implementation issue was found. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("The UniqueAttributeValues validator attribute %q is not an attribute of the list element objects.", v.attributeName),
+			)
+
+			return
+		}
+
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		duplicate := false
+
+		for seenIndex, seenValue := range seenValues {
+			if !seenValue.Equal(value) {
+				continue
+			}
+
+			duplicate = true
+
+			resp.Diagnostics.AddAttributeError(
+				elementPath.AtName(v.attributeName),
+				"Duplicate List Element Value",
+				fmt.Sprintf("Each list element must have a unique %q value. ", v.attributeName)+
+					fmt.Sprintf("The value at %s is already used at %s.", elementPath.AtName(v.attributeName), req.Path.AtListIndex(seenIndices[seenIndex]).AtName(v.attributeName)),
+			)
+
+			break
+		}
+
+		if !duplicate {
+			seenIndices = append(seenIndices, index)
+			seenValues = append(seenValues, value)
+		}
+	}
+}
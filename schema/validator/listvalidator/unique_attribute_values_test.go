@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUniqueAttributeValuesValidatorValidateList(t *testing.T) {
+	t.Parallel()
+
+	testAttributeTypes := map[string]attr.Type{
+		"name":   types.StringType,
+		"action": types.StringType,
+	}
+
+	testObjectType := types.ObjectType{
+		AttrTypes: testAttributeTypes,
+	}
+
+	testObject := func(name types.String) attr.Value {
+		return types.ObjectValueMust(
+			testAttributeTypes,
+			map[string]attr.Value{
+				"name":   name,
+				"action": types.StringValue("allow"),
+			},
+		)
+	}
+
+	testCases := map[string]struct {
+		attributeName string
+		request       validator.ListRequest
+		expected      *validator.ListResponse
+	}{
+		"null": {
+			attributeName: "name",
+			request: validator.ListRequest{
+				ConfigValue:    types.ListNull(testObjectType),
+				Path:           path.Root("rule"),
+				PathExpression: path.MatchRoot("rule"),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"unknown": {
+			attributeName: "name",
+			request: validator.ListRequest{
+				ConfigValue:    types.ListUnknown(testObjectType),
+				Path:           path.Root("rule"),
+				PathExpression: path.MatchRoot("rule"),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"unique": {
+			attributeName: "name",
+			request: validator.ListRequest{
+				ConfigValue: types.ListValueMust(
+					testObjectType,
+					[]attr.Value{
+						testObject(types.StringValue("one")),
+						testObject(types.StringValue("two")),
+					},
+				),
+				Path:           path.Root("rule"),
+				PathExpression: path.MatchRoot("rule"),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"null-and-unknown-values": {
+			attributeName: "name",
+			request: validator.ListRequest{
+				ConfigValue: types.ListValueMust(
+					testObjectType,
+					[]attr.Value{
+						types.ObjectNull(testAttributeTypes),
+						types.ObjectNull(testAttributeTypes),
+						testObject(types.StringNull()),
+						testObject(types.StringNull()),
+						testObject(types.StringUnknown()),
+						testObject(types.StringUnknown()),
+					},
+				),
+				Path:           path.Root("rule"),
+				PathExpression: path.MatchRoot("rule"),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"duplicates": {
+			attributeName: "name",
+			request: validator.ListRequest{
+				ConfigValue: types.ListValueMust(
+					testObjectType,
+					[]attr.Value{
+						testObject(types.StringValue("one")),
+						testObject(types.StringValue("two")),
+						testObject(types.StringValue("one")),
+						testObject(types.StringValue("one")),
+					},
+				),
+				Path:           path.Root("rule"),
+				PathExpression: path.MatchRoot("rule"),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("rule").AtListIndex(2).AtName("name"),
+						"Duplicate List Element Value",
+						`Each list element must have a unique "name" value. The value at rule[2].name is already used at rule[0].name.`,
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("rule").AtListIndex(3).AtName("name"),
+						"Duplicate List Element Value",
+						`Each list element must have a unique "name" value. The value at rule[3].name is already used at rule[0].name.`,
+					),
+				},
+			},
+		},
+		"missing-attribute": {
+			attributeName: "missing",
+			request: validator.ListRequest{
+				ConfigValue: types.ListValueMust(
+					testObjectType,
+					[]attr.Value{
+						testObject(types.StringValue("one")),
+					},
+				),
+				Path:           path.Root("rule"),
+				PathExpression: path.MatchRoot("rule"),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("rule").AtListIndex(0),
+						"Invalid Validator Usage",
+						"When validating the list, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							`The UniqueAttributeValues validator attribute "missing" is not an attribute of the list element objects.`,
+					),
+				},
+			},
+		},
+		"non-object-elements": {
+			attributeName: "name",
+			request: validator.ListRequest{
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("one"),
+					},
+				),
+				Path:           path.Root("rule"),
+				PathExpression: path.MatchRoot("rule"),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("rule").AtListIndex(0),
+						"Invalid Validator Usage",
+						"When validating the list, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"The UniqueAttributeValues validator requires object elements, got: basetypes.StringValue",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := &validator.ListResponse{}
+
+			listvalidator.UniqueAttributeValues(testCase.attributeName).ValidateList(context.Background(), testCase.request, got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package mapvalidator provides validators for types.Map attributes and
+// map nested attributes, such as validators which apply validation to the
+// map keys separately from the map element values.
+package mapvalidator
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.Map = KeysAreValidator{}
+
+// KeysAre returns a validator which ensures that every key of a map value
+// passes all of the given string validators. The validator can be used with
+// map attributes and map nested attributes.
+//
+// Each map key is passed to the string validators as a known types.String
+// value. The request Path and PathExpression point at the map element with
+// that key, so any diagnostics refer to the offending key.
+//
+// Validation is skipped if the map value is null or unknown.
+func KeysAre(keyValidators ...validator.String) KeysAreValidator {
+	return KeysAreValidator{
+		keyValidators: keyValidators,
+	}
+}
+
+// KeysAreValidator is the validator returned by KeysAre.
+type KeysAreValidator struct {
+	keyValidators []validator.String
+}
+
+// Description returns a plaintext description of the validator.
+func (v KeysAreValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, keyValidator := range v.keyValidators {
+		descriptions = append(descriptions, keyValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("map keys must satisfy all validations: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v KeysAreValidator) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, keyValidator := range v.keyValidators {
+		descriptions = append(descriptions, keyValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("map keys must satisfy all validations: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateMap performs the validation.
+func (v KeysAreValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+	keys := make([]string, 0, len(elements))
+
+	for key := range elements {
+		keys = append(keys, key)
+	}
+
+	// Sort keys for deterministic diagnostics ordering.
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		keyRequest := validator.StringRequest{
+			Path:           req.Path.AtMapKey(key),
+			PathExpression: req.PathExpression.AtMapKey(key),
+			Config:         req.Config,
+			ConfigValue:    types.StringValue(key),
+		}
+
+		for _, keyValidator := range v.keyValidators {
+			keyResponse := &validator.StringResponse{}
+
+			keyValidator.ValidateString(ctx, keyRequest, keyResponse)
+
+			resp.Diagnostics.Append(keyResponse.Diagnostics...)
+		}
+	}
+}
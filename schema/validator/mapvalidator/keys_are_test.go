@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestKeysAreValidatorValidateMap(t *testing.T) {
+	t.Parallel()
+
+	testKeyValidator := testvalidator.String{
+		ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if len(req.ConfigValue.ValueString()) > 3 {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Invalid Map Key",
+					"Map key must be at most 3 characters, got: "+req.ConfigValue.ValueString(),
+				)
+			}
+		},
+	}
+
+	testCases := map[string]struct {
+		keyValidators []validator.String
+		request       validator.MapRequest
+		expected      *validator.MapResponse
+	}{
+		"null": {
+			keyValidators: []validator.String{testKeyValidator},
+			request: validator.MapRequest{
+				ConfigValue:    types.MapNull(types.StringType),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			},
+			expected: &validator.MapResponse{},
+		},
+		"unknown": {
+			keyValidators: []validator.String{testKeyValidator},
+			request: validator.MapRequest{
+				ConfigValue:    types.MapUnknown(types.StringType),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			},
+			expected: &validator.MapResponse{},
+		},
+		"valid": {
+			keyValidators: []validator.String{testKeyValidator},
+			request: validator.MapRequest{
+				ConfigValue: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{
+						"one": types.StringValue("value-does-not-matter"),
+						"two": types.StringUnknown(),
+					},
+				),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			},
+			expected: &validator.MapResponse{},
+		},
+		"invalid": {
+			keyValidators: []validator.String{testKeyValidator},
+			request: validator.MapRequest{
+				ConfigValue: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{
+						"one":   types.StringValue("value"),
+						"three": types.StringValue("value"),
+						"eight": types.StringValue("value"),
+					},
+				),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			},
+			expected: &validator.MapResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtMapKey("eight"),
+						"Invalid Map Key",
+						"Map key must be at most 3 characters, got: eight",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtMapKey("three"),
+						"Invalid Map Key",
+						"Map key must be at most 3 characters, got: three",
+					),
+				},
+			},
+		},
+		"invalid-map-nested": {
+			keyValidators: []validator.String{testKeyValidator},
+			request: validator.MapRequest{
+				ConfigValue: types.MapValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested": types.StringType,
+						},
+					},
+					map[string]attr.Value{
+						"four": types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested": types.StringValue("value"),
+							},
+						),
+					},
+				),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			},
+			expected: &validator.MapResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtMapKey("four"),
+						"Invalid Map Key",
+						"Map key must be at most 3 characters, got: four",
+					),
+				},
+			},
+		},
+		"path-expression": {
+			keyValidators: []validator.String{
+				testvalidator.String{
+					ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+						if !req.PathExpression.Equal(path.MatchRoot("test").AtMapKey("one")) {
+							resp.Diagnostics.AddError("Unexpected PathExpression", req.PathExpression.String())
+						}
+					},
+				},
+			},
+			request: validator.MapRequest{
+				ConfigValue: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{
+						"one": types.StringValue("value"),
+					},
+				),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			},
+			expected: &validator.MapResponse{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.MapResponse{}
+
+			mapvalidator.KeysAre(testCase.keyValidators...).ValidateMap(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package modelvalidator provides a validator which decodes configuration
+// data into a provider-defined Go type, using the framework reflection rules,
+// before calling provider-defined validation logic. This enables complex
+// validation logic to be written against typed models rather than
+// attr.Value.
+//
+// The validator implements the datasource.ConfigValidator,
+// provider.ConfigValidator, and resource.ConfigValidator interfaces for
+// validating the entire configuration, and the validator.Object interface for
+// validating object and nested attribute values.
+package modelvalidator
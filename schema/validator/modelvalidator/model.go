@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package modelvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ datasource.ConfigValidator = Validator[struct{}]{}
+	_ provider.ConfigValidator   = Validator[struct{}]{}
+	_ resource.ConfigValidator   = Validator[struct{}]{}
+	_ validator.Object           = Validator[struct{}]{}
+)
+
+// Func is the provider-defined validation logic for a Validator. The model
+// contains the configuration data decoded into the Go type T.
+type Func[T any] func(ctx context.Context, model T) diag.Diagnostics
+
+// Model returns a validator which decodes configuration data into the Go
+// type T, using the framework reflection rules, and calls the given function
+// with the result. T is typically a struct type with `tfsdk` field tags.
+//
+// Configuration data may contain unknown values, so T should use framework
+// value types, such as types.String, rather than Go built-in types for
+// any attributes which may be unknown, otherwise a decoding error diagnostic
+// is returned instead of calling the function.
+//
+// When used as an object attribute validator, the function is not called for
+// null or unknown object values and any returned diagnostics without a path
+// are associated with the object attribute path.
+func Model[T any](f Func[T], description, markdownDescription string) Validator[T] {
+	return Validator[T]{
+		f:                   f,
+		description:         description,
+		markdownDescription: markdownDescription,
+	}
+}
+
+// Validator is the validator returned by Model.
+type Validator[T any] struct {
+	f                   Func[T]
+	description         string
+	markdownDescription string
+}
+
+// Description returns a plaintext description of the validator.
+func (v Validator[T]) Description(_ context.Context) string {
+	return v.description
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v Validator[T]) MarkdownDescription(_ context.Context) string {
+	return v.markdownDescription
+}
+
+// ValidateDataSource performs the validation.
+func (v Validator[T]) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var model T
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.f(ctx, model)...)
+}
+
+// ValidateObject performs the validation.
+func (v Validator[T]) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var model T
+
+	resp.Diagnostics.Append(req.ConfigValue.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, d := range v.f(ctx, model) {
+		if _, ok := d.(diag.DiagnosticWithPath); !ok {
+			d = diag.WithPath(req.Path, d)
+		}
+
+		resp.Diagnostics.Append(d)
+	}
+}
+
+// ValidateProvider performs the validation.
+func (v Validator[T]) ValidateProvider(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var model T
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.f(ctx, model)...)
+}
+
+// ValidateResource performs the validation.
+func (v Validator[T]) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model T
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.f(ctx, model)...)
+}
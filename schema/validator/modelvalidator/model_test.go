@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package modelvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/modelvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testModel struct {
+	Min types.Int64 `tfsdk:"min"`
+	Max types.Int64 `tfsdk:"max"`
+}
+
+func testModelFunc(_ context.Context, model testModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if model.Min.IsUnknown() || model.Max.IsUnknown() {
+		return diags
+	}
+
+	if model.Min.ValueInt64() > model.Max.ValueInt64() {
+		diags.AddAttributeError(path.Root("min"), "Invalid Range", "min must be less than or equal to max")
+	}
+
+	if model.Max.ValueInt64() > 100 {
+		diags.AddError("Invalid Maximum", "max must be less than or equal to 100")
+	}
+
+	return diags
+}
+
+func TestValidatorValidateResource(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"min": schema.Int64Attribute{
+				Required: true,
+			},
+			"max": schema.Int64Attribute{
+				Required: true,
+			},
+		},
+	}
+
+	testConfig := func(minValue, maxValue tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"min": minValue,
+					"max": maxValue,
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		request  resource.ValidateConfigRequest
+		expected *resource.ValidateConfigResponse
+	}{
+		"valid": {
+			request: resource.ValidateConfigRequest{
+				Config: testConfig(
+					tftypes.NewValue(tftypes.Number, 1),
+					tftypes.NewValue(tftypes.Number, 2),
+				),
+			},
+			expected: &resource.ValidateConfigResponse{},
+		},
+		"unknown": {
+			request: resource.ValidateConfigRequest{
+				Config: testConfig(
+					tftypes.NewValue(tftypes.Number, 1),
+					tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+				),
+			},
+			expected: &resource.ValidateConfigResponse{},
+		},
+		"invalid": {
+			request: resource.ValidateConfigRequest{
+				Config: testConfig(
+					tftypes.NewValue(tftypes.Number, 1000),
+					tftypes.NewValue(tftypes.Number, 200),
+				),
+			},
+			expected: &resource.ValidateConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(path.Root("min"), "Invalid Range", "min must be less than or equal to max"),
+					diag.NewErrorDiagnostic("Invalid Maximum", "max must be less than or equal to 100"),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &resource.ValidateConfigResponse{}
+
+			modelvalidator.Model(testModelFunc, "test", "test").ValidateResource(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidatorValidateObject(t *testing.T) {
+	t.Parallel()
+
+	testAttrTypes := map[string]attr.Type{
+		"min": types.Int64Type,
+		"max": types.Int64Type,
+	}
+
+	testCases := map[string]struct {
+		request  validator.ObjectRequest
+		expected *validator.ObjectResponse
+	}{
+		"null": {
+			request: validator.ObjectRequest{
+				ConfigValue: types.ObjectNull(testAttrTypes),
+				Path:        path.Root("range"),
+			},
+			expected: &validator.ObjectResponse{},
+		},
+		"unknown": {
+			request: validator.ObjectRequest{
+				ConfigValue: types.ObjectUnknown(testAttrTypes),
+				Path:        path.Root("range"),
+			},
+			expected: &validator.ObjectResponse{},
+		},
+		"valid": {
+			request: validator.ObjectRequest{
+				ConfigValue: types.ObjectValueMust(
+					testAttrTypes,
+					map[string]attr.Value{
+						"min": types.Int64Value(1),
+						"max": types.Int64Value(2),
+					},
+				),
+				Path: path.Root("range"),
+			},
+			expected: &validator.ObjectResponse{},
+		},
+		"invalid": {
+			request: validator.ObjectRequest{
+				ConfigValue: types.ObjectValueMust(
+					testAttrTypes,
+					map[string]attr.Value{
+						"min": types.Int64Value(1),
+						"max": types.Int64Value(200),
+					},
+				),
+				Path: path.Root("range"),
+			},
+			expected: &validator.ObjectResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(path.Root("range"), "Invalid Maximum", "max must be less than or equal to 100"),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.ObjectResponse{}
+
+			modelvalidator.Model(testModelFunc, "test", "test").ValidateObject(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schemavalidator provides validators which are not dependent on the
+// attribute type, such as validators which compare against other attributes.
+// Each validator implements all schema validator interfaces, so it can be
+// used with any attribute in data source, provider, and resource schemas.
+package schemavalidator
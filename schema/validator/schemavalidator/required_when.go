@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemavalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var (
+	_ validator.Bool    = RequiredWhenValidator{}
+	_ validator.Dynamic = RequiredWhenValidator{}
+	_ validator.Float32 = RequiredWhenValidator{}
+	_ validator.Float64 = RequiredWhenValidator{}
+	_ validator.Int32   = RequiredWhenValidator{}
+	_ validator.Int64   = RequiredWhenValidator{}
+	_ validator.List    = RequiredWhenValidator{}
+	_ validator.Map     = RequiredWhenValidator{}
+	_ validator.Number  = RequiredWhenValidator{}
+	_ validator.Object  = RequiredWhenValidator{}
+	_ validator.Set     = RequiredWhenValidator{}
+	_ validator.String  = RequiredWhenValidator{}
+)
+
+// RequiredWhen returns a validator which ensures that the attribute is
+// configured when any attribute matching the given path expression is
+// configured with the given value. Relative path expressions are resolved
+// from the attribute being validated, e.g.
+// path.MatchRelative().AtParent().AtName("type") refers to a sibling
+// attribute named type.
+//
+// The given value is compared with the matched attribute values by their
+// Terraform values, so a base type value, such as types.StringValue("example"),
+// also matches attributes with a custom type based on types.String.
+//
+// Validation is skipped if a matched attribute value is unknown, since it is
+// not yet possible to determine whether the attribute is required. The
+// attribute configuration value itself being unknown satisfies the
+// requirement, as it will be known during apply.
+func RequiredWhen(expression path.Expression, value attr.Value) RequiredWhenValidator {
+	return RequiredWhenValidator{
+		expression: expression,
+		value:      value,
+	}
+}
+
+// RequiredWhenValidator is the validator returned by RequiredWhen.
+type RequiredWhenValidator struct {
+	expression path.Expression
+	value      attr.Value
+}
+
+// requiredWhenRequest is the type-independent request for the validator.
+type requiredWhenRequest struct {
+	Config         tfsdk.Config
+	ConfigValue    attr.Value
+	Path           path.Path
+	PathExpression path.Expression
+}
+
+// Description returns a plaintext description of the validator.
+func (v RequiredWhenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Value must be configured when %s is %s", v.expression, v.value)
+}
+
+// MarkdownDescription returns a Markdown description of the validator.
+func (v RequiredWhenValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Value must be configured when `%s` is `%s`", v.expression, v.value)
+}
+
+// validate performs the type-independent validation.
+func (v RequiredWhenValidator) validate(ctx context.Context, req requiredWhenRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !req.ConfigValue.IsNull() {
+		return diags
+	}
+
+	value, err := v.value.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddAttributeError(
+			req.Path,
+			"Invalid Validator Value",
+			"An unexpected error occurred converting the RequiredWhen validator value. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				err.Error(),
+		)
+
+		return diags
+	}
+
+	matchedPaths, matchedPathsDiags := req.Config.PathMatches(ctx, req.PathExpression.Merge(v.expression))
+
+	diags.Append(matchedPathsDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, matchedPath := range matchedPaths {
+		// Ensure the attribute is not comparing against itself.
+		if matchedPath.Equal(req.Path) {
+			continue
+		}
+
+		var matchedValue attr.Value
+
+		diags.Append(req.Config.GetAttribute(ctx, matchedPath, &matchedValue)...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		// The requirement cannot be determined until the value is known.
+		if matchedValue.IsUnknown() {
+			continue
+		}
+
+		matchedTerraformValue, err := matchedValue.ToTerraformValue(ctx)
+
+		if err != nil {
+			diags.AddAttributeError(
+				matchedPath,
+				"Invalid Attribute Value",
+				"An unexpected error occurred converting the attribute value. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					err.Error(),
+			)
+
+			return diags
+		}
+
+		if !matchedTerraformValue.Equal(value) {
+			continue
+		}
+
+		diags.AddAttributeError(
+			req.Path,
+			"Missing Required Attribute",
+			fmt.Sprintf("The attribute %q is required when %q is %s.", req.Path, matchedPath, v.value),
+		)
+	}
+
+	return diags
+}
+
+// ValidateBool performs the validation.
+func (v RequiredWhenValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, requiredWhenRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	})...)
+}
+
+// ValidateDynamic performs the validation.
+func (v RequiredWhenValidator) ValidateDynamic(ctx context.Context, req validator.DynamicRequest, resp *validator.DynamicResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, requiredWhenRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	})...)
+}
+
+// ValidateFloat32 performs the validation.
+func (v RequiredWhenValidator) ValidateFloat32(ctx context.Context, req validator.Float32Request, resp *validator.Float32Response) {
+	resp.Diagnostics.Append(v.validate(ctx, requiredWhenRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	})...)
+}
+
+// ValidateFloat64 performs the validation.
+func (v RequiredWhenValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	resp.Diagnostics.Append(v.validate(ctx, requiredWhenRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	})...)
+}
+
+// ValidateInt32 performs the validation.
+func (v RequiredWhenValidator) ValidateInt32(ctx context.Context, req validator.Int32Request, resp *validator.Int32Response) {
+	resp.Diagnostics.Append(v.validate(ctx, requiredWhenRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	})...)
+}
+
+// ValidateInt64 performs the validation.
+func (v RequiredWhenValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	resp.Diagnostics.Append(v.validate(ctx, requiredWhenRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	})...)
+}
+
+// ValidateList performs the validation.
+func (v RequiredWhenValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, requiredWhenRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	})...)
+}
+
+// ValidateMap performs the validation.
+func (v RequiredWhenValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, requiredWhenRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	})...)
+}
+
+// ValidateNumber performs the validation.
+func (v RequiredWhenValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, requiredWhenRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	})...)
+}
+
+// ValidateObject performs the validation.
+func (v RequiredWhenValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, requiredWhenRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	})...)
+}
+
+// ValidateSet performs the validation.
+func (v RequiredWhenValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, requiredWhenRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	})...)
+}
+
+// ValidateString performs the validation.
+func (v RequiredWhenValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, requiredWhenRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	})...)
+}
@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemavalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRequiredWhenValidatorValidateString(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Required: true,
+			},
+			"path": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testConfig := func(typeValue, pathValue tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"type": typeValue,
+					"path": pathValue,
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		expression path.Expression
+		value      attr.Value
+		request    validator.StringRequest
+		expected   *validator.StringResponse
+	}{
+		"configured": {
+			expression: path.MatchRelative().AtParent().AtName("type"),
+			value:      types.StringValue("http"),
+			request: validator.StringRequest{
+				Config: testConfig(
+					tftypes.NewValue(tftypes.String, "http"),
+					tftypes.NewValue(tftypes.String, "/"),
+				),
+				ConfigValue:    types.StringValue("/"),
+				Path:           path.Root("path"),
+				PathExpression: path.MatchRoot("path"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"unknown": {
+			expression: path.MatchRelative().AtParent().AtName("type"),
+			value:      types.StringValue("http"),
+			request: validator.StringRequest{
+				Config: testConfig(
+					tftypes.NewValue(tftypes.String, "http"),
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				),
+				ConfigValue:    types.StringUnknown(),
+				Path:           path.Root("path"),
+				PathExpression: path.MatchRoot("path"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"null-matched-value-equal": {
+			expression: path.MatchRelative().AtParent().AtName("type"),
+			value:      types.StringValue("http"),
+			request: validator.StringRequest{
+				Config: testConfig(
+					tftypes.NewValue(tftypes.String, "http"),
+					tftypes.NewValue(tftypes.String, nil),
+				),
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("path"),
+				PathExpression: path.MatchRoot("path"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("path"),
+						"Missing Required Attribute",
+						`The attribute "path" is required when "type" is "http".`,
+					),
+				},
+			},
+		},
+		"null-matched-value-not-equal": {
+			expression: path.MatchRelative().AtParent().AtName("type"),
+			value:      types.StringValue("http"),
+			request: validator.StringRequest{
+				Config: testConfig(
+					tftypes.NewValue(tftypes.String, "tcp"),
+					tftypes.NewValue(tftypes.String, nil),
+				),
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("path"),
+				PathExpression: path.MatchRoot("path"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"null-matched-value-unknown": {
+			expression: path.MatchRelative().AtParent().AtName("type"),
+			value:      types.StringValue("http"),
+			request: validator.StringRequest{
+				Config: testConfig(
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					tftypes.NewValue(tftypes.String, nil),
+				),
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("path"),
+				PathExpression: path.MatchRoot("path"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"null-absolute-expression": {
+			expression: path.MatchRoot("type"),
+			value:      types.StringValue("http"),
+			request: validator.StringRequest{
+				Config: testConfig(
+					tftypes.NewValue(tftypes.String, "http"),
+					tftypes.NewValue(tftypes.String, nil),
+				),
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("path"),
+				PathExpression: path.MatchRoot("path"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("path"),
+						"Missing Required Attribute",
+						`The attribute "path" is required when "type" is "http".`,
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+
+			schemavalidator.RequiredWhen(testCase.expression, testCase.value).ValidateString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRequiredWhenValidatorValidateString_customType(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				CustomType: testtypes.StringTypeWithSemanticEquals{},
+				Required:   true,
+			},
+			"path": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	request := validator.StringRequest{
+		Config: tfsdk.Config{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"type": tftypes.NewValue(tftypes.String, "http"),
+					"path": tftypes.NewValue(tftypes.String, nil),
+				},
+			),
+		},
+		ConfigValue:    types.StringNull(),
+		Path:           path.Root("path"),
+		PathExpression: path.MatchRoot("path"),
+	}
+
+	expected := &validator.StringResponse{
+		Diagnostics: diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				path.Root("path"),
+				"Missing Required Attribute",
+				`The attribute "path" is required when "type" is "http".`,
+			),
+		},
+	}
+
+	resp := &validator.StringResponse{}
+
+	schemavalidator.RequiredWhen(path.MatchRelative().AtParent().AtName("type"), types.StringValue("http")).ValidateString(context.Background(), request, resp)
+
+	if diff := cmp.Diff(expected, resp); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validator
+
+// UnknownPolicy controls how the framework calls the validators of an
+// attribute when its configuration value is unknown, such as a reference to
+// a value which is only known after apply.
+type UnknownPolicy int8
+
+const (
+	// UnknownPolicyDefault calls validators with unknown configuration
+	// values. Each validator is responsible for handling unknown values,
+	// which most validators skip.
+	UnknownPolicyDefault UnknownPolicy = 0
+
+	// UnknownPolicySkipWhenUnknown skips calling validators when the
+	// configuration value is unknown. Validators are called again once
+	// Terraform knows the value.
+	UnknownPolicySkipWhenUnknown UnknownPolicy = 1
+
+	// UnknownPolicyErrorWhenUnknown skips calling validators and raises an
+	// error diagnostic when the configuration value is unknown, for values
+	// which must be known when planning.
+	UnknownPolicyErrorWhenUnknown UnknownPolicy = 2
+)
+
+// String returns a string representation of the UnknownPolicy.
+func (p UnknownPolicy) String() string {
+	switch p {
+	case UnknownPolicyDefault:
+		return "Default"
+	case UnknownPolicySkipWhenUnknown:
+		return "SkipWhenUnknown"
+	case UnknownPolicyErrorWhenUnknown:
+		return "ErrorWhenUnknown"
+	}
+
+	return "Invalid"
+}
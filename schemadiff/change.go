@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemadiff
+
+import (
+	"fmt"
+)
+
+// SchemaKind describes which kind of schema contains a change.
+type SchemaKind string
+
+const (
+	// SchemaKindProvider is the provider configuration schema.
+	SchemaKindProvider SchemaKind = "provider"
+
+	// SchemaKindProviderMeta is the provider_meta schema.
+	SchemaKindProviderMeta SchemaKind = "provider_meta"
+
+	// SchemaKindResource is a managed resource schema.
+	SchemaKindResource SchemaKind = "resource"
+
+	// SchemaKindDataSource is a data source schema.
+	SchemaKindDataSource SchemaKind = "data source"
+)
+
+// Change is a single difference between two provider schemas.
+type Change struct {
+	// SchemaKind is the kind of schema containing the change.
+	SchemaKind SchemaKind
+
+	// SchemaName is the resource or data source type name containing the
+	// change. It is empty for the provider and provider_meta schemas.
+	SchemaName string
+
+	// Path is the location of the changed attribute or block within the
+	// schema, such as rule.action. It is empty if the change applies to the
+	// whole schema, such as a removed resource.
+	Path string
+
+	// Description is a human-readable description of the change.
+	Description string
+
+	// Breaking is true if the change may cause existing configurations or
+	// state to stop working.
+	Breaking bool
+}
+
+// String returns a human-readable representation of the change.
+func (c Change) String() string {
+	location := string(c.SchemaKind)
+
+	if c.SchemaName != "" {
+		location += " " + c.SchemaName
+	}
+
+	if c.Path != "" {
+		location += " " + c.Path
+	}
+
+	return fmt.Sprintf("%s: %s", location, c.Description)
+}
+
+// Changes is a collection of differences between two provider schemas.
+type Changes []Change
+
+// Breaking returns only the breaking changes.
+func (c Changes) Breaking() Changes {
+	var result Changes
+
+	for _, change := range c {
+		if change.Breaking {
+			result = append(result, change)
+		}
+	}
+
+	return result
+}
+
+// HasBreaking returns true if any change is breaking.
+func (c Changes) HasBreaking() bool {
+	for _, change := range c {
+		if change.Breaking {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemadiff_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schemadiff"
+)
+
+func TestChangeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		change   schemadiff.Change
+		expected string
+	}{
+		"provider": {
+			change: schemadiff.Change{
+				SchemaKind:  schemadiff.SchemaKindProvider,
+				Path:        "test",
+				Description: "attribute removed",
+			},
+			expected: "provider test: attribute removed",
+		},
+		"resource": {
+			change: schemadiff.Change{
+				SchemaKind:  schemadiff.SchemaKindResource,
+				SchemaName:  "test_resource",
+				Description: "removed",
+			},
+			expected: "resource test_resource: removed",
+		},
+		"resource-path": {
+			change: schemadiff.Change{
+				SchemaKind:  schemadiff.SchemaKindResource,
+				SchemaName:  "test_resource",
+				Path:        "rule.action",
+				Description: "attribute removed",
+			},
+			expected: "resource test_resource rule.action: attribute removed",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.change.String()
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestChangesBreaking(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		changes             schemadiff.Changes
+		expected            schemadiff.Changes
+		expectedHasBreaking bool
+	}{
+		"nil": {},
+		"non-breaking": {
+			changes: schemadiff.Changes{
+				{Description: "added"},
+			},
+		},
+		"mixed": {
+			changes: schemadiff.Changes{
+				{Description: "added"},
+				{Description: "removed", Breaking: true},
+			},
+			expected: schemadiff.Changes{
+				{Description: "removed", Breaking: true},
+			},
+			expectedHasBreaking: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testCase.changes.Breaking(), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if got := testCase.changes.HasBreaking(); got != testCase.expectedHasBreaking {
+				t.Errorf("expected HasBreaking %t, got %t", testCase.expectedHasBreaking, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemadiff
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// Compare returns the differences between the prior and current provider
+// schemas, such as the GetProviderSchema responses of two provider versions.
+// Changes are ordered by schema kind, then schema name, then path.
+//
+// The following changes are breaking:
+//
+//   - Removing a resource, data source, attribute, or block.
+//   - Decreasing a schema version.
+//   - Changing the type of an attribute or the nesting mode of a nested
+//     attribute or block.
+//   - Adding a required attribute or a block with a minimum number of items.
+//   - Making an attribute required, no longer configurable, or no longer
+//     computed.
+//   - Increasing the minimum or decreasing the maximum number of items of a
+//     block.
+func Compare(prior, current *tfprotov6.GetProviderSchemaResponse) Changes {
+	if prior == nil {
+		prior = &tfprotov6.GetProviderSchemaResponse{}
+	}
+
+	if current == nil {
+		current = &tfprotov6.GetProviderSchemaResponse{}
+	}
+
+	var changes Changes
+
+	changes = append(changes, compareSchema(SchemaKindProvider, "", prior.Provider, current.Provider)...)
+	changes = append(changes, compareSchema(SchemaKindProviderMeta, "", prior.ProviderMeta, current.ProviderMeta)...)
+	changes = append(changes, compareSchemas(SchemaKindResource, prior.ResourceSchemas, current.ResourceSchemas)...)
+	changes = append(changes, compareSchemas(SchemaKindDataSource, prior.DataSourceSchemas, current.DataSourceSchemas)...)
+
+	return changes
+}
+
+// compareSchemas compares the resource or data source schemas of two
+// provider versions.
+func compareSchemas(kind SchemaKind, prior, current map[string]*tfprotov6.Schema) Changes {
+	var changes Changes
+
+	for _, name := range sortedKeys(prior, current) {
+		priorSchema, priorOk := prior[name]
+		currentSchema, currentOk := current[name]
+
+		switch {
+		case !currentOk:
+			changes = append(changes, Change{
+				SchemaKind:  kind,
+				SchemaName:  name,
+				Description: "removed",
+				Breaking:    true,
+			})
+		case !priorOk:
+			changes = append(changes, Change{
+				SchemaKind:  kind,
+				SchemaName:  name,
+				Description: "added",
+			})
+		default:
+			changes = append(changes, compareSchema(kind, name, priorSchema, currentSchema)...)
+		}
+	}
+
+	return changes
+}
+
+// compareSchema compares a single schema of two provider versions. A nil
+// schema is treated as an empty schema.
+func compareSchema(kind SchemaKind, name string, prior, current *tfprotov6.Schema) Changes {
+	c := &comparer{
+		kind: kind,
+		name: name,
+	}
+
+	if prior == nil {
+		prior = &tfprotov6.Schema{}
+	}
+
+	if current == nil {
+		current = &tfprotov6.Schema{}
+	}
+
+	if current.Version < prior.Version {
+		c.add("", true, "schema version decreased from %d to %d", prior.Version, current.Version)
+	}
+
+	c.compareBlock("", prior.Block, current.Block)
+
+	return c.changes
+}
+
+// comparer accumulates the changes of a single schema.
+type comparer struct {
+	kind    SchemaKind
+	name    string
+	changes Changes
+}
+
+func (c *comparer) add(path string, breaking bool, format string, a ...any) {
+	c.changes = append(c.changes, Change{
+		SchemaKind:  c.kind,
+		SchemaName:  c.name,
+		Path:        path,
+		Description: fmt.Sprintf(format, a...),
+		Breaking:    breaking,
+	})
+}
+
+func (c *comparer) compareBlock(path string, prior, current *tfprotov6.SchemaBlock) {
+	if prior == nil {
+		prior = &tfprotov6.SchemaBlock{}
+	}
+
+	if current == nil {
+		current = &tfprotov6.SchemaBlock{}
+	}
+
+	c.compareAttributes(path, prior.Attributes, current.Attributes)
+
+	priorBlocks := make(map[string]*tfprotov6.SchemaNestedBlock, len(prior.BlockTypes))
+	currentBlocks := make(map[string]*tfprotov6.SchemaNestedBlock, len(current.BlockTypes))
+
+	for _, block := range prior.BlockTypes {
+		if block != nil {
+			priorBlocks[block.TypeName] = block
+		}
+	}
+
+	for _, block := range current.BlockTypes {
+		if block != nil {
+			currentBlocks[block.TypeName] = block
+		}
+	}
+
+	for _, name := range sortedKeys(priorBlocks, currentBlocks) {
+		priorBlock, priorOk := priorBlocks[name]
+		currentBlock, currentOk := currentBlocks[name]
+		blockPath := joinPath(path, name)
+
+		switch {
+		case !currentOk:
+			c.add(blockPath, true, "block removed")
+		case !priorOk:
+			if currentBlock.MinItems > 0 {
+				c.add(blockPath, true, "required block added")
+			} else {
+				c.add(blockPath, false, "block added")
+			}
+		default:
+			c.compareNestedBlock(blockPath, priorBlock, currentBlock)
+		}
+	}
+}
+
+func (c *comparer) compareNestedBlock(path string, prior, current *tfprotov6.SchemaNestedBlock) {
+	if prior.Nesting != current.Nesting {
+		c.add(path, true, "block nesting mode changed from %s to %s", prior.Nesting, current.Nesting)
+
+		return
+	}
+
+	if current.MinItems > prior.MinItems {
+		c.add(path, true, "block minimum items increased from %d to %d", prior.MinItems, current.MinItems)
+	}
+
+	if current.MaxItems > 0 && (prior.MaxItems == 0 || current.MaxItems < prior.MaxItems) {
+		c.add(path, true, "block maximum items decreased from %s to %d", maxItemsString(prior.MaxItems), current.MaxItems)
+	}
+
+	c.compareBlock(path, prior.Block, current.Block)
+}
+
+func (c *comparer) compareAttributes(path string, prior, current []*tfprotov6.SchemaAttribute) {
+	priorAttributes := make(map[string]*tfprotov6.SchemaAttribute, len(prior))
+	currentAttributes := make(map[string]*tfprotov6.SchemaAttribute, len(current))
+
+	for _, attribute := range prior {
+		if attribute != nil {
+			priorAttributes[attribute.Name] = attribute
+		}
+	}
+
+	for _, attribute := range current {
+		if attribute != nil {
+			currentAttributes[attribute.Name] = attribute
+		}
+	}
+
+	for _, name := range sortedKeys(priorAttributes, currentAttributes) {
+		priorAttribute, priorOk := priorAttributes[name]
+		currentAttribute, currentOk := currentAttributes[name]
+		attributePath := joinPath(path, name)
+
+		switch {
+		case !currentOk:
+			c.add(attributePath, true, "attribute removed")
+		case !priorOk:
+			if currentAttribute.Required {
+				c.add(attributePath, true, "required attribute added")
+			} else {
+				c.add(attributePath, false, "attribute added")
+			}
+		default:
+			c.compareAttribute(attributePath, priorAttribute, currentAttribute)
+		}
+	}
+}
+
+func (c *comparer) compareAttribute(path string, prior, current *tfprotov6.SchemaAttribute) {
+	switch {
+	case prior.NestedType != nil && current.NestedType != nil:
+		if prior.NestedType.Nesting != current.NestedType.Nesting {
+			c.add(path, true, "nested attribute nesting mode changed from %s to %s", prior.NestedType.Nesting, current.NestedType.Nesting)
+		} else {
+			c.compareAttributes(path, prior.NestedType.Attributes, current.NestedType.Attributes)
+		}
+	case prior.NestedType != nil || current.NestedType != nil:
+		c.add(path, true, "type changed from %s to %s", prior.ValueType(), current.ValueType())
+	case prior.Type != nil && !prior.Type.Equal(current.Type):
+		c.add(path, true, "type changed from %s to %s", prior.Type, current.Type)
+	}
+
+	priorMode := attributeMode(prior)
+	currentMode := attributeMode(current)
+
+	if priorMode != currentMode {
+		breaking := (!prior.Required && current.Required) ||
+			(isConfigurable(prior) && !isConfigurable(current)) ||
+			(prior.Computed && !current.Computed)
+
+		c.add(path, breaking, "changed from %s to %s", priorMode, currentMode)
+	}
+
+	if !prior.Deprecated && current.Deprecated {
+		c.add(path, false, "deprecated")
+	}
+}
+
+// attributeMode returns a human-readable description of whether the
+// attribute is required, optional, and/or computed.
+func attributeMode(attribute *tfprotov6.SchemaAttribute) string {
+	switch {
+	case attribute.Required:
+		return "required"
+	case attribute.Optional && attribute.Computed:
+		return "optional and computed"
+	case attribute.Optional:
+		return "optional"
+	case attribute.Computed:
+		return "computed"
+	default:
+		return "unset"
+	}
+}
+
+// isConfigurable returns true if the attribute can be set in configuration.
+func isConfigurable(attribute *tfprotov6.SchemaAttribute) bool {
+	return attribute.Required || attribute.Optional
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func maxItemsString(maxItems int64) string {
+	if maxItems == 0 {
+		return "unlimited"
+	}
+
+	return fmt.Sprintf("%d", maxItems)
+}
+
+// sortedKeys returns the sorted union of the keys of both maps.
+func sortedKeys[V any](prior, current map[string]V) []string {
+	keys := make([]string, 0, len(prior)+len(current))
+
+	for key := range prior {
+		keys = append(keys, key)
+	}
+
+	for key := range current {
+		if _, ok := prior[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,516 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemadiff_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/schemadiff"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	testResourceSchema := func(block *tfprotov6.SchemaBlock) *tfprotov6.GetProviderSchemaResponse {
+		return &tfprotov6.GetProviderSchemaResponse{
+			ResourceSchemas: map[string]*tfprotov6.Schema{
+				"test_resource": {
+					Block: block,
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		prior    *tfprotov6.GetProviderSchemaResponse
+		current  *tfprotov6.GetProviderSchemaResponse
+		expected schemadiff.Changes
+	}{
+		"nil": {},
+		"no-changes": {
+			prior: testResourceSchema(&tfprotov6.SchemaBlock{
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{
+						Name:     "test",
+						Type:     tftypes.String,
+						Required: true,
+					},
+				},
+			}),
+			current: testResourceSchema(&tfprotov6.SchemaBlock{
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{
+						Name:     "test",
+						Type:     tftypes.String,
+						Required: true,
+					},
+				},
+			}),
+		},
+		"resource-added-removed": {
+			prior: &tfprotov6.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_one": {},
+				},
+			},
+			current: &tfprotov6.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_two": {},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_one",
+					Description: "removed",
+					Breaking:    true,
+				},
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_two",
+					Description: "added",
+				},
+			},
+		},
+		"data-source-removed": {
+			prior: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov6.Schema{
+					"test_data_source": {},
+				},
+			},
+			current: &tfprotov6.GetProviderSchemaResponse{},
+			expected: schemadiff.Changes{
+				{
+					SchemaKind:  schemadiff.SchemaKindDataSource,
+					SchemaName:  "test_data_source",
+					Description: "removed",
+					Breaking:    true,
+				},
+			},
+		},
+		"schema-version-decreased": {
+			prior: &tfprotov6.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_resource": {
+						Version: 2,
+					},
+				},
+			},
+			current: &tfprotov6.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_resource": {
+						Version: 1,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Description: "schema version decreased from 2 to 1",
+					Breaking:    true,
+				},
+			},
+		},
+		"provider-attribute-removed": {
+			prior: &tfprotov6.GetProviderSchemaResponse{
+				Provider: &tfprotov6.Schema{
+					Block: &tfprotov6.SchemaBlock{
+						Attributes: []*tfprotov6.SchemaAttribute{
+							{
+								Name:     "test",
+								Type:     tftypes.String,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+			current: &tfprotov6.GetProviderSchemaResponse{},
+			expected: schemadiff.Changes{
+				{
+					SchemaKind:  schemadiff.SchemaKindProvider,
+					Path:        "test",
+					Description: "attribute removed",
+					Breaking:    true,
+				},
+			},
+		},
+		"attributes-added": {
+			prior: testResourceSchema(&tfprotov6.SchemaBlock{}),
+			current: testResourceSchema(&tfprotov6.SchemaBlock{
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{
+						Name:     "test_optional",
+						Type:     tftypes.String,
+						Optional: true,
+					},
+					{
+						Name:     "test_required",
+						Type:     tftypes.String,
+						Required: true,
+					},
+				},
+			}),
+			expected: schemadiff.Changes{
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test_optional",
+					Description: "attribute added",
+				},
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test_required",
+					Description: "required attribute added",
+					Breaking:    true,
+				},
+			},
+		},
+		"attribute-type-changed": {
+			prior: testResourceSchema(&tfprotov6.SchemaBlock{
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{
+						Name:     "test",
+						Type:     tftypes.String,
+						Optional: true,
+					},
+				},
+			}),
+			current: testResourceSchema(&tfprotov6.SchemaBlock{
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{
+						Name:     "test",
+						Type:     tftypes.Number,
+						Optional: true,
+					},
+				},
+			}),
+			expected: schemadiff.Changes{
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test",
+					Description: "type changed from tftypes.String to tftypes.Number",
+					Breaking:    true,
+				},
+			},
+		},
+		"attribute-mode-changes": {
+			prior: testResourceSchema(&tfprotov6.SchemaBlock{
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{
+						Name:     "test_computed_to_optional_computed",
+						Type:     tftypes.String,
+						Computed: true,
+					},
+					{
+						Name:     "test_optional_computed_to_optional",
+						Type:     tftypes.String,
+						Optional: true,
+						Computed: true,
+					},
+					{
+						Name:     "test_optional_to_computed",
+						Type:     tftypes.String,
+						Optional: true,
+					},
+					{
+						Name:     "test_optional_to_required",
+						Type:     tftypes.String,
+						Optional: true,
+					},
+					{
+						Name:     "test_required_to_optional",
+						Type:     tftypes.String,
+						Required: true,
+					},
+				},
+			}),
+			current: testResourceSchema(&tfprotov6.SchemaBlock{
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{
+						Name:     "test_computed_to_optional_computed",
+						Type:     tftypes.String,
+						Optional: true,
+						Computed: true,
+					},
+					{
+						Name:     "test_optional_computed_to_optional",
+						Type:     tftypes.String,
+						Optional: true,
+					},
+					{
+						Name:     "test_optional_to_computed",
+						Type:     tftypes.String,
+						Computed: true,
+					},
+					{
+						Name:     "test_optional_to_required",
+						Type:     tftypes.String,
+						Required: true,
+					},
+					{
+						Name:     "test_required_to_optional",
+						Type:     tftypes.String,
+						Optional: true,
+					},
+				},
+			}),
+			expected: schemadiff.Changes{
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test_computed_to_optional_computed",
+					Description: "changed from computed to optional and computed",
+				},
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test_optional_computed_to_optional",
+					Description: "changed from optional and computed to optional",
+					Breaking:    true,
+				},
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test_optional_to_computed",
+					Description: "changed from optional to computed",
+					Breaking:    true,
+				},
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test_optional_to_required",
+					Description: "changed from optional to required",
+					Breaking:    true,
+				},
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test_required_to_optional",
+					Description: "changed from required to optional",
+				},
+			},
+		},
+		"attribute-deprecated": {
+			prior: testResourceSchema(&tfprotov6.SchemaBlock{
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{
+						Name:     "test",
+						Type:     tftypes.String,
+						Optional: true,
+					},
+				},
+			}),
+			current: testResourceSchema(&tfprotov6.SchemaBlock{
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{
+						Name:       "test",
+						Type:       tftypes.String,
+						Optional:   true,
+						Deprecated: true,
+					},
+				},
+			}),
+			expected: schemadiff.Changes{
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test",
+					Description: "deprecated",
+				},
+			},
+		},
+		"nested-attribute-changes": {
+			prior: testResourceSchema(&tfprotov6.SchemaBlock{
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{
+						Name: "rule",
+						NestedType: &tfprotov6.SchemaObject{
+							Nesting: tfprotov6.SchemaObjectNestingModeList,
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name:     "action",
+									Type:     tftypes.String,
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+					{
+						Name: "test_nesting",
+						NestedType: &tfprotov6.SchemaObject{
+							Nesting: tfprotov6.SchemaObjectNestingModeList,
+						},
+						Optional: true,
+					},
+				},
+			}),
+			current: testResourceSchema(&tfprotov6.SchemaBlock{
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{
+						Name: "rule",
+						NestedType: &tfprotov6.SchemaObject{
+							Nesting: tfprotov6.SchemaObjectNestingModeList,
+						},
+						Optional: true,
+					},
+					{
+						Name: "test_nesting",
+						NestedType: &tfprotov6.SchemaObject{
+							Nesting: tfprotov6.SchemaObjectNestingModeSet,
+						},
+						Optional: true,
+					},
+				},
+			}),
+			expected: schemadiff.Changes{
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "rule.action",
+					Description: "attribute removed",
+					Breaking:    true,
+				},
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test_nesting",
+					Description: "nested attribute nesting mode changed from LIST to SET",
+					Breaking:    true,
+				},
+			},
+		},
+		"block-changes": {
+			prior: testResourceSchema(&tfprotov6.SchemaBlock{
+				BlockTypes: []*tfprotov6.SchemaNestedBlock{
+					{
+						TypeName: "test_items",
+						Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name:     "test",
+									Type:     tftypes.String,
+									Optional: true,
+								},
+							},
+						},
+					},
+					{
+						TypeName: "test_nesting",
+						Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+					},
+					{
+						TypeName: "test_removed",
+						Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+					},
+				},
+			}),
+			current: testResourceSchema(&tfprotov6.SchemaBlock{
+				BlockTypes: []*tfprotov6.SchemaNestedBlock{
+					{
+						TypeName: "test_added",
+						Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+					},
+					{
+						TypeName: "test_added_required",
+						Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+						MinItems: 1,
+					},
+					{
+						TypeName: "test_items",
+						Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+						MinItems: 1,
+						MaxItems: 2,
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name:     "test",
+									Type:     tftypes.String,
+									Required: true,
+								},
+							},
+						},
+					},
+					{
+						TypeName: "test_nesting",
+						Nesting:  tfprotov6.SchemaNestedBlockNestingModeSet,
+					},
+				},
+			}),
+			expected: schemadiff.Changes{
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test_added",
+					Description: "block added",
+				},
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test_added_required",
+					Description: "required block added",
+					Breaking:    true,
+				},
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test_items",
+					Description: "block minimum items increased from 0 to 1",
+					Breaking:    true,
+				},
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test_items",
+					Description: "block maximum items decreased from unlimited to 2",
+					Breaking:    true,
+				},
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test_items.test",
+					Description: "changed from optional to required",
+					Breaking:    true,
+				},
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test_nesting",
+					Description: "block nesting mode changed from LIST to SET",
+					Breaking:    true,
+				},
+				{
+					SchemaKind:  schemadiff.SchemaKindResource,
+					SchemaName:  "test_resource",
+					Path:        "test_removed",
+					Description: "block removed",
+					Breaking:    true,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemadiff.Compare(testCase.prior, testCase.current)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schemadiff compares the provider schemas of two provider versions,
+// such as the GetProviderSchema responses of the released and development
+// versions of a provider, and reports the differences. Breaking changes are
+// those which may cause existing configurations or state to stop working,
+// such as removed attributes, attribute type changes, or optional attributes
+// becoming required.
+//
+// Providers can use this package in unit tests to gate releases on schema
+// compatibility, for example:
+//
+//	changes := schemadiff.Compare(releasedSchema, currentSchema)
+//
+//	for _, change := range changes.Breaking() {
+//		t.Errorf("breaking schema change: %s", change)
+//	}
+package schemadiff
@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package statedump decodes resource state, as it is sent over the plugin
+// protocol, against a resource schema and renders it as a typed tree with
+// null and unknown markers. It is intended for debugging, such as inspecting
+// the prior state of an UpgradeResourceState request.
+//
+// For example:
+//
+//	dump, diags := statedump.RawState(ctx, resp.Schema, req.RawState)
+//
+//	t.Log(dump)
+//
+// Which renders, with object attributes and map keys in sorted order:
+//
+//	id: string = "example"
+//	settings: list of object = null
+//	tags: map of string
+//	  ["env"]: string = "prod"
+//
+// Protocol version 5 values can be converted by copying their JSON and
+// MsgPack fields into the protocol version 6 types.
+package statedump
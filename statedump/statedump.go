@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statedump
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// DynamicValue decodes the msgpack or JSON encoded state, such as the prior
+// state of a ReadResource request, using the resource schema and returns its
+// typed tree. Error diagnostics are returned if the state does not match the
+// schema.
+func DynamicValue(ctx context.Context, resourceSchema schema.Schema, state *tfprotov6.DynamicValue) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if state == nil {
+		return "null\n", diags
+	}
+
+	value, err := state.Unmarshal(resourceSchema.Type().TerraformType(ctx))
+
+	if err != nil {
+		diags.AddError(
+			"State Decoding Error",
+			"The state could not be decoded using the resource schema. "+
+				"This may indicate the state was written with a different schema version.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return "", diags
+	}
+
+	return Value(value), diags
+}
+
+// RawState decodes the JSON encoded state of an UpgradeResourceState
+// request using the resource schema and returns its typed tree. Attributes
+// in the state which are not defined in the schema, such as removed
+// attributes, are ignored. Error diagnostics are returned for other
+// differences between the state and the schema, such as changed attribute
+// types.
+func RawState(ctx context.Context, resourceSchema schema.Schema, state *tfprotov6.RawState) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if state == nil {
+		return "null\n", diags
+	}
+
+	if state.JSON == nil {
+		diags.AddError(
+			"State Decoding Error",
+			"The state could not be decoded as it is not JSON encoded. Flatmap encoded state is not supported.",
+		)
+
+		return "", diags
+	}
+
+	opts := tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	}
+
+	value, err := state.UnmarshalWithOpts(resourceSchema.Type().TerraformType(ctx), opts)
+
+	if err != nil {
+		diags.AddError(
+			"State Decoding Error",
+			"The state could not be decoded using the resource schema. "+
+				"This may indicate the state was written with a different schema version.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return "", diags
+	}
+
+	return Value(value), diags
+}
+
+// Value returns the typed tree of an object value, such as a decoded
+// resource state. Each line contains an attribute name, map key, or list,
+// set, or tuple element index followed by the value type and, for
+// primitive, null, and unknown values, the value itself. Nested values are
+// indented below their parent.
+func Value(value tftypes.Value) string {
+	var b strings.Builder
+
+	switch {
+	case value.IsNull():
+		b.WriteString("null\n")
+	case !value.IsKnown():
+		b.WriteString("(unknown)\n")
+	default:
+		writeChildren(&b, 0, value)
+	}
+
+	return b.String()
+}
+
+// writeValue writes the line for the value and its children.
+func writeValue(b *strings.Builder, indent int, label string, value tftypes.Value) {
+	b.WriteString(strings.Repeat("  ", indent))
+	b.WriteString(label)
+	b.WriteString(": ")
+	b.WriteString(typeName(value.Type()))
+
+	switch {
+	case value.IsNull():
+		b.WriteString(" = null\n")
+
+		return
+	case !value.IsKnown():
+		b.WriteString(" = (unknown)\n")
+
+		return
+	}
+
+	if primitive, ok := primitiveString(value); ok {
+		b.WriteString(" = ")
+		b.WriteString(primitive)
+		b.WriteString("\n")
+
+		return
+	}
+
+	b.WriteString("\n")
+	writeChildren(b, indent+1, value)
+}
+
+// writeChildren writes the attributes, map elements, or list, set, or tuple
+// elements of a known value.
+func writeChildren(b *strings.Builder, indent int, value tftypes.Value) {
+	switch {
+	case value.Type().Is(tftypes.Object{}), value.Type().Is(tftypes.Map{}):
+		var children map[string]tftypes.Value
+
+		if err := value.As(&children); err != nil {
+			return
+		}
+
+		keys := make([]string, 0, len(children))
+
+		for key := range children {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			label := key
+
+			if value.Type().Is(tftypes.Map{}) {
+				label = "[" + strconv.Quote(key) + "]"
+			}
+
+			writeValue(b, indent, label, children[key])
+		}
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var children []tftypes.Value
+
+		if err := value.As(&children); err != nil {
+			return
+		}
+
+		for index, child := range children {
+			writeValue(b, indent, fmt.Sprintf("[%d]", index), child)
+		}
+	}
+}
+
+// primitiveString returns the representation of a known primitive value.
+func primitiveString(value tftypes.Value) (string, bool) {
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+
+		if err := value.As(&s); err != nil {
+			return "", false
+		}
+
+		return strconv.Quote(s), true
+	case value.Type().Is(tftypes.Number):
+		n := new(big.Float)
+
+		if err := value.As(&n); err != nil {
+			return "", false
+		}
+
+		return n.Text('g', -1), true
+	case value.Type().Is(tftypes.Bool):
+		var v bool
+
+		if err := value.As(&v); err != nil {
+			return "", false
+		}
+
+		return strconv.FormatBool(v), true
+	}
+
+	return "", false
+}
+
+// typeName returns a short name for the type, such as "list of string".
+func typeName(typ tftypes.Type) string {
+	switch t := typ.(type) {
+	case tftypes.List:
+		return "list of " + typeName(t.ElementType)
+	case tftypes.Map:
+		return "map of " + typeName(t.ElementType)
+	case tftypes.Set:
+		return "set of " + typeName(t.ElementType)
+	case tftypes.Object:
+		return "object"
+	case tftypes.Tuple:
+		return "tuple"
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		return "string"
+	case typ.Is(tftypes.Number):
+		return "number"
+	case typ.Is(tftypes.Bool):
+		return "bool"
+	case typ.Is(tftypes.DynamicPseudoType):
+		return "dynamic"
+	}
+
+	return typ.String()
+}
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statedump_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/statedump"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"count": schema.Int64Attribute{
+			Optional: true,
+		},
+		"enabled": schema.BoolAttribute{
+			Optional: true,
+		},
+		"tags": schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"settings": schema.ListNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+		},
+	},
+}
+
+func testObjectType() tftypes.Object {
+	return testSchema.Type().TerraformType(context.Background()).(tftypes.Object) //nolint:forcetypeassert
+}
+
+func TestDynamicValue(t *testing.T) {
+	t.Parallel()
+
+	settingsType := tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}}
+
+	state, err := tfprotov6.NewDynamicValue(testObjectType(), tftypes.NewValue(testObjectType(), map[string]tftypes.Value{
+		"id":      tftypes.NewValue(tftypes.String, "example"),
+		"count":   tftypes.NewValue(tftypes.Number, 5),
+		"enabled": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"env": tftypes.NewValue(tftypes.String, "prod"),
+		}),
+		"settings": tftypes.NewValue(settingsType, []tftypes.Value{
+			tftypes.NewValue(settingsType.ElementType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, nil),
+			}),
+		}),
+	}))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		state         *tfprotov6.DynamicValue
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			expected: "null\n",
+		},
+		"msgpack": {
+			state: &state,
+			expected: `count: number = 5
+enabled: bool = (unknown)
+id: string = "example"
+settings: list of object
+  [0]: object
+    name: string = null
+tags: map of string
+  ["env"]: string = "prod"
+`,
+		},
+		"json": {
+			state: &tfprotov6.DynamicValue{
+				JSON: []byte(`{"id":"example","count":null,"enabled":true,"tags":null,"settings":[]}`),
+			},
+			expected: `count: number = null
+enabled: bool = true
+id: string = "example"
+settings: list of object
+tags: map of string = null
+`,
+		},
+		"mismatch": {
+			state: &tfprotov6.DynamicValue{
+				JSON: []byte(`{"id":"example","removed":true}`),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"State Decoding Error",
+					"The state could not be decoded using the resource schema. "+
+						"This may indicate the state was written with a different schema version.\n\n"+
+						"Error: AttributeName(\"removed\"): unsupported attribute \"removed\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := statedump.DynamicValue(context.Background(), testSchema, testCase.state)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRawState(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		state         *tfprotov6.RawState
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			expected: "null\n",
+		},
+		"flatmap": {
+			state: &tfprotov6.RawState{
+				Flatmap: map[string]string{"id": "example"},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"State Decoding Error",
+					"The state could not be decoded as it is not JSON encoded. Flatmap encoded state is not supported.",
+				),
+			},
+		},
+		"removed-attribute": {
+			state: &tfprotov6.RawState{
+				JSON: []byte(`{"id":"example","count":1.5,"removed":true,"tags":{"a":"b","c":""}}`),
+			},
+			expected: `count: number = 1.5
+enabled: bool = null
+id: string = "example"
+settings: list of object = null
+tags: map of string
+  ["a"]: string = "b"
+  ["c"]: string = ""
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := statedump.RawState(context.Background(), testSchema, testCase.state)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValue(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"dynamic": tftypes.DynamicPseudoType,
+			"set":     tftypes.Set{ElementType: tftypes.Number},
+			"tuple":   tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}},
+		},
+	}
+
+	testCases := map[string]struct {
+		value    tftypes.Value
+		expected string
+	}{
+		"null": {
+			value:    tftypes.NewValue(objectType, nil),
+			expected: "null\n",
+		},
+		"unknown": {
+			value:    tftypes.NewValue(objectType, tftypes.UnknownValue),
+			expected: "(unknown)\n",
+		},
+		"collections": {
+			value: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"dynamic": tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+				"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, []tftypes.Value{
+					tftypes.NewValue(tftypes.Number, 1),
+				}),
+				"tuple": tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+					tftypes.NewValue(tftypes.Bool, false),
+				}),
+			}),
+			expected: `dynamic: dynamic = (unknown)
+set: set of number
+  [0]: number = 1
+tuple: tuple
+  [0]: string = "a"
+  [1]: bool = false
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := statedump.Value(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrvalue
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/statedump"
+)
+
+// Equal returns true if the values are equal or semantically equal. Semantic
+// equality is checked with the semantic equality method of the value type,
+// such as StringSemanticEquals, including for elements and attributes nested
+// in collection and object values. Semantic equality errors cause the values
+// to be treated as not equal.
+func Equal(ctx context.Context, x, y attr.Value) bool {
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+
+	if x.Equal(y) {
+		return true
+	}
+
+	if !x.Type(ctx).Equal(y.Type(ctx)) {
+		return false
+	}
+
+	req := fwschemadata.ValueSemanticEqualityRequest{
+		Path:             path.Empty(),
+		PriorValue:       x,
+		ProposedNewValue: y,
+	}
+	resp := &fwschemadata.ValueSemanticEqualityResponse{}
+
+	fwschemadata.ValueSemanticEquality(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		return false
+	}
+
+	return resp.NewValue.Equal(x)
+}
+
+// Comparer returns a cmp.Option which compares attr.Value, including values
+// nested in structs, slices, and maps, with Equal.
+func Comparer() cmp.Option {
+	return cmp.Comparer(func(x, y attr.Value) bool {
+		return Equal(context.Background(), x, y)
+	})
+}
+
+// Options returns the cmp.Options for comparing values containing attr.Value
+// in provider unit tests.
+func Options() cmp.Options {
+	return cmp.Options{
+		Comparer(),
+	}
+}
+
+// Diff returns a human readable report of the differences between the
+// values, or an empty string if they are equal according to Equal. Each
+// value is rendered as a typed tree, with one line per attribute, element,
+// null, or unknown value, so differences in deeply nested values are shown
+// at the line where they occur.
+func Diff(ctx context.Context, x, y attr.Value) string {
+	if Equal(ctx, x, y) {
+		return ""
+	}
+
+	return cmp.Diff(render(ctx, x), render(ctx, y))
+}
+
+// render returns the typed tree of the value, under a single value
+// attribute so primitive values can be rendered.
+func render(ctx context.Context, value attr.Value) string {
+	if value == nil {
+		return "<nil>\n"
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		return value.String() + "\n"
+	}
+
+	wrapper := tftypes.NewValue(
+		tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"value": tfValue.Type(),
+			},
+		},
+		map[string]tftypes.Value{
+			"value": tfValue,
+		},
+	)
+
+	return statedump.Value(wrapper)
+}
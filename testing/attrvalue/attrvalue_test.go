@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrvalue_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/testing/attrvalue"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+
+	semanticString := func(value string, semanticEquals bool) attr.Value {
+		return testtypes.StringValueWithSemanticEquals{
+			StringValue:    types.StringValue(value),
+			SemanticEquals: semanticEquals,
+		}
+	}
+
+	testCases := map[string]struct {
+		x        attr.Value
+		y        attr.Value
+		expected bool
+	}{
+		"nil": {
+			expected: true,
+		},
+		"nil-value": {
+			x:        nil,
+			y:        types.StringValue("test"),
+			expected: false,
+		},
+		"equal": {
+			x:        types.StringValue("test"),
+			y:        types.StringValue("test"),
+			expected: true,
+		},
+		"not-equal": {
+			x:        types.StringValue("test"),
+			y:        types.StringValue("other"),
+			expected: false,
+		},
+		"null-unknown": {
+			x:        types.StringNull(),
+			y:        types.StringUnknown(),
+			expected: false,
+		},
+		"null-value": {
+			x:        types.StringNull(),
+			y:        types.StringValue("test"),
+			expected: false,
+		},
+		"different-types": {
+			x:        types.StringValue("1"),
+			y:        types.Int64Value(1),
+			expected: false,
+		},
+		"semantic-equals": {
+			x:        semanticString("test", true),
+			y:        semanticString("TEST", true),
+			expected: true,
+		},
+		"semantic-not-equals": {
+			x:        semanticString("test", false),
+			y:        semanticString("TEST", false),
+			expected: false,
+		},
+		"semantic-equals-null": {
+			x: testtypes.StringValueWithSemanticEquals{
+				StringValue:    types.StringNull(),
+				SemanticEquals: true,
+			},
+			y:        semanticString("test", true),
+			expected: false,
+		},
+		"semantic-equals-error": {
+			x: testtypes.StringValueWithSemanticEquals{
+				StringValue:    types.StringValue("test"),
+				SemanticEquals: true,
+				SemanticEqualsDiagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test summary", "test detail"),
+				},
+			},
+			y: testtypes.StringValueWithSemanticEquals{
+				StringValue:    types.StringValue("TEST"),
+				SemanticEquals: true,
+				SemanticEqualsDiagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test summary", "test detail"),
+				},
+			},
+			expected: false,
+		},
+		"list-element-semantic-equals": {
+			x: types.ListValueMust(
+				testtypes.StringTypeWithSemanticEquals{SemanticEquals: true},
+				[]attr.Value{semanticString("test", true)},
+			),
+			y: types.ListValueMust(
+				testtypes.StringTypeWithSemanticEquals{SemanticEquals: true},
+				[]attr.Value{semanticString("TEST", true)},
+			),
+			expected: true,
+		},
+		"object-attribute-not-equal": {
+			x: types.ObjectValueMust(
+				map[string]attr.Type{"test": types.StringType},
+				map[string]attr.Value{"test": types.StringValue("test")},
+			),
+			y: types.ObjectValueMust(
+				map[string]attr.Type{"test": types.StringType},
+				map[string]attr.Value{"test": types.StringValue("other")},
+			),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := attrvalue.Equal(context.Background(), testCase.x, testCase.y)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestOptions(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		ID    types.String
+		Value basetypes.StringValuable
+		Tags  []attr.Value
+	}
+
+	x := model{
+		ID: types.StringValue("test"),
+		Value: testtypes.StringValueWithSemanticEquals{
+			StringValue:    types.StringValue("test"),
+			SemanticEquals: true,
+		},
+		Tags: []attr.Value{types.StringValue("a")},
+	}
+
+	y := model{
+		ID: types.StringValue("test"),
+		Value: testtypes.StringValueWithSemanticEquals{
+			StringValue:    types.StringValue("TEST"),
+			SemanticEquals: true,
+		},
+		Tags: []attr.Value{types.StringValue("a")},
+	}
+
+	if diff := cmp.Diff(x, y, attrvalue.Options()); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	y.Tags = []attr.Value{types.StringNull()}
+
+	if diff := cmp.Diff(x, y, attrvalue.Options()); diff == "" {
+		t.Error("expected difference, got none")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	objectType := map[string]attr.Type{
+		"name": types.StringType,
+		"tags": types.ListType{ElemType: types.StringType},
+	}
+
+	x := types.ObjectValueMust(objectType, map[string]attr.Value{
+		"name": types.StringValue("test"),
+		"tags": types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("a"),
+			types.StringValue("b"),
+		}),
+	})
+
+	if diff := attrvalue.Diff(ctx, x, x); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	y := types.ObjectValueMust(objectType, map[string]attr.Value{
+		"name": types.StringValue("test"),
+		"tags": types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("a"),
+			types.StringUnknown(),
+		}),
+	})
+
+	diff := attrvalue.Diff(ctx, x, y)
+
+	for _, expected := range []string{`[1]: string = "b"`, `[1]: string = (unknown)`} {
+		if !strings.Contains(diff, expected) {
+			t.Errorf("expected difference to contain %q, got: %s", expected, diff)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package attrvalue contains helpers for comparing attr.Value in provider
+// unit tests. Values are equal if they are exactly equal or, for types which
+// implement semantic equality, such as a JSON string type, if they are
+// semantically equal. Null and unknown values are only equal to other null or
+// unknown values of the same type.
+//
+// For example, with the github.com/google/go-cmp/cmp module:
+//
+//	if diff := cmp.Diff(got, expected, attrvalue.Options()); diff != "" {
+//		t.Errorf("unexpected difference: %s", diff)
+//	}
+//
+// Or to compare two values with readable output for nested values:
+//
+//	if diff := attrvalue.Diff(ctx, got, expected); diff != "" {
+//		t.Errorf("unexpected difference: %s", diff)
+//	}
+package attrvalue
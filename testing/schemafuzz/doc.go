@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schemafuzz contains helpers for fuzz testing the attribute
+// validation of a data source, provider, or resource schema in provider unit
+// tests.
+//
+// Random configurations are generated from the schema, including null,
+// unknown, empty, and boundary values, and mixed with attribute values from
+// any given example configurations. Each configuration is validated the same
+// way the framework validates configuration from Terraform, including schema
+// validators and custom type validation. The test fails if validation panics
+// or returns an error or warning diagnostic which is not associated with an
+// attribute path.
+//
+// For example, with a fixed number of configurations in a regular unit test:
+//
+//	func TestThingResourceSchemaValidation(t *testing.T) {
+//		t.Parallel()
+//
+//		resp := &resource.SchemaResponse{}
+//		NewThingResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+//
+//		schemafuzz.Test(t, resp.Schema, schemafuzz.Options{})
+//	}
+//
+// Or with Go fuzzing, which runs until stopped when invoked with go test -fuzz:
+//
+//	func FuzzThingResourceSchemaValidation(f *testing.F) {
+//		resp := &resource.SchemaResponse{}
+//		NewThingResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+//
+//		schemafuzz.Fuzz(f, resp.Schema, schemafuzz.Options{})
+//	}
+package schemafuzz
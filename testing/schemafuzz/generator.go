@@ -0,0 +1,366 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemafuzz
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"math/rand"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// maxElements is the maximum number of elements generated for collections.
+const maxElements = 3
+
+// generatorStrings are string values which commonly exercise validator edge
+// cases, in addition to random strings.
+var generatorStrings = []string{
+	"",
+	" ",
+	"0",
+	"-1",
+	"true",
+	"null",
+	"{}",
+	"[]",
+	"\x00",
+	"\n",
+	"é日本🙂",
+	strings.Repeat("a", 1024),
+}
+
+// generatorNumbers are number values which commonly exercise validator edge
+// cases, in addition to random numbers.
+var generatorNumbers = []*big.Float{
+	big.NewFloat(0),
+	big.NewFloat(-1),
+	big.NewFloat(1),
+	big.NewFloat(0.5),
+	big.NewFloat(-0.5),
+	big.NewFloat(math.MaxInt32),
+	big.NewFloat(math.MinInt32),
+	big.NewFloat(math.MaxInt64),
+	big.NewFloat(math.MinInt64),
+	big.NewFloat(math.MaxFloat64),
+	big.NewFloat(-math.MaxFloat64),
+	big.NewFloat(math.SmallestNonzeroFloat64),
+	new(big.Float).Mul(big.NewFloat(math.MaxFloat64), big.NewFloat(2)),
+}
+
+// generator creates random configurations for a schema. Attributes are
+// generated in sorted name order, so the same seed always creates the same
+// configurations.
+type generator struct {
+	rand     *rand.Rand
+	schema   fwschema.Schema
+	examples []tftypes.Value
+}
+
+func newGenerator(schema fwschema.Schema, seed int64, examples []tftypes.Value) *generator {
+	return &generator{
+		//nolint:gosec // Fuzzing requires reproducible, not secure, randomness.
+		rand:     rand.New(rand.NewSource(seed)),
+		schema:   schema,
+		examples: examples,
+	}
+}
+
+// config returns a random configuration of the schema.
+func (g *generator) config() tftypes.Value {
+	objectType := g.schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	attributes := g.schema.GetAttributes()
+
+	for _, name := range fwschema.SortedNames(attributes) {
+		if example, ok := g.exampleValue(name); ok {
+			values[name] = example
+
+			continue
+		}
+
+		values[name] = g.attribute(attributes[name], objectType.AttributeTypes[name])
+	}
+
+	blocks := g.schema.GetBlocks()
+
+	for _, name := range fwschema.SortedNames(blocks) {
+		if example, ok := g.exampleValue(name); ok {
+			values[name] = example
+
+			continue
+		}
+
+		values[name] = g.block(blocks[name], objectType.AttributeTypes[name])
+	}
+
+	return tftypes.NewValue(objectType, values)
+}
+
+// exampleValue returns the top level attribute or block value of a random
+// example configuration, a third of the time.
+func (g *generator) exampleValue(name string) (tftypes.Value, bool) {
+	if len(g.examples) == 0 || g.rand.Intn(3) != 0 {
+		return tftypes.Value{}, false
+	}
+
+	example := g.examples[g.rand.Intn(len(g.examples))]
+
+	if !example.IsKnown() || example.IsNull() {
+		return tftypes.Value{}, false
+	}
+
+	var values map[string]tftypes.Value
+
+	if err := example.As(&values); err != nil {
+		return tftypes.Value{}, false
+	}
+
+	value, ok := values[name]
+
+	return value, ok
+}
+
+// attribute returns a random configuration value for the attribute. Values of
+// computed-only attributes are always null, as Terraform does not allow them
+// in configuration.
+func (g *generator) attribute(attribute fwschema.Attribute, typ tftypes.Type) tftypes.Value {
+	if attribute.IsComputed() && !attribute.IsOptional() && !attribute.IsRequired() {
+		return tftypes.NewValue(typ, nil)
+	}
+
+	nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+	if !ok {
+		return g.value(typ, 0)
+	}
+
+	if v, ok := g.nullOrUnknown(typ); ok {
+		return v
+	}
+
+	nestedObject := nestedAttribute.GetNestedObject()
+
+	switch nestedAttribute.GetNestingMode() {
+	case fwschema.NestingModeSingle:
+		return g.nestedAttributeObject(nestedObject, typ)
+	case fwschema.NestingModeList:
+		elemType := typ.(tftypes.List).ElementType
+
+		return tftypes.NewValue(typ, g.elements(func() tftypes.Value { return g.nestedAttributeObject(nestedObject, elemType) }, false))
+	case fwschema.NestingModeSet:
+		elemType := typ.(tftypes.Set).ElementType
+
+		return tftypes.NewValue(typ, g.elements(func() tftypes.Value { return g.nestedAttributeObject(nestedObject, elemType) }, true))
+	case fwschema.NestingModeMap:
+		elemType := typ.(tftypes.Map).ElementType
+		elements := make(map[string]tftypes.Value)
+
+		for i := g.rand.Intn(maxElements + 1); i > 0; i-- {
+			elements[g.string()] = g.nestedAttributeObject(nestedObject, elemType)
+		}
+
+		return tftypes.NewValue(typ, elements)
+	default:
+		return g.value(typ, 0)
+	}
+}
+
+// nestedAttributeObject returns a random object for the nested attribute
+// object.
+func (g *generator) nestedAttributeObject(nestedObject fwschema.NestedAttributeObject, typ tftypes.Type) tftypes.Value {
+	objectType := typ.(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	attributes := nestedObject.GetAttributes()
+
+	for _, name := range fwschema.SortedNames(attributes) {
+		values[name] = g.attribute(attributes[name], objectType.AttributeTypes[name])
+	}
+
+	return tftypes.NewValue(objectType, values)
+}
+
+// block returns a random configuration value for the block. List and set
+// blocks are never null, as Terraform sends an empty collection when there
+// are no blocks in configuration.
+func (g *generator) block(block fwschema.Block, typ tftypes.Type) tftypes.Value {
+	nestedObject := block.GetNestedObject()
+
+	switch block.GetNestingMode() {
+	case fwschema.BlockNestingModeList:
+		elemType := typ.(tftypes.List).ElementType
+
+		return tftypes.NewValue(typ, g.elements(func() tftypes.Value { return g.nestedBlockObject(nestedObject, elemType) }, false))
+	case fwschema.BlockNestingModeSet:
+		elemType := typ.(tftypes.Set).ElementType
+
+		return tftypes.NewValue(typ, g.elements(func() tftypes.Value { return g.nestedBlockObject(nestedObject, elemType) }, true))
+	case fwschema.BlockNestingModeSingle:
+		if g.rand.Intn(3) == 0 {
+			return tftypes.NewValue(typ, nil)
+		}
+
+		return g.nestedBlockObject(nestedObject, typ)
+	default:
+		return g.value(typ, 0)
+	}
+}
+
+// nestedBlockObject returns a random object for the nested block object.
+func (g *generator) nestedBlockObject(nestedObject fwschema.NestedBlockObject, typ tftypes.Type) tftypes.Value {
+	objectType := typ.(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	attributes := nestedObject.GetAttributes()
+
+	for _, name := range fwschema.SortedNames(attributes) {
+		values[name] = g.attribute(attributes[name], objectType.AttributeTypes[name])
+	}
+
+	blocks := nestedObject.GetBlocks()
+
+	for _, name := range fwschema.SortedNames(blocks) {
+		values[name] = g.block(blocks[name], objectType.AttributeTypes[name])
+	}
+
+	return tftypes.NewValue(objectType, values)
+}
+
+// elements returns up to maxElements values created by the given function.
+// If unique is true, duplicate values are skipped, as sets cannot contain
+// duplicate elements.
+func (g *generator) elements(newElement func() tftypes.Value, unique bool) []tftypes.Value {
+	var elements []tftypes.Value
+
+	for i := g.rand.Intn(maxElements + 1); i > 0; i-- {
+		element := newElement()
+
+		if unique && containsValue(elements, element) {
+			continue
+		}
+
+		elements = append(elements, element)
+	}
+
+	return elements
+}
+
+// nullOrUnknown returns a null value a fifth of the time and an unknown value
+// a tenth of the time.
+func (g *generator) nullOrUnknown(typ tftypes.Type) (tftypes.Value, bool) {
+	switch n := g.rand.Intn(10); {
+	case n < 2:
+		return tftypes.NewValue(typ, nil), true
+	case n < 3:
+		return tftypes.NewValue(typ, tftypes.UnknownValue), true
+	default:
+		return tftypes.Value{}, false
+	}
+}
+
+// value returns a random value of the type, which may be null or unknown.
+func (g *generator) value(typ tftypes.Type, depth int) tftypes.Value {
+	if v, ok := g.nullOrUnknown(typ); ok {
+		return v
+	}
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		return tftypes.NewValue(typ, g.rand.Intn(2) == 0)
+	case typ.Is(tftypes.Number):
+		return tftypes.NewValue(typ, g.number())
+	case typ.Is(tftypes.String):
+		return tftypes.NewValue(typ, g.string())
+	case typ.Is(tftypes.DynamicPseudoType):
+		if depth > 0 {
+			return tftypes.NewValue(tftypes.String, g.string())
+		}
+
+		primitives := []tftypes.Type{tftypes.Bool, tftypes.Number, tftypes.String}
+
+		return g.value(primitives[g.rand.Intn(len(primitives))], depth+1)
+	case typ.Is(tftypes.List{}):
+		elemType := typ.(tftypes.List).ElementType
+
+		return tftypes.NewValue(typ, g.elements(func() tftypes.Value { return g.value(elemType, depth+1) }, false))
+	case typ.Is(tftypes.Set{}):
+		elemType := typ.(tftypes.Set).ElementType
+
+		return tftypes.NewValue(typ, g.elements(func() tftypes.Value { return g.value(elemType, depth+1) }, true))
+	case typ.Is(tftypes.Map{}):
+		elemType := typ.(tftypes.Map).ElementType
+		elements := make(map[string]tftypes.Value)
+
+		for i := g.rand.Intn(maxElements + 1); i > 0; i-- {
+			elements[g.string()] = g.value(elemType, depth+1)
+		}
+
+		return tftypes.NewValue(typ, elements)
+	case typ.Is(tftypes.Object{}):
+		objectType := typ.(tftypes.Object)
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+		for _, name := range fwschema.SortedNames(objectType.AttributeTypes) {
+			values[name] = g.value(objectType.AttributeTypes[name], depth+1)
+		}
+
+		return tftypes.NewValue(typ, values)
+	case typ.Is(tftypes.Tuple{}):
+		tupleType := typ.(tftypes.Tuple)
+		values := make([]tftypes.Value, 0, len(tupleType.ElementTypes))
+
+		for _, elemType := range tupleType.ElementTypes {
+			values = append(values, g.value(elemType, depth+1))
+		}
+
+		return tftypes.NewValue(typ, values)
+	default:
+		return tftypes.NewValue(typ, nil)
+	}
+}
+
+// number returns an edge case number half of the time, otherwise a random
+// integer or fraction.
+func (g *generator) number() *big.Float {
+	switch g.rand.Intn(4) {
+	case 0, 1:
+		return new(big.Float).Copy(generatorNumbers[g.rand.Intn(len(generatorNumbers))])
+	case 2:
+		return big.NewFloat(float64(g.rand.Int63n(2001) - 1000))
+	default:
+		return big.NewFloat(g.rand.NormFloat64() * 1000)
+	}
+}
+
+// string returns an edge case string half of the time, otherwise a random
+// printable ASCII string.
+func (g *generator) string() string {
+	if g.rand.Intn(2) == 0 {
+		return generatorStrings[g.rand.Intn(len(generatorStrings))]
+	}
+
+	b := make([]byte, g.rand.Intn(16)+1)
+
+	for i := range b {
+		b[i] = byte(' ' + g.rand.Intn('~'-' '+1))
+	}
+
+	return string(b)
+}
+
+// containsValue returns true if the values contain an equal value.
+func containsValue(values []tftypes.Value, value tftypes.Value) bool {
+	for _, v := range values {
+		if v.Equal(value) {
+			return true
+		}
+	}
+
+	return false
+}
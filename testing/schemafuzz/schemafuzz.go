@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemafuzz
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// DefaultIterations is the number of random configurations validated by Test
+// when the Options Iterations field is zero.
+const DefaultIterations = 100
+
+// Options contains the settings for Test and Fuzz.
+type Options struct {
+	// Examples are configurations of the schema type, such as the
+	// configurations of acceptance tests. Each example is validated as-is
+	// and its attribute values are mixed into random configurations, which
+	// exercises validators with values that are more likely to be valid.
+	Examples []tftypes.Value
+
+	// Iterations is the number of random configurations validated by Test.
+	// If zero, DefaultIterations is used. Fuzz ignores this field.
+	Iterations int
+
+	// Seed is the random seed for generating configurations. If zero, Test
+	// uses the current time. Fuzz adds the seed to the seed corpus. The seed
+	// is included in failure messages to reproduce a failure.
+	Seed int64
+}
+
+// Test validates the Options Examples and the given number of random
+// configurations against the schema, failing the test if validation panics
+// or returns an error or warning diagnostic without an attribute path.
+//
+// The schema is the Schema field of a datasource.SchemaResponse,
+// provider.SchemaResponse, or resource.SchemaResponse.
+func Test(t testing.TB, schema fwschema.Schema, opts Options) {
+	t.Helper()
+
+	for i, example := range opts.Examples {
+		check(t, schema, example, fmt.Sprintf("example %d", i))
+	}
+
+	iterations := opts.Iterations
+
+	if iterations == 0 {
+		iterations = DefaultIterations
+	}
+
+	seed := opts.Seed
+
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	g := newGenerator(schema, seed, opts.Examples)
+
+	for i := 0; i < iterations; i++ {
+		check(t, schema, g.config(), fmt.Sprintf("seed %d, iteration %d", seed, i))
+	}
+}
+
+// Fuzz registers a fuzz target which validates a random configuration per
+// fuzzing input against the schema, failing if validation panics or returns
+// an error or warning diagnostic without an attribute path. The Options
+// Examples are validated before fuzzing.
+//
+// The schema is the Schema field of a datasource.SchemaResponse,
+// provider.SchemaResponse, or resource.SchemaResponse.
+func Fuzz(f *testing.F, schema fwschema.Schema, opts Options) {
+	f.Helper()
+
+	for i, example := range opts.Examples {
+		check(f, schema, example, fmt.Sprintf("example %d", i))
+	}
+
+	f.Add(opts.Seed)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		check(t, schema, newGenerator(schema, seed, opts.Examples).config(), fmt.Sprintf("seed %d", seed))
+	})
+}
+
+// check validates the configuration against the schema, reporting any panic
+// or diagnostic without an attribute path as a test error.
+func check(t testing.TB, schema fwschema.Schema, raw tftypes.Value, description string) {
+	t.Helper()
+
+	diags, panicMessage := validate(context.Background(), schema, raw)
+
+	if panicMessage != "" {
+		t.Errorf("%s: validation panicked: %s\n\nConfiguration: %s", description, panicMessage, raw)
+
+		return
+	}
+
+	for _, d := range diags {
+		if _, ok := d.(diag.DiagnosticWithPath); ok {
+			continue
+		}
+
+		// The schema deprecation warning is intentionally not associated
+		// with an attribute.
+		if d.Severity() == diag.SeverityWarning && d.Summary() == "Deprecated" && d.Detail() == schema.GetDeprecationMessage() {
+			continue
+		}
+
+		t.Errorf("%s: %s diagnostic without attribute path: %s: %s\n\nConfiguration: %s", description, d.Severity(), d.Summary(), d.Detail(), raw)
+	}
+}
+
+// validate returns the diagnostics of validating the configuration against
+// the schema, or a message including the stack trace if validation panics.
+func validate(ctx context.Context, schema fwschema.Schema, raw tftypes.Value) (diags diag.Diagnostics, panicMessage string) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicMessage = fmt.Sprintf("%v\n\n%s", r, debug.Stack())
+		}
+	}()
+
+	req := fwserver.ValidateSchemaRequest{
+		Config: tfsdk.Config{
+			Raw:    raw,
+			Schema: schema,
+		},
+	}
+	resp := &fwserver.ValidateSchemaResponse{}
+
+	fwserver.SchemaValidate(ctx, schema, req, resp)
+
+	return resp.Diagnostics, ""
+}
@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemafuzz_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/testing/schemafuzz"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/jsontypes"
+)
+
+// recorder captures test errors instead of failing the test.
+type recorder struct {
+	testing.TB
+
+	errors []string
+}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Helper() {}
+
+func TestTest(t *testing.T) {
+	t.Parallel()
+
+	pathValidator := testvalidator.String{
+		ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if req.ConfigValue.ValueString() == "" {
+				resp.Diagnostics.AddAttributeError(req.Path, "Empty", "must not be empty")
+			}
+		},
+	}
+
+	testCases := map[string]struct {
+		schema         schema.Schema
+		opts           schemafuzz.Options
+		expectedErrors []string
+	}{
+		"all-attribute-kinds": {
+			schema: schema.Schema{
+				DeprecationMessage: "use another resource",
+				Attributes: map[string]schema.Attribute{
+					"bool": schema.BoolAttribute{Optional: true},
+					"computed": schema.StringAttribute{
+						Computed:   true,
+						Validators: []validator.String{pathValidator},
+					},
+					"dynamic": schema.DynamicAttribute{Optional: true},
+					"float64": schema.Float64Attribute{Optional: true},
+					"int64":   schema.Int64Attribute{Optional: true},
+					"json": schema.StringAttribute{
+						CustomType: jsontypes.NormalizedType{},
+						Optional:   true,
+					},
+					"list": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"list_nested": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"string": schema.StringAttribute{
+									Required:   true,
+									Validators: []validator.String{pathValidator},
+								},
+							},
+						},
+						Optional: true,
+					},
+					"map": schema.MapAttribute{
+						ElementType: types.Int64Type,
+						Optional:    true,
+					},
+					"map_nested": schema.MapNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"bool": schema.BoolAttribute{Optional: true},
+							},
+						},
+						Optional: true,
+					},
+					"number": schema.NumberAttribute{Optional: true},
+					"object": schema.ObjectAttribute{
+						AttributeTypes: map[string]attr.Type{
+							"list": types.ListType{ElemType: types.BoolType},
+						},
+						Optional: true,
+					},
+					"set": schema.SetAttribute{
+						ElementType: types.BoolType,
+						Optional:    true,
+					},
+					"set_nested": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"int64": schema.Int64Attribute{Optional: true},
+							},
+						},
+						Optional: true,
+					},
+					"single_nested": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"string": schema.StringAttribute{
+								Optional:   true,
+								Validators: []validator.String{pathValidator},
+							},
+						},
+						Optional: true,
+					},
+					"string": schema.StringAttribute{
+						Required:   true,
+						Validators: []validator.String{pathValidator},
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"list_block": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"string": schema.StringAttribute{Optional: true},
+							},
+							Blocks: map[string]schema.Block{
+								"single_block": schema.SingleNestedBlock{
+									Attributes: map[string]schema.Attribute{
+										"float64": schema.Float64Attribute{Optional: true},
+									},
+								},
+							},
+						},
+					},
+					"set_block": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"bool": schema.BoolAttribute{Optional: true},
+							},
+						},
+					},
+				},
+			},
+			opts: schemafuzz.Options{
+				Iterations: 500,
+				Seed:       1,
+			},
+		},
+		"panic": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"string": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							testvalidator.String{
+								ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+									_ = req.ConfigValue.ValueString()[0]
+								},
+							},
+						},
+					},
+				},
+			},
+			opts: schemafuzz.Options{
+				Iterations: 50,
+				Seed:       1,
+			},
+			expectedErrors: []string{"validation panicked: runtime error: index out of range [0] with length 0"},
+		},
+		"diagnostic-without-path": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"string": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							testvalidator.String{
+								ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+									if !req.ConfigValue.IsNull() {
+										resp.Diagnostics.AddError("Invalid", "invalid value")
+									}
+								},
+							},
+						},
+					},
+				},
+			},
+			opts: schemafuzz.Options{
+				Iterations: 50,
+				Seed:       1,
+			},
+			expectedErrors: []string{"Error diagnostic without attribute path: Invalid: invalid value"},
+		},
+		"examples": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"string": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							testvalidator.String{
+								ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+									if req.ConfigValue.ValueString() == "example" {
+										resp.Diagnostics.AddWarning("Example", "example value")
+									}
+								},
+							},
+						},
+					},
+				},
+			},
+			opts: schemafuzz.Options{
+				Examples: []tftypes.Value{
+					tftypes.NewValue(
+						tftypes.Object{AttributeTypes: map[string]tftypes.Type{"string": tftypes.String}},
+						map[string]tftypes.Value{"string": tftypes.NewValue(tftypes.String, "example")},
+					),
+				},
+				Iterations: 1,
+				Seed:       1,
+			},
+			expectedErrors: []string{"example 0: Warning diagnostic without attribute path: Example: example value"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &recorder{TB: t}
+
+			schemafuzz.Test(r, testCase.schema, testCase.opts)
+
+			for _, expected := range testCase.expectedErrors {
+				if !containsSubstring(r.errors, expected) {
+					t.Errorf("expected error containing %q, got: %v", expected, r.errors)
+				}
+			}
+
+			if len(testCase.expectedErrors) == 0 && len(r.errors) > 0 {
+				t.Errorf("unexpected errors: %s", strings.Join(r.errors, "\n\n"))
+			}
+		})
+	}
+}
+
+func TestTest_Reproducible(t *testing.T) {
+	t.Parallel()
+
+	var configs [2][]string
+
+	for i := range configs {
+		s := schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"string": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						testvalidator.String{
+							ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+								configs[i] = append(configs[i], req.ConfigValue.String())
+							},
+						},
+					},
+				},
+			},
+		}
+
+		schemafuzz.Test(t, s, schemafuzz.Options{Iterations: 20, Seed: 42})
+	}
+
+	if strings.Join(configs[0], ",") != strings.Join(configs[1], ",") {
+		t.Errorf("expected the same configurations for the same seed, got:\n%v\n%v", configs[0], configs[1])
+	}
+}
+
+func FuzzFuzz(f *testing.F) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"list_nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"string": schema.StringAttribute{Optional: true},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	schemafuzz.Fuzz(f, s, schemafuzz.Options{Seed: 1})
+}
+
+func containsSubstring(values []string, substring string) bool {
+	for _, value := range values {
+		if strings.Contains(value, substring) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestClone(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"tags": tftypes.List{ElementType: tftypes.String},
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"tags": testschema.Attribute{
+				Type:     types.ListType{ElemType: types.StringType},
+				Optional: true,
+			},
+		},
+	}
+
+	// Each test case clones the given raw value with the Clone method of its
+	// type and returns the Raw value of the clone.
+	testCases := map[string]func(tftypes.Value) tftypes.Value{
+		"config": func(raw tftypes.Value) tftypes.Value {
+			return tfsdk.Config{Raw: raw, Schema: testSchema}.Clone().Raw
+		},
+		"plan": func(raw tftypes.Value) tftypes.Value {
+			return tfsdk.Plan{Raw: raw, Schema: testSchema}.Clone().Raw
+		},
+		"state": func(raw tftypes.Value) tftypes.Value {
+			return tfsdk.State{Raw: raw, Schema: testSchema}.Clone().Raw
+		},
+	}
+
+	for name, clone := range testCases {
+		name, clone := name, clone
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			original := tftypes.NewValue(testType, map[string]tftypes.Value{
+				"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "original"),
+				}),
+			})
+			expected := original.Copy()
+
+			// Mutate the underlying data of each clone concurrently, which the
+			// race detector reports if any of it is shared.
+			var wg sync.WaitGroup
+
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					var attributes map[string]tftypes.Value
+
+					if err := clone(original).As(&attributes); err != nil {
+						t.Errorf("unexpected error: %s", err)
+
+						return
+					}
+
+					var tags []tftypes.Value
+
+					if err := attributes["tags"].As(&tags); err != nil {
+						t.Errorf("unexpected error: %s", err)
+
+						return
+					}
+
+					tags[0] = tftypes.NewValue(tftypes.String, "mutated")
+					attributes["tags"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil)
+				}()
+			}
+
+			wg.Wait()
+
+			if diff := cmp.Diff(original, expected); diff != "" {
+				t.Errorf("unexpected original value difference: %s", diff)
+			}
+		})
+	}
+}
@@ -15,7 +15,14 @@ import (
 
 // Config represents a Terraform config.
 type Config struct {
-	Raw    tftypes.Value
+	// Raw is the entire configuration data as a terraform-plugin-go value, which
+	// matches the Schema type. Logic that needs to analyze the whole value,
+	// such as walking every attribute with tftypes.Walk, can read it directly
+	// instead of calling GetAttribute for each path. Values passed to
+	// provider logic, such as validator requests, are copies, so changing
+	// Raw does not affect the framework's data.
+	Raw tftypes.Value
+
 	Schema fwschema.Schema
 }
 
@@ -29,6 +36,9 @@ func (c Config) Get(ctx context.Context, target interface{}) diag.Diagnostics {
 // attributes or blocks. Use `types` package methods or custom types to step
 // into collections.
 //
+// Only the data at the given path is converted, which makes this method more
+// efficient than Get when only a few values are needed from large data.
+//
 // Attributes or elements under null or unknown collections return null
 // values, however this behavior is not protected by compatibility promises.
 func (c Config) GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics {
@@ -44,6 +54,17 @@ func (c Config) PathMatches(ctx context.Context, pathExpr path.Expression) (path
 	return c.data().PathMatches(ctx, pathExpr)
 }
 
+// Clone returns a deep copy of the configuration. The Raw value of the copy does not
+// share any underlying data, such as maps or slices returned by its As method,
+// so modifying one cannot affect the other. The Schema is not copied, as it is
+// not modified by the framework.
+func (c Config) Clone() Config {
+	return Config{
+		Raw:    c.Raw.Copy(),
+		Schema: c.Schema,
+	}
+}
+
 func (c Config) data() fwschemadata.Data {
 	return fwschemadata.Data{
 		Description:    fwschemadata.DataDescriptionConfiguration,
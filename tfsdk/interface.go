@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"fmt"
+	goreflect "reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+)
+
+// RegisterInterface registers the implementations of the interface type I,
+// so struct fields, slice elements, and map values declared as I can be
+// converted from and into objects. The value of the string object attribute
+// named `discriminator` selects which implementation an object is converted
+// into, using the keys of `implementations`. Each value of `implementations`
+// must be a struct or a pointer to a struct; only its type is used.
+//
+// When converting an object into an implementation, object attributes without
+// a matching struct field are ignored, so a nested object can hold the union
+// of the attributes of all implementations. When converting an implementation
+// into an object, attributes without a matching struct field are null and the
+// discriminator attribute is set to the implementation's key.
+//
+// RegisterInterface is intended to be called during package initialization
+// and panics if the registration is invalid, for example if I is not an
+// interface type or an implementation is already registered.
+//
+//	type Rule interface {
+//		isRule()
+//	}
+//
+//	func init() {
+//		tfsdk.RegisterInterface[Rule]("type", map[string]Rule{
+//			"allow": AllowRule{},
+//			"deny":  DenyRule{},
+//		})
+//	}
+func RegisterInterface[I any](discriminator string, implementations map[string]I) {
+	iface := goreflect.TypeOf((*I)(nil)).Elem()
+	impls := make(map[string]goreflect.Type, len(implementations))
+
+	for name, impl := range implementations {
+		impls[name] = goreflect.TypeOf(impl)
+	}
+
+	if err := reflect.RegisterInterface(iface, discriminator, impls); err != nil {
+		panic(fmt.Sprintf("tfsdk: unable to register interface: %s", err))
+	}
+}
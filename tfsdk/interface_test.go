@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"context"
+	"errors"
+	goreflect "reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testRule interface {
+	isTestRule()
+}
+
+type testAllowRule struct {
+	CIDR types.String `tfsdk:"cidr"`
+}
+
+func (testAllowRule) isTestRule() {}
+
+type testDenyRule struct {
+	CIDR   types.String `tfsdk:"cidr"`
+	Reason types.String `tfsdk:"reason"`
+}
+
+func (*testDenyRule) isTestRule() {}
+
+type testPortRule struct {
+	Match testPortMatch `tfsdk:"match"`
+}
+
+func (testPortRule) isTestRule() {}
+
+type testPortMatch struct {
+	Port types.Int64 `tfsdk:"port"`
+}
+
+type testUnregisteredRule interface {
+	isTestUnregisteredRule()
+}
+
+func init() {
+	RegisterInterface[testRule]("type", map[string]testRule{
+		"allow": testAllowRule{},
+		"deny":  &testDenyRule{},
+		"port":  testPortRule{},
+	})
+}
+
+var testRuleType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":   types.StringType,
+		"cidr":   types.StringType,
+		"reason": types.StringType,
+	},
+}
+
+func TestRegisterInterface_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	rules := types.ListValueMust(testRuleType, []attr.Value{
+		types.ObjectValueMust(testRuleType.AttrTypes, map[string]attr.Value{
+			"type":   types.StringValue("allow"),
+			"cidr":   types.StringValue("10.0.0.0/8"),
+			"reason": types.StringNull(),
+		}),
+		types.ObjectValueMust(testRuleType.AttrTypes, map[string]attr.Value{
+			"type":   types.StringValue("deny"),
+			"cidr":   types.StringValue("0.0.0.0/0"),
+			"reason": types.StringValue("default"),
+		}),
+	})
+
+	var got []testRule
+
+	diags := ValueAs(ctx, rules, &got)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %s", diags)
+	}
+
+	expected := []testRule{
+		testAllowRule{
+			CIDR: types.StringValue("10.0.0.0/8"),
+		},
+		&testDenyRule{
+			CIDR:   types.StringValue("0.0.0.0/0"),
+			Reason: types.StringValue("default"),
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	var roundTrip types.List
+
+	diags = ValueFrom(ctx, got, rules.Type(ctx), &roundTrip)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(roundTrip, rules); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestRegisterInterface_valueFrom(t *testing.T) {
+	t.Parallel()
+
+	matchType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"port":     types.Int64Type,
+			"protocol": types.StringType,
+		},
+	}
+
+	testCases := map[string]struct {
+		val           any
+		typ           attr.Type
+		expected      attr.Value
+		expectedError bool
+	}{
+		// Implementations used as concrete types are ordinary structs, so
+		// no discriminator attribute is set.
+		"concrete-implementation": {
+			val: testAllowRule{CIDR: types.StringValue("10.0.0.0/8")},
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"cidr": types.StringType,
+				},
+			},
+			expected: types.ObjectValueMust(
+				map[string]attr.Type{
+					"cidr": types.StringType,
+				},
+				map[string]attr.Value{
+					"cidr": types.StringValue("10.0.0.0/8"),
+				},
+			),
+		},
+		// Only the implementation struct itself allows undefined object
+		// attributes, not the structs nested within it.
+		"nested-struct-mismatch": {
+			val: []testRule{
+				testPortRule{Match: testPortMatch{Port: types.Int64Value(443)}},
+			},
+			typ: types.ListType{
+				ElemType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"type":  types.StringType,
+						"match": matchType,
+					},
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got attr.Value
+
+			diags := ValueFrom(context.Background(), testCase.val, testCase.typ, &got)
+
+			if diags.HasError() != testCase.expectedError {
+				t.Fatalf("expected error %t, got diagnostics: %v", testCase.expectedError, diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRegisterInterface_errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val      attr.Value
+		target   any
+		expected diag.Diagnostics
+	}{
+		"unknown-discriminator": {
+			val: types.ObjectValueMust(testRuleType.AttrTypes, map[string]attr.Value{
+				"type":   types.StringValue("log"),
+				"cidr":   types.StringNull(),
+				"reason": types.StringNull(),
+			}),
+			target: new(testRule),
+			expected: diag.Diagnostics{
+				diag.WithPath(
+					path.Root("type"),
+					reflect.DiagIntoIncompatibleType{
+						Val:        tftypes.NewValue(tftypes.String, "log"),
+						TargetType: goreflect.TypeOf((*testRule)(nil)).Elem(),
+						Err:        errors.New(`no implementation of tfsdk.testRule registered for "log", expected one of: allow, deny, port`),
+					},
+				),
+			},
+		},
+		"unregistered-interface": {
+			val: types.ObjectValueMust(testRuleType.AttrTypes, map[string]attr.Value{
+				"type":   types.StringValue("allow"),
+				"cidr":   types.StringNull(),
+				"reason": types.StringNull(),
+			}),
+			target: new(testUnregisteredRule),
+			expected: diag.Diagnostics{
+				diag.WithPath(
+					path.Empty(),
+					reflect.DiagIntoIncompatibleType{
+						Val: tftypes.NewValue(testRuleType.TerraformType(context.Background()), map[string]tftypes.Value{
+							"type":   tftypes.NewValue(tftypes.String, "allow"),
+							"cidr":   tftypes.NewValue(tftypes.String, nil),
+							"reason": tftypes.NewValue(tftypes.String, nil),
+						}),
+						TargetType: goreflect.TypeOf((*testUnregisteredRule)(nil)).Elem(),
+						Err:        errors.New("no implementations registered for interface tfsdk.testUnregisteredRule"),
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := ValueAs(context.Background(), testCase.val, testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRegisterInterface_panics(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(){
+		"not-interface": func() {
+			RegisterInterface[testAllowRule]("type", map[string]testAllowRule{
+				"allow": {},
+			})
+		},
+		"empty-discriminator": func() {
+			RegisterInterface[testUnregisteredRule]("", map[string]testUnregisteredRule{})
+		},
+		"already-registered": func() {
+			RegisterInterface[testRule]("type", map[string]testRule{
+				"allow": testAllowRule{},
+			})
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expected panic, got none")
+				}
+			}()
+
+			testCase()
+		})
+	}
+}
@@ -15,7 +15,14 @@ import (
 
 // Plan represents a Terraform plan.
 type Plan struct {
-	Raw    tftypes.Value
+	// Raw is the entire plan data as a terraform-plugin-go value, which
+	// matches the Schema type. Logic that needs to analyze the whole value,
+	// such as walking every attribute with tftypes.Walk, can read it directly
+	// instead of calling GetAttribute for each path. Values passed to
+	// provider logic, such as validator requests, are copies, so changing
+	// Raw does not affect the framework's data.
+	Raw tftypes.Value
+
 	Schema fwschema.Schema
 }
 
@@ -29,6 +36,9 @@ func (p Plan) Get(ctx context.Context, target interface{}) diag.Diagnostics {
 // attributes or blocks. Use `types` package methods or custom types to step
 // into collections.
 //
+// Only the data at the given path is converted, which makes this method more
+// efficient than Get when only a few values are needed from large data.
+//
 // Attributes or elements under null or unknown collections return null
 // values, however this behavior is not protected by compatibility promises.
 func (p Plan) GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics {
@@ -44,6 +54,17 @@ func (p Plan) PathMatches(ctx context.Context, pathExpr path.Expression) (path.P
 	return p.data().PathMatches(ctx, pathExpr)
 }
 
+// Clone returns a deep copy of the plan. The Raw value of the copy does not
+// share any underlying data, such as maps or slices returned by its As method,
+// so modifying one cannot affect the other. The Schema is not copied, as it is
+// not modified by the framework.
+func (p Plan) Clone() Plan {
+	return Plan{
+		Raw:    p.Raw.Copy(),
+		Schema: p.Schema,
+	}
+}
+
 // Set populates the entire plan using the supplied Go value. The value `val`
 // should be a struct whose values have one of the attr.Value types. Each field
 // must be tagged with the corresponding schema field.
@@ -12,12 +12,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // State represents a Terraform state.
 type State struct {
-	Raw    tftypes.Value
+	// Raw is the entire state data as a terraform-plugin-go value, which
+	// matches the Schema type. Logic that needs to analyze the whole value,
+	// such as walking every attribute with tftypes.Walk, can read it directly
+	// instead of calling GetAttribute for each path. Values passed to
+	// provider logic, such as validator requests, are copies, so changing
+	// Raw does not affect the framework's data.
+	Raw tftypes.Value
+
 	Schema fwschema.Schema
 }
 
@@ -31,6 +39,9 @@ func (s State) Get(ctx context.Context, target interface{}) diag.Diagnostics {
 // attributes or blocks. Use `types` package methods or custom types to step
 // into collections.
 //
+// Only the data at the given path is converted, which makes this method more
+// efficient than Get when only a few values are needed from large data.
+//
 // Attributes or elements under null or unknown collections return null
 // values, however this behavior is not protected by compatibility promises.
 func (s State) GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics {
@@ -46,6 +57,17 @@ func (s State) PathMatches(ctx context.Context, pathExpr path.Expression) (path.
 	return s.data().PathMatches(ctx, pathExpr)
 }
 
+// Clone returns a deep copy of the state. The Raw value of the copy does not
+// share any underlying data, such as maps or slices returned by its As method,
+// so modifying one cannot affect the other. The Schema is not copied, as it is
+// not modified by the framework.
+func (s State) Clone() State {
+	return State{
+		Raw:    s.Raw.Copy(),
+		Schema: s.Schema,
+	}
+}
+
 // Set populates the entire state using the supplied Go value. The value `val`
 // should be a struct whose values have one of the attr.Value types. Each field
 // must be tagged with the corresponding schema field.
@@ -97,11 +119,42 @@ func (s *State) SetAttribute(ctx context.Context, path path.Path, val interface{
 	return diags
 }
 
+// SetPartial merges the supplied Go value into the existing state. The value
+// `val` should be a struct whose values have one of the attr.Value types.
+// Each field must be tagged with the corresponding root schema attribute or
+// block name, however unlike Set, the struct does not need fields for every
+// attribute and block. Attributes and blocks without a field keep their
+// existing state value, which is useful when only some attributes were
+// changed, such as after an error partway through an Update method.
+//
+// If any field cannot be set, the state is left unchanged.
+func (s *State) SetPartial(ctx context.Context, val interface{}) diag.Diagnostics {
+	data := s.data()
+	diags := data.SetPartial(ctx, val)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	s.Raw = data.TerraformValue
+
+	return diags
+}
+
 // RemoveResource removes the entire resource from state.
 //
 // If a Resource type Delete method is completed without error, this is
 // automatically called on the DeleteResourceResponse.State.
+//
+// The Schema must be set. Otherwise, an error is logged and the state is left
+// unchanged, as the null value type cannot be determined.
 func (s *State) RemoveResource(ctx context.Context) {
+	if s.Schema == nil {
+		logging.FrameworkError(ctx, "Unable to remove resource from state without a schema. The framework sets the schema of request and response state. If the state was created outside the framework, such as in a unit test, set its Schema field before calling RemoveResource.")
+
+		return
+	}
+
 	s.Raw = tftypes.NewValue(s.Schema.Type().TerraformType(ctx), nil)
 }
 
@@ -271,6 +271,25 @@ func TestStateSet(t *testing.T) {
 			}),
 			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
+		"schema-missing": {
+			state: tfsdk.State{},
+			val: struct {
+				Name string `tfsdk:"name"`
+			}{
+				Name: "newvalue",
+			},
+			expected: tftypes.Value{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"State Write Error",
+					"An unexpected error was encountered trying to write the state. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The state has no schema. The framework sets the schema when it creates the state "+
+						"of a request or response. If the state was created outside the framework, such as in a unit test, "+
+						"set its Schema field before calling its methods.",
+				),
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -291,6 +310,100 @@ func TestStateSet(t *testing.T) {
 	}
 }
 
+func TestStateSetPartial(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"name": tftypes.String,
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"id": testschema.Attribute{
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": testschema.Attribute{
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}
+
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, "test-id"),
+			"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+		}),
+		Schema: testSchema,
+	}
+
+	type testCase struct {
+		state         tfsdk.State
+		val           interface{}
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		// Refer to fwschemadata.TestDataSetPartial for more exhaustive unit
+		// testing. These test cases are to ensure State schema and data
+		// values are passed appropriately to the shared implementation.
+		"valid": {
+			state: testState,
+			val: struct {
+				Name string `tfsdk:"name"`
+			}{
+				Name: "newvalue",
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "test-id"),
+				"name": tftypes.NewValue(tftypes.String, "newvalue"),
+			}),
+		},
+		"diagnostics": {
+			state: testState,
+			val: struct {
+				Name    string `tfsdk:"name"`
+				Missing string `tfsdk:"missing"`
+			}{
+				Name:    "newvalue",
+				Missing: "test",
+			},
+			expected: testState.Raw,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("missing"),
+					"State Write Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.state.SetPartial(context.Background(), tc.val)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.state.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestStateSetAttribute(t *testing.T) {
 	t.Parallel()
 
@@ -373,6 +486,47 @@ func TestStateSetAttribute(t *testing.T) {
 				testtypes.TestWarningDiagnostic(path.Root("name")),
 			},
 		},
+		"raw-missing": {
+			state: tfsdk.State{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			path:     path.Root("test"),
+			val:      "newvalue",
+			expected: tftypes.Value{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"State Write Error",
+					"An unexpected error was encountered trying to write the state. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The state has no value to update. The framework sets the value when it creates the state "+
+						"of a request or response. If the state was created outside the framework, such as in a unit test, "+
+						"set its Raw field to a value of the schema type, such as a null value, or call Set to write the entire state first.",
+				),
+			},
+		},
+		"schema-missing": {
+			state:    tfsdk.State{},
+			path:     path.Root("test"),
+			val:      "newvalue",
+			expected: tftypes.Value{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"State Write Error",
+					"An unexpected error was encountered trying to write the state. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The state has no schema. The framework sets the schema when it creates the state "+
+						"of a request or response. If the state was created outside the framework, such as in a unit test, "+
+						"set its Schema field before calling its methods.",
+				),
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -392,3 +546,57 @@ func TestStateSetAttribute(t *testing.T) {
 		})
 	}
 }
+
+func TestStateRemoveResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		state    tfsdk.State
+		expected tftypes.Value
+	}{
+		"valid": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "value"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+				},
+			}, nil),
+		},
+		"schema-missing": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.String, "value"),
+			},
+			expected: tftypes.NewValue(tftypes.String, "value"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.state.RemoveResource(context.Background())
+
+			if diff := cmp.Diff(testCase.state.Raw, testCase.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
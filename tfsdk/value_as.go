@@ -13,10 +13,33 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
+// ValueAsOptions is a collection of toggles to control the behavior of
+// ValueAsWithOptions.
+type ValueAsOptions struct {
+	// UnhandledNullAsEmpty controls what happens when ValueAs needs to put a
+	// null value in a type that has no way to preserve that distinction.
+	// When set to true, the type's empty value will be used. When set to
+	// false, an error will be returned.
+	UnhandledNullAsEmpty bool
+
+	// UnhandledUnknownAsEmpty controls what happens when ValueAs needs to put
+	// an unknown value in a type that has no way to preserve that
+	// distinction. When set to true, the type's empty value will be used.
+	// When set to false, an error will be returned.
+	UnhandledUnknownAsEmpty bool
+}
+
 // ValueAs takes the attr.Value `val` and populates the Go value `target` with its content.
 //
 // This is achieved using reflection rules provided by the internal/reflect package.
 func ValueAs(ctx context.Context, val attr.Value, target interface{}) diag.Diagnostics {
+	return ValueAsWithOptions(ctx, val, target, ValueAsOptions{})
+}
+
+// ValueAsWithOptions is like ValueAs, but allows null and unknown values
+// to be converted into the empty value of Go types which cannot represent
+// them, as controlled by `opts`.
+func ValueAsWithOptions(ctx context.Context, val attr.Value, target interface{}, opts ValueAsOptions) diag.Diagnostics {
 	if reflect.IsGenericAttrValue(ctx, target) {
 		//nolint:forcetypeassert // Type assertion is guaranteed by the above `reflect.IsGenericAttrValue` function
 		*(target.(*attr.Value)) = val
@@ -27,5 +50,8 @@ func ValueAs(ctx context.Context, val attr.Value, target interface{}) diag.Diagn
 		return diag.Diagnostics{diag.NewErrorDiagnostic("Error converting value",
 			fmt.Sprintf("An unexpected error was encountered converting a %T to its equivalent Terraform representation. This is always a bug in the provider.\n\nError: %s", val, err))}
 	}
-	return reflect.Into(ctx, val.Type(ctx), raw, target, reflect.Options{}, path.Empty())
+	return reflect.Into(ctx, val.Type(ctx), raw, target, reflect.Options{
+		UnhandledNullAsEmpty:    opts.UnhandledNullAsEmpty,
+		UnhandledUnknownAsEmpty: opts.UnhandledUnknownAsEmpty,
+	}, path.Empty())
 }
@@ -422,3 +422,85 @@ func TestValueAs_generic(t *testing.T) {
 		t.Errorf("Expected target to be %v, got %v", val, target)
 	}
 }
+
+func TestValueAsWithOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val           attr.Value
+		opts          ValueAsOptions
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			val: types.StringNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+						"Path: \nTarget Type: string\nSuggested `types` Type: basetypes.StringValue\nSuggested Pointer Type: *string",
+				),
+			},
+		},
+		"null-UnhandledNullAsEmpty": {
+			val: types.StringNull(),
+			opts: ValueAsOptions{
+				UnhandledNullAsEmpty: true,
+			},
+			expected: "",
+		},
+		"unknown": {
+			val: types.StringUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Path: \nTarget Type: string\nSuggested Type: basetypes.StringValue",
+				),
+			},
+		},
+		"unknown-UnhandledUnknownAsEmpty": {
+			val: types.StringUnknown(),
+			opts: ValueAsOptions{
+				UnhandledUnknownAsEmpty: true,
+			},
+			expected: "",
+		},
+		"known": {
+			val: types.StringValue("test"),
+			opts: ValueAsOptions{
+				UnhandledNullAsEmpty:    true,
+				UnhandledUnknownAsEmpty: true,
+			},
+			expected: "test",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			target := "not-set"
+
+			diags := ValueAsWithOptions(context.Background(), testCase.val, &target, testCase.opts)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Fatalf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(target, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -17,7 +17,7 @@ import (
 //
 // This is achieved using reflection rules provided by the internal/reflect package.
 func ValueFrom(ctx context.Context, val interface{}, targetType attr.Type, target interface{}) diag.Diagnostics {
-	v, diags := reflect.FromValue(ctx, targetType, val, path.Empty())
+	v, diags := reflect.FromValue(ctx, targetType, val, reflect.Options{}, path.Empty())
 	if diags.HasError() {
 		return diags
 	}
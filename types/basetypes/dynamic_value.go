@@ -140,6 +140,17 @@ func (v DynamicValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
+// IsFullyKnown returns true if the DynamicValue is not unknown and the
+// underlying value, including any nested values of collection, object, or
+// tuple values, is not unknown. A null DynamicValue is considered fully known.
+func (v DynamicValue) IsFullyKnown() bool {
+	if v.IsUnknown() {
+		return false
+	}
+
+	return isFullyKnown(v.value)
+}
+
 // String returns a human-readable representation of the DynamicValue. The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
 func (v DynamicValue) String() string {
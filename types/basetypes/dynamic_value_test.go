@@ -479,6 +479,54 @@ func TestDynamicValueString(t *testing.T) {
 	}
 }
 
+func TestDynamicValueIsFullyKnown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    DynamicValue
+		expected bool
+	}{
+		"known": {
+			input:    NewDynamicValue(NewStringValue("test")),
+			expected: true,
+		},
+		"known-underlying-null": {
+			input:    NewDynamicValue(NewStringNull()),
+			expected: true,
+		},
+		"known-underlying-unknown": {
+			input:    NewDynamicValue(NewStringUnknown()),
+			expected: false,
+		},
+		"known-nested-unknown": {
+			input:    NewDynamicValue(NewListValueMust(StringType{}, []attr.Value{NewStringUnknown()})),
+			expected: false,
+		},
+		"null": {
+			input:    NewDynamicNull(),
+			expected: true,
+		},
+		"unknown": {
+			input:    NewDynamicUnknown(),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.IsFullyKnown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestDynamicValueIsUnderlyingValueNull(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// fullyKnownValuable is implemented by value types which can contain other
+// values, such as collections, objects, and tuples. Custom value types which
+// embed the base value types implement this interface via method promotion.
+type fullyKnownValuable interface {
+	IsFullyKnown() bool
+}
+
+// isFullyKnown returns true if the given value is not unknown and, for values
+// which contain other values, all nested values are not unknown. Null values
+// are considered fully known.
+func isFullyKnown(value attr.Value) bool {
+	if value == nil {
+		return true
+	}
+
+	if value.IsUnknown() {
+		return false
+	}
+
+	if value.IsNull() {
+		return true
+	}
+
+	if v, ok := value.(fullyKnownValuable); ok {
+		return v.IsFullyKnown()
+	}
+
+	return true
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ListBuilder constructs a List value element by element. Elements are type
+// checked as they are added, and any problems are returned as diagnostics by
+// the Build method.
+//
+// ListBuilder is immutable: every method returns a new ListBuilder and leaves
+// the receiver unchanged, so a builder can be safely shared or reused as the
+// starting point for several lists.
+type ListBuilder struct {
+	// elementType is the type of the elements in the List.
+	elementType attr.Type
+
+	// elements is the ordered collection of elements added so far.
+	elements []attr.Value
+
+	// diags contains any problems found while adding elements.
+	diags diag.Diagnostics
+}
+
+// NewListBuilder creates a ListBuilder without elements for a List with the
+// given element type.
+func NewListBuilder(elementType attr.Type) ListBuilder {
+	return ListBuilder{
+		elementType: elementType,
+	}
+}
+
+// Builder returns a ListBuilder starting with the elements of the List, which
+// can be used to create a modified copy of the List. Builders for null or
+// unknown Lists start without elements.
+func (l ListValue) Builder() ListBuilder {
+	return ListBuilder{
+		elementType: l.elementType,
+		elements:    l.Elements(),
+	}
+}
+
+// Append returns a ListBuilder with the given elements added to the end. An
+// error diagnostic is recorded for each element which does not match the
+// element type, and that element is not added.
+func (b ListBuilder) Append(elements ...attr.Value) ListBuilder {
+	result := b.clone()
+
+	for _, element := range elements {
+		if !result.checkElementType(element, len(result.elements)) {
+			continue
+		}
+
+		result.elements = append(result.elements, element)
+	}
+
+	return result
+}
+
+// Set returns a ListBuilder with the element at the given index replaced. An
+// error diagnostic is recorded if the index is out of range or the element
+// does not match the element type.
+func (b ListBuilder) Set(index int, element attr.Value) ListBuilder {
+	result := b.clone()
+
+	if !result.checkIndex(index) || !result.checkElementType(element, index) {
+		return result
+	}
+
+	result.elements[index] = element
+
+	return result
+}
+
+// Remove returns a ListBuilder with the element at the given index removed,
+// shifting later elements down by one. An error diagnostic is recorded if the
+// index is out of range.
+func (b ListBuilder) Remove(index int) ListBuilder {
+	result := b.clone()
+
+	if !result.checkIndex(index) {
+		return result
+	}
+
+	result.elements = append(result.elements[:index], result.elements[index+1:]...)
+
+	return result
+}
+
+// Len returns the number of elements added so far.
+func (b ListBuilder) Len() int {
+	return len(b.elements)
+}
+
+// Build returns a known List containing the elements added so far. If any
+// error diagnostics were recorded while adding elements, or the builder has
+// no element type, an unknown List is returned with those diagnostics.
+func (b ListBuilder) Build() (ListValue, diag.Diagnostics) {
+	// A missing element type is reported by NewListValue.
+	if b.diags.HasError() && b.elementType != nil {
+		return NewListUnknown(b.elementType), b.diags
+	}
+
+	list, listDiags := NewListValue(b.elementType, b.clone().elements)
+
+	var diags diag.Diagnostics
+
+	diags.Append(b.diags...)
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+// clone returns a copy of the ListBuilder which does not share its elements
+// or diagnostics with the receiver.
+func (b ListBuilder) clone() ListBuilder {
+	result := ListBuilder{
+		elementType: b.elementType,
+		elements:    make([]attr.Value, 0, len(b.elements)+1),
+	}
+
+	result.elements = append(result.elements, b.elements...)
+
+	if len(b.diags) > 0 {
+		result.diags = make(diag.Diagnostics, 0, len(b.diags))
+		result.diags = append(result.diags, b.diags...)
+	}
+
+	return result
+}
+
+func (b *ListBuilder) checkElementType(element attr.Value, index int) bool {
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	if b.elementType == nil {
+		b.diags.AddError(
+			"Missing List Element Type",
+			"While creating a List value, a missing element type was detected. "+
+				"A List must define its element type. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return false
+	}
+
+	if element != nil && b.elementType.Equal(element.Type(ctx)) {
+		return true
+	}
+
+	elementType := "<nil>"
+
+	if element != nil {
+		elementType = element.Type(ctx).String()
+	}
+
+	b.diags.AddError(
+		"Invalid List Element Type",
+		"While building a List value, an invalid element was detected. "+
+			"A List must use the single, given element type. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("List Element Type: %s\n", b.elementType.String())+
+			fmt.Sprintf("List Index (%d) Element Type: %s", index, elementType),
+	)
+
+	return false
+}
+
+func (b *ListBuilder) checkIndex(index int) bool {
+	if index >= 0 && index < len(b.elements) {
+		return true
+	}
+
+	b.diags.AddError(
+		"Invalid List Index",
+		"While building a List value, an element index outside of the List was given. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("List Index: %d\n", index)+
+			fmt.Sprintf("List Length: %d", len(b.elements)),
+	)
+
+	return false
+}
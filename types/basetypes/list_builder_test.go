@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestListBuilder(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		builder       ListBuilder
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			builder:  NewListBuilder(StringType{}),
+			expected: NewListValueMust(StringType{}, []attr.Value{}),
+		},
+		"append": {
+			builder: NewListBuilder(StringType{}).
+				Append(NewStringValue("a")).
+				Append(NewStringValue("b"), NewStringNull()),
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("b"),
+				NewStringNull(),
+			}),
+		},
+		"append-invalid-element-type": {
+			builder: NewListBuilder(StringType{}).
+				Append(NewStringValue("a"), NewBoolValue(true)),
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While building a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.StringType\n"+
+						"List Index (1) Element Type: basetypes.BoolType",
+				),
+			},
+		},
+		"append-nil-element": {
+			builder:  NewListBuilder(StringType{}).Append(nil),
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While building a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.StringType\n"+
+						"List Index (0) Element Type: <nil>",
+				),
+			},
+		},
+		"append-missing-element-type": {
+			builder:  NewListBuilder(nil).Append(NewStringValue("a"), NewStringValue("b")),
+			expected: NewListUnknown(missingType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing List Element Type",
+					"While creating a List value, a missing element type was detected. "+
+						"A List must define its element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"missing-element-type": {
+			builder:  NewListBuilder(nil),
+			expected: NewListUnknown(missingType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing List Element Type",
+					"While creating a List value, a missing element type was detected. "+
+						"A List must define its element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"zero-value-list": {
+			builder:  ListValue{}.Builder().Set(0, NewStringValue("a")).Append(NewStringValue("a")),
+			expected: NewListUnknown(missingType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Index",
+					"While building a List value, an element index outside of the List was given. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Index: 0\n"+
+						"List Length: 0",
+				),
+				diag.NewErrorDiagnostic(
+					"Missing List Element Type",
+					"While creating a List value, a missing element type was detected. "+
+						"A List must define its element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"set": {
+			builder: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("b"),
+			}).Builder().Set(1, NewStringValue("c")),
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("c"),
+			}),
+		},
+		"set-out-of-range": {
+			builder: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+			}).Builder().Set(1, NewStringValue("c")),
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Index",
+					"While building a List value, an element index outside of the List was given. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Index: 1\n"+
+						"List Length: 1",
+				),
+			},
+		},
+		"remove": {
+			builder: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("b"),
+				NewStringValue("c"),
+			}).Builder().Remove(1),
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("c"),
+			}),
+		},
+		"remove-out-of-range": {
+			builder:  NewListBuilder(StringType{}).Remove(-1),
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Index",
+					"While building a List value, an element index outside of the List was given. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Index: -1\n"+
+						"List Length: 0",
+				),
+			},
+		},
+		"null-list": {
+			builder: NewListNull(StringType{}).Builder().Append(NewStringValue("a")),
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.builder.Build()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListBuilder_immutable(t *testing.T) {
+	t.Parallel()
+
+	list := NewListValueMust(StringType{}, []attr.Value{
+		NewStringValue("a"),
+	})
+	base := list.Builder().Append(NewStringValue("b"))
+
+	_ = base.Set(0, NewStringValue("changed"))
+	_ = base.Remove(1)
+	_ = base.Append(NewBoolValue(true))
+
+	got, diags := base.Build()
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %s", diags)
+	}
+
+	expected := NewListValueMust(StringType{}, []attr.Value{
+		NewStringValue("a"),
+		NewStringValue("b"),
+	})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(list, NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")})); diff != "" {
+		t.Errorf("unexpected list difference: %s", diff)
+	}
+
+	if base.Len() != 2 {
+		t.Errorf("expected length 2, got %d", base.Len())
+	}
+}
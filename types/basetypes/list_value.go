@@ -69,7 +69,31 @@ func NewListValue(elementType attr.Type, elements []attr.Value) (ListValue, diag
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
 	ctx := context.Background()
 
+	if elementType == nil {
+		diags.AddError(
+			"Missing List Element Type",
+			"While creating a List value, a missing element type was detected. "+
+				"A List must define its element type. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return NewListUnknown(missingType{}), diags
+	}
+
 	for idx, element := range elements {
+		if element == nil {
+			diags.AddError(
+				"Invalid List Element Type",
+				"While creating a List value, an invalid element was detected. "+
+					"A List must use the single, given element type. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("List Element Type: %s\n", elementType.String())+
+					fmt.Sprintf("List Index (%d) Element Type: <nil>", idx),
+			)
+
+			continue
+		}
+
 		if !elementType.Equal(element.Type(ctx)) {
 			diags.AddError(
 				"Invalid List Element Type",
@@ -101,6 +125,7 @@ func NewListValueFrom(ctx context.Context, elementType attr.Type, elements any)
 		ctx,
 		ListType{ElemType: elementType},
 		elements,
+		reflect.Options{},
 		path.Empty(),
 	)
 
@@ -205,6 +230,10 @@ func (l ListValue) Type(ctx context.Context) attr.Type {
 
 // ToTerraformValue returns the data contained in the List as a tftypes.Value.
 func (l ListValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	if l.elementType == nil {
+		return tftypes.Value{}, fmt.Errorf("missing List element type, create List values with the framework creation functions")
+	}
+
 	listType := tftypes.List{ElementType: l.ElementType(ctx).TerraformType(ctx)}
 
 	switch l.state {
@@ -302,6 +331,23 @@ func (l ListValue) IsUnknown() bool {
 	return l.state == attr.ValueStateUnknown
 }
 
+// IsFullyKnown returns true if the List is not unknown and none of its
+// elements are unknown, recursively checking nested collection, dynamic,
+// object, and tuple values. A null List is considered fully known.
+func (l ListValue) IsFullyKnown() bool {
+	if l.IsUnknown() {
+		return false
+	}
+
+	for _, element := range l.elements {
+		if !isFullyKnown(element) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // String returns a human-readable representation of the List value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
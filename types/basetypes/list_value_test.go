@@ -45,6 +45,38 @@ func TestNewListValue(t *testing.T) {
 				},
 			),
 		},
+		"nil-element": {
+			elementType: StringType{},
+			elements: []attr.Value{
+				NewStringValue("test"),
+				nil,
+			},
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While creating a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.StringType\n"+
+						"List Index (1) Element Type: <nil>",
+				),
+			},
+		},
+		"missing-element-type": {
+			elements: []attr.Value{
+				NewStringValue("test"),
+			},
+			expected: NewListUnknown(missingType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing List Element Type",
+					"While creating a List value, a missing element type was detected. "+
+						"A List must define its element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
 		"invalid-element-type": {
 			elementType: StringType{},
 			elements: []attr.Value{
@@ -256,6 +288,11 @@ func TestListValueToTerraformValue(t *testing.T) {
 		expectedErr string
 	}
 	tests := map[string]testCase{
+		"missing-element-type": {
+			input:       ListValue{},
+			expectation: tftypes.Value{},
+			expectedErr: "missing List element type, create List values with the framework creation functions",
+		},
 		"known": {
 			input: NewListValueMust(
 				StringType{},
@@ -683,6 +720,58 @@ func TestListValueIsNull(t *testing.T) {
 	}
 }
 
+func TestListValueIsFullyKnown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    ListValue
+		expected bool
+	}{
+		"known": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+			expected: true,
+		},
+		"known-element-null": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringNull()}),
+			expected: true,
+		},
+		"known-element-unknown": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("test"), NewStringUnknown()}),
+			expected: false,
+		},
+		"known-nested-unknown": {
+			input:    NewListValueMust(ListType{ElemType: StringType{}}, []attr.Value{NewListValueMust(StringType{}, []attr.Value{NewStringUnknown()})}),
+			expected: false,
+		},
+		"known-dynamic-unknown": {
+			input:    NewListValueMust(DynamicType{}, []attr.Value{NewDynamicValue(NewStringUnknown())}),
+			expected: false,
+		},
+		"null": {
+			input:    NewListNull(StringType{}),
+			expected: true,
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.IsFullyKnown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListValueIsUnknown(t *testing.T) {
 	t.Parallel()
 
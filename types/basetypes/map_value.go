@@ -70,7 +70,31 @@ func NewMapValue(elementType attr.Type, elements map[string]attr.Value) (MapValu
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
 	ctx := context.Background()
 
+	if elementType == nil {
+		diags.AddError(
+			"Missing Map Element Type",
+			"While creating a Map value, a missing element type was detected. "+
+				"A Map must define its element type. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return NewMapUnknown(missingType{}), diags
+	}
+
 	for key, element := range elements {
+		if element == nil {
+			diags.AddError(
+				"Invalid Map Element Type",
+				"While creating a Map value, an invalid element was detected. "+
+					"A Map must use the single, given element type. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Map Element Type: %s\n", elementType.String())+
+					fmt.Sprintf("Map Key (%s) Element Type: <nil>", key),
+			)
+
+			continue
+		}
+
 		if !elementType.Equal(element.Type(ctx)) {
 			diags.AddError(
 				"Invalid Map Element Type",
@@ -103,6 +127,7 @@ func NewMapValueFrom(ctx context.Context, elementType attr.Type, elements any) (
 		ctx,
 		MapType{ElemType: elementType},
 		elements,
+		reflect.Options{},
 		path.Empty(),
 	)
 
@@ -212,6 +237,10 @@ func (m MapValue) Type(ctx context.Context) attr.Type {
 
 // ToTerraformValue returns the data contained in the Map as a tftypes.Value.
 func (m MapValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	if m.elementType == nil {
+		return tftypes.Value{}, fmt.Errorf("missing Map element type, create Map values with the framework creation functions")
+	}
+
 	mapType := tftypes.Map{ElementType: m.ElementType(ctx).TerraformType(ctx)}
 
 	switch m.state {
@@ -309,6 +338,23 @@ func (m MapValue) IsUnknown() bool {
 	return m.state == attr.ValueStateUnknown
 }
 
+// IsFullyKnown returns true if the Map is not unknown and none of its
+// elements are unknown, recursively checking nested collection, dynamic,
+// object, and tuple values. A null Map is considered fully known.
+func (m MapValue) IsFullyKnown() bool {
+	if m.IsUnknown() {
+		return false
+	}
+
+	for _, element := range m.elements {
+		if !isFullyKnown(element) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // String returns a human-readable representation of the Map value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
@@ -45,6 +45,38 @@ func TestNewMapValue(t *testing.T) {
 				},
 			),
 		},
+		"nil-element": {
+			elementType: StringType{},
+			elements: map[string]attr.Value{
+				"test": NewStringValue("test"),
+				"nil":  nil,
+			},
+			expected: NewMapUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Map Element Type",
+					"While creating a Map value, an invalid element was detected. "+
+						"A Map must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Map Element Type: basetypes.StringType\n"+
+						"Map Key (nil) Element Type: <nil>",
+				),
+			},
+		},
+		"missing-element-type": {
+			elements: map[string]attr.Value{
+				"test": NewStringValue("test"),
+			},
+			expected: NewMapUnknown(missingType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Map Element Type",
+					"While creating a Map value, a missing element type was detected. "+
+						"A Map must define its element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
 		"invalid-element-type": {
 			elementType: StringType{},
 			elements: map[string]attr.Value{
@@ -259,6 +291,11 @@ func TestMapValueToTerraformValue(t *testing.T) {
 		expectedErr string
 	}
 	tests := map[string]testCase{
+		"missing-element-type": {
+			input:       MapValue{},
+			expectation: tftypes.Value{},
+			expectedErr: "missing Map element type, create Map values with the framework creation functions",
+		},
 		"known": {
 			input: NewMapValueMust(
 				StringType{},
@@ -686,6 +723,50 @@ func TestMapValueIsNull(t *testing.T) {
 	}
 }
 
+func TestMapValueIsFullyKnown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    MapValue
+		expected bool
+	}{
+		"known": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{"key": NewStringValue("test")}),
+			expected: true,
+		},
+		"known-element-unknown": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{"key1": NewStringValue("test"), "key2": NewStringUnknown()}),
+			expected: false,
+		},
+		"known-nested-unknown": {
+			input:    NewMapValueMust(ListType{ElemType: StringType{}}, map[string]attr.Value{"key": NewListValueMust(StringType{}, []attr.Value{NewStringUnknown()})}),
+			expected: false,
+		},
+		"null": {
+			input:    NewMapNull(StringType{}),
+			expected: true,
+		},
+		"unknown": {
+			input:    NewMapUnknown(StringType{}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.IsFullyKnown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestMapValueIsUnknown(t *testing.T) {
 	t.Parallel()
 
@@ -6,6 +6,7 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -159,6 +160,76 @@ func (n NumberValue) ValueBigFloat() *big.Float {
 	return n.value
 }
 
+// Cmp compares the known value of the Number with the known value of other
+// and returns -1 if the Number is less than other, 0 if they are equal, and
+// +1 if the Number is greater than other. Null and unknown values are compared
+// as 0.
+func (n NumberValue) Cmp(other NumberValue) int {
+	return n.bigFloatOrZero().Cmp(other.bigFloatOrZero())
+}
+
+// ValueInt64 returns the known value as an int64. If Number is null or
+// unknown, returns 0. An error diagnostic is returned if the value is not an
+// integer or cannot be represented as a 64-bit integer.
+func (n NumberValue) ValueInt64() (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value := n.bigFloatOrZero()
+
+	if !value.IsInt() {
+		diags.AddError(
+			"Number Conversion Error",
+			fmt.Sprintf("Value %s is not an integer.", value.String()),
+		)
+		return 0, diags
+	}
+
+	i, accuracy := value.Int64()
+
+	if accuracy != big.Exact {
+		diags.AddError(
+			"Number Conversion Error",
+			fmt.Sprintf("Value %s cannot be represented as a 64-bit integer.", value.String()),
+		)
+		return 0, diags
+	}
+
+	return i, diags
+}
+
+// ValueFloat64 returns the known value as a float64, rounded to the nearest
+// representable float64 if necessary. If Number is null or unknown, returns
+// 0.0. An error diagnostic is returned if the value is too small or too large
+// to be represented as a 64-bit floating point.
+func (n NumberValue) ValueFloat64() (float64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value := n.bigFloatOrZero()
+	f, accuracy := value.Float64()
+
+	// Underflow and overflow
+	// Reference: https://pkg.go.dev/math/big#Float.Float64
+	if (f == 0 && accuracy != big.Exact) || math.IsInf(f, 0) {
+		diags.AddError(
+			"Number Conversion Error",
+			fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", value.String()),
+		)
+		return 0, diags
+	}
+
+	return f, diags
+}
+
+// bigFloatOrZero returns the known *big.Float value or zero if the Number is
+// null or unknown.
+func (n NumberValue) bigFloatOrZero() *big.Float {
+	if n.value == nil {
+		return new(big.Float)
+	}
+
+	return n.value
+}
+
 // ToNumberValue returns Number.
 func (n NumberValue) ToNumberValue(context.Context) (NumberValue, diag.Diagnostics) {
 	return n, nil
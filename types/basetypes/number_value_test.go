@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -355,3 +356,175 @@ func TestNumberValueValueBigFloat(t *testing.T) {
 		})
 	}
 }
+
+func TestNumberValueCmp(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    NumberValue
+		other    NumberValue
+		expected int
+	}{
+		"less": {
+			input:    NewNumberValue(big.NewFloat(1.5)),
+			other:    NewNumberValue(big.NewFloat(2.4)),
+			expected: -1,
+		},
+		"equal": {
+			input:    NewNumberValue(big.NewFloat(2.4)),
+			other:    NewNumberValue(big.NewFloat(2.4)),
+			expected: 0,
+		},
+		"greater": {
+			input:    NewNumberValue(big.NewFloat(2.4)),
+			other:    NewNumberValue(big.NewFloat(1.5)),
+			expected: 1,
+		},
+		"null-zero": {
+			input:    NewNumberNull(),
+			other:    NewNumberValue(big.NewFloat(0)),
+			expected: 0,
+		},
+		"unknown-positive": {
+			input:    NewNumberUnknown(),
+			other:    NewNumberValue(big.NewFloat(1)),
+			expected: -1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Cmp(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %d, got: %d", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNumberValueValueInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         NumberValue
+		expected      int64
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input:    NewNumberValue(big.NewFloat(24)),
+			expected: 24,
+		},
+		"known-max": {
+			input:    NewNumberValue(new(big.Float).SetInt64(math.MaxInt64)),
+			expected: math.MaxInt64,
+		},
+		"known-not-integer": {
+			input: NewNumberValue(big.NewFloat(2.4)),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Number Conversion Error",
+					"Value 2.4 is not an integer.",
+				),
+			},
+		},
+		"known-overflow": {
+			input: NewNumberValue(new(big.Float).Mul(big.NewFloat(math.MaxInt64), big.NewFloat(2))),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Number Conversion Error",
+					"Value 1.844674407e+19 cannot be represented as a 64-bit integer.",
+				),
+			},
+		},
+		"null": {
+			input:    NewNumberNull(),
+			expected: 0,
+		},
+		"unknown": {
+			input:    NewNumberUnknown(),
+			expected: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.ValueInt64()
+
+			if got != testCase.expected {
+				t.Errorf("expected %d, got: %d", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNumberValueValueFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         NumberValue
+		expected      float64
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input:    NewNumberValue(big.NewFloat(2.4)),
+			expected: 2.4,
+		},
+		"known-overflow": {
+			input: NewNumberValue(new(big.Float).Mul(big.NewFloat(math.MaxFloat64), big.NewFloat(2))),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Number Conversion Error",
+					"Value 3.59538627e+308 cannot be represented as a 64-bit floating point.",
+				),
+			},
+		},
+		"known-underflow": {
+			input: NewNumberValue(new(big.Float).Quo(big.NewFloat(math.SmallestNonzeroFloat64), big.NewFloat(10))),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Number Conversion Error",
+					"Value 4.940656458e-325 cannot be represented as a 64-bit floating point.",
+				),
+			},
+		},
+		"null": {
+			input:    NewNumberNull(),
+			expected: 0,
+		},
+		"unknown": {
+			input:    NewNumberUnknown(),
+			expected: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.ValueFloat64()
+
+			if got != testCase.expected {
+				t.Errorf("expected %f, got: %f", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
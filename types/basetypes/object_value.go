@@ -131,6 +131,7 @@ func NewObjectValueFrom(ctx context.Context, attributeTypes map[string]attr.Type
 		ctx,
 		ObjectType{AttrTypes: attributeTypes},
 		attributes,
+		reflect.Options{},
 		path.Empty(),
 	)
 
@@ -207,6 +208,12 @@ type ObjectAsOptions struct {
 	// distinction. When set to true, the type's empty value will be used.
 	// When set to false, an error will be returned.
 	UnhandledUnknownAsEmpty bool
+
+	// ReportAllMismatches controls what happens when the attributes of the
+	// object, or any nested object, do not match the fields of the target
+	// struct. When set to true, every mismatch is reported at once. When set
+	// to false, an error will be returned for the first mismatched struct.
+	ReportAllMismatches bool
 }
 
 // As populates `target` with the data in the ObjectValue, throwing an error if the
@@ -227,6 +234,7 @@ func (o ObjectValue) As(ctx context.Context, target interface{}, opts ObjectAsOp
 	return reflect.Into(ctx, obj, val, target, reflect.Options{
 		UnhandledNullAsEmpty:    opts.UnhandledNullAsEmpty,
 		UnhandledUnknownAsEmpty: opts.UnhandledUnknownAsEmpty,
+		ReportAllMismatches:     opts.ReportAllMismatches,
 	}, path.Empty())
 }
 
@@ -361,6 +369,23 @@ func (o ObjectValue) IsUnknown() bool {
 	return o.state == attr.ValueStateUnknown
 }
 
+// IsFullyKnown returns true if the Object is not unknown and none of its
+// attributes are unknown, recursively checking nested collection, dynamic,
+// object, and tuple values. A null Object is considered fully known.
+func (o ObjectValue) IsFullyKnown() bool {
+	if o.IsUnknown() {
+		return false
+	}
+
+	for _, attribute := range o.attributes {
+		if !isFullyKnown(attribute) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // String returns a human-readable representation of the Object value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
@@ -1589,6 +1589,50 @@ func TestObjectValueIsNull(t *testing.T) {
 	}
 }
 
+func TestObjectValueIsFullyKnown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    ObjectValue
+		expected bool
+	}{
+		"known": {
+			input:    NewObjectValueMust(map[string]attr.Type{"test_attr": StringType{}}, map[string]attr.Value{"test_attr": NewStringValue("test")}),
+			expected: true,
+		},
+		"known-attribute-unknown": {
+			input:    NewObjectValueMust(map[string]attr.Type{"test_attr": StringType{}}, map[string]attr.Value{"test_attr": NewStringUnknown()}),
+			expected: false,
+		},
+		"known-nested-unknown": {
+			input:    NewObjectValueMust(map[string]attr.Type{"test_attr": ListType{ElemType: StringType{}}}, map[string]attr.Value{"test_attr": NewListValueMust(StringType{}, []attr.Value{NewStringUnknown()})}),
+			expected: false,
+		},
+		"null": {
+			input:    NewObjectNull(map[string]attr.Type{"test_attr": StringType{}}),
+			expected: true,
+		},
+		"unknown": {
+			input:    NewObjectUnknown(map[string]attr.Type{"test_attr": StringType{}}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.IsFullyKnown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestObjectValueIsUnknown(t *testing.T) {
 	t.Parallel()
 
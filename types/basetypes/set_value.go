@@ -69,7 +69,31 @@ func NewSetValue(elementType attr.Type, elements []attr.Value) (SetValue, diag.D
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
 	ctx := context.Background()
 
+	if elementType == nil {
+		diags.AddError(
+			"Missing Set Element Type",
+			"While creating a Set value, a missing element type was detected. "+
+				"A Set must define its element type. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return NewSetUnknown(missingType{}), diags
+	}
+
 	for idx, element := range elements {
+		if element == nil {
+			diags.AddError(
+				"Invalid Set Element Type",
+				"While creating a Set value, an invalid element was detected. "+
+					"A Set must use the single, given element type. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Set Element Type: %s\n", elementType.String())+
+					fmt.Sprintf("Set Index (%d) Element Type: <nil>", idx),
+			)
+
+			continue
+		}
+
 		if !elementType.Equal(element.Type(ctx)) {
 			diags.AddError(
 				"Invalid Set Element Type",
@@ -101,6 +125,7 @@ func NewSetValueFrom(ctx context.Context, elementType attr.Type, elements any) (
 		ctx,
 		SetType{ElemType: elementType},
 		elements,
+		reflect.Options{},
 		path.Empty(),
 	)
 
@@ -205,6 +230,10 @@ func (s SetValue) Type(ctx context.Context) attr.Type {
 
 // ToTerraformValue returns the data contained in the Set as a tftypes.Value.
 func (s SetValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	if s.elementType == nil {
+		return tftypes.Value{}, fmt.Errorf("missing Set element type, create Set values with the framework creation functions")
+	}
+
 	setType := tftypes.Set{ElementType: s.ElementType(ctx).TerraformType(ctx)}
 
 	switch s.state {
@@ -310,6 +339,23 @@ func (s SetValue) IsUnknown() bool {
 	return s.state == attr.ValueStateUnknown
 }
 
+// IsFullyKnown returns true if the Set is not unknown and none of its
+// elements are unknown, recursively checking nested collection, dynamic,
+// object, and tuple values. A null Set is considered fully known.
+func (s SetValue) IsFullyKnown() bool {
+	if s.IsUnknown() {
+		return false
+	}
+
+	for _, element := range s.elements {
+		if !isFullyKnown(element) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // String returns a human-readable representation of the Set value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
@@ -328,6 +328,38 @@ func TestNewSetValue(t *testing.T) {
 				},
 			),
 		},
+		"nil-element": {
+			elementType: StringType{},
+			elements: []attr.Value{
+				NewStringValue("test"),
+				nil,
+			},
+			expected: NewSetUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Set Element Type",
+					"While creating a Set value, an invalid element was detected. "+
+						"A Set must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Set Element Type: basetypes.StringType\n"+
+						"Set Index (1) Element Type: <nil>",
+				),
+			},
+		},
+		"missing-element-type": {
+			elements: []attr.Value{
+				NewStringValue("test"),
+			},
+			expected: NewSetUnknown(missingType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Set Element Type",
+					"While creating a Set value, a missing element type was detected. "+
+						"A Set must define its element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
 		"invalid-element-type": {
 			elementType: StringType{},
 			elements: []attr.Value{
@@ -494,6 +526,11 @@ func TestSetValueToTerraformValue(t *testing.T) {
 		expectedErr string
 	}
 	tests := map[string]testCase{
+		"missing-element-type": {
+			input:       SetValue{},
+			expectation: tftypes.Value{},
+			expectedErr: "missing Set element type, create Set values with the framework creation functions",
+		},
 		"known": {
 			input: NewSetValueMust(
 				StringType{},
@@ -936,6 +973,50 @@ func TestSetValueIsNull(t *testing.T) {
 	}
 }
 
+func TestSetValueIsFullyKnown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    SetValue
+		expected bool
+	}{
+		"known": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+			expected: true,
+		},
+		"known-element-unknown": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("test"), NewStringUnknown()}),
+			expected: false,
+		},
+		"known-nested-unknown": {
+			input:    NewSetValueMust(ListType{ElemType: StringType{}}, []attr.Value{NewListValueMust(StringType{}, []attr.Value{NewStringUnknown()})}),
+			expected: false,
+		},
+		"null": {
+			input:    NewSetNull(StringType{}),
+			expected: true,
+		},
+		"unknown": {
+			input:    NewSetUnknown(StringType{}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.IsFullyKnown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSetValueIsUnknown(t *testing.T) {
 	t.Parallel()
 
@@ -188,6 +188,23 @@ func (v TupleValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
+// IsFullyKnown returns true if the Tuple is not unknown and none of its
+// elements are unknown, recursively checking nested collection, dynamic,
+// object, and tuple values. A null Tuple is considered fully known.
+func (v TupleValue) IsFullyKnown() bool {
+	if v.IsUnknown() {
+		return false
+	}
+
+	for _, element := range v.elements {
+		if !isFullyKnown(element) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // String returns a human-readable representation of the Tuple. The string returned here is not protected by any
 // compatibility guarantees, and is intended for logging and error reporting.
 func (v TupleValue) String() string {
@@ -446,6 +446,50 @@ func TestTupleValueIsNull(t *testing.T) {
 	}
 }
 
+func TestTupleValueIsFullyKnown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    TupleValue
+		expected bool
+	}{
+		"known": {
+			input:    NewTupleValueMust([]attr.Type{StringType{}}, []attr.Value{NewStringValue("test")}),
+			expected: true,
+		},
+		"known-element-unknown": {
+			input:    NewTupleValueMust([]attr.Type{StringType{}, StringType{}}, []attr.Value{NewStringValue("test"), NewStringUnknown()}),
+			expected: false,
+		},
+		"known-nested-unknown": {
+			input:    NewTupleValueMust([]attr.Type{ListType{ElemType: StringType{}}}, []attr.Value{NewListValueMust(StringType{}, []attr.Value{NewStringUnknown()})}),
+			expected: false,
+		},
+		"null": {
+			input:    NewTupleNull([]attr.Type{StringType{}}),
+			expected: true,
+		},
+		"unknown": {
+			input:    NewTupleUnknown([]attr.Type{StringType{}}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.IsFullyKnown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestTupleValueIsUnknown(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package enumtype contains a string-backed custom type which only allows a
+// fixed set of values, such as an API field that accepts a handful of named
+// modes.
+//
+// The allowed values are declared once, as provider-defined typed constants,
+// and the same declaration drives value validation and attribute
+// documentation, so the two cannot drift apart:
+//
+//	type Color string
+//
+//	const (
+//		ColorBlue Color = "blue"
+//		ColorRed  Color = "red"
+//	)
+//
+//	var colorType = enumtype.New(ColorBlue, ColorRed)
+//
+//	schema.StringAttribute{
+//		CustomType:          colorType,
+//		MarkdownDescription: "Color of the widget. " + colorType.MarkdownDescription(),
+//		Required:            true,
+//	}
+//
+// Values are stored as Terraform strings, so changing the allowed values or
+// switching an existing string attribute to an enum type does not require a
+// schema version or state upgrade.
+package enumtype
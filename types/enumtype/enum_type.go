@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enumtype
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ basetypes.StringTypable = Type[string]{}
+
+// Type is a string type which only allows the values it was created with.
+// Value is the associated value type. Use New to create a Type.
+type Type[T ~string] struct {
+	basetypes.StringType
+
+	// values contains the allowed values, in the order given to New.
+	values []T
+}
+
+// New returns a Type which only allows the given values. Validation errors and
+// the Description and MarkdownDescription methods list the values in the
+// given order.
+func New[T ~string](values ...T) Type[T] {
+	return Type[T]{
+		values: slices.Clone(values),
+	}
+}
+
+// Description returns a plain text sentence listing the allowed values, which
+// can be appended to the attribute Description.
+func (t Type[T]) Description() string {
+	return "Value must be one of: " + t.quotedValues() + "."
+}
+
+// MarkdownDescription returns a Markdown sentence listing the allowed values,
+// which can be appended to the attribute MarkdownDescription.
+func (t Type[T]) MarkdownDescription() string {
+	quoted := make([]string, 0, len(t.values))
+
+	for _, value := range t.values {
+		quoted = append(quoted, "`"+string(value)+"`")
+	}
+
+	return "Value must be one of: " + strings.Join(quoted, ", ") + "."
+}
+
+// Equal returns true if the given type is a Type with the same allowed values.
+func (t Type[T]) Equal(o attr.Type) bool {
+	other, ok := o.(Type[T])
+
+	if !ok {
+		return false
+	}
+
+	return slices.Equal(t.values, other.values)
+}
+
+// String returns a human readable string of the type name.
+func (t Type[T]) String() string {
+	return "enumtype.Type"
+}
+
+// quotedValues returns the allowed values as a comma-separated list of Go
+// quoted strings.
+func (t Type[T]) quotedValues() string {
+	quoted := make([]string, 0, len(t.values))
+
+	for _, value := range t.values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+// Values returns a copy of the allowed values.
+func (t Type[T]) Values() []T {
+	return slices.Clone(t.values)
+}
+
+// ValueFromString returns a Value given a StringValue.
+func (t Type[T]) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Value[T]{
+		StringValue: in,
+		values:      t.values,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. Values outside of
+// the allowed values are returned without error and reported by the Value
+// ValidateAttribute and ValidateParameter methods.
+func (t Type[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return Value[T]{
+		StringValue: stringValue,
+		values:      t.values,
+	}, nil
+}
+
+// ValueType returns the Value type.
+func (t Type[T]) ValueType(_ context.Context) attr.Value {
+	return Value[T]{
+		values: t.values,
+	}
+}
+
+// NewValue returns a known Value of the type.
+func (t Type[T]) NewValue(value T) Value[T] {
+	return Value[T]{
+		StringValue: basetypes.NewStringValue(string(value)),
+		values:      t.values,
+	}
+}
+
+// NewNullValue returns a null Value of the type.
+func (t Type[T]) NewNullValue() Value[T] {
+	return Value[T]{
+		StringValue: basetypes.NewStringNull(),
+		values:      t.values,
+	}
+}
+
+// NewUnknownValue returns an unknown Value of the type.
+func (t Type[T]) NewUnknownValue() Value[T] {
+	return Value[T]{
+		StringValue: basetypes.NewStringUnknown(),
+		values:      t.values,
+	}
+}
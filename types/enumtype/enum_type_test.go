@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enumtype_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/enumtype"
+)
+
+type testColor string
+
+const (
+	testColorBlue testColor = "blue"
+	testColorRed  testColor = "red"
+)
+
+func TestTypeDescription(t *testing.T) {
+	t.Parallel()
+
+	enumType := enumtype.New(testColorBlue, testColorRed)
+
+	if diff := cmp.Diff(enumType.Description(), `Value must be one of: "blue", "red".`); diff != "" {
+		t.Errorf("unexpected Description difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(enumType.MarkdownDescription(), "Value must be one of: `blue`, `red`."); diff != "" {
+		t.Errorf("unexpected MarkdownDescription difference: %s", diff)
+	}
+}
+
+func TestTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		enumType enumtype.Type[testColor]
+		other    attr.Type
+		expected bool
+	}{
+		"equal": {
+			enumType: enumtype.New(testColorBlue, testColorRed),
+			other:    enumtype.New(testColorBlue, testColorRed),
+			expected: true,
+		},
+		"different-values": {
+			enumType: enumtype.New(testColorBlue, testColorRed),
+			other:    enumtype.New(testColorBlue),
+			expected: false,
+		},
+		"different-order": {
+			enumType: enumtype.New(testColorBlue, testColorRed),
+			other:    enumtype.New(testColorRed, testColorBlue),
+			expected: false,
+		},
+		"different-enum": {
+			enumType: enumtype.New(testColorBlue, testColorRed),
+			other:    enumtype.New("blue", "red"),
+			expected: false,
+		},
+		"StringType": {
+			enumType: enumtype.New(testColorBlue, testColorRed),
+			other:    basetypes.StringType{},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.enumType.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	enumType := enumtype.New(testColorBlue, testColorRed)
+
+	testCases := map[string]struct {
+		input         tftypes.Value
+		expected      attr.Value
+		expectedError string
+	}{
+		"known": {
+			input:    tftypes.NewValue(tftypes.String, "blue"),
+			expected: enumType.NewValue(testColorBlue),
+		},
+		"known-not-allowed": {
+			input:    tftypes.NewValue(tftypes.String, "green"),
+			expected: enumType.NewValue("green"),
+		},
+		"null": {
+			input:    tftypes.NewValue(tftypes.String, nil),
+			expected: enumType.NewNullValue(),
+		},
+		"unknown": {
+			input:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: enumType.NewUnknownValue(),
+		},
+		"wrong-type": {
+			input:         tftypes.NewValue(tftypes.Number, 123),
+			expectedError: "can't unmarshal tftypes.Number into *string, expected string",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := enumType.ValueFromTerraform(context.Background(), testCase.input)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTypeValues(t *testing.T) {
+	t.Parallel()
+
+	enumType := enumtype.New(testColorBlue, testColorRed)
+
+	got := enumType.Values()
+	got[0] = "green"
+
+	if diff := cmp.Diff(enumType.Values(), []testColor{testColorBlue, testColorRed}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
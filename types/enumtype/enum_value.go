@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enumtype
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable       = Value[string]{}
+	_ xattr.ValidateableAttribute    = Value[string]{}
+	_ function.ValidateableParameter = Value[string]{}
+)
+
+// Value is a string value of a Type. Known values outside of the allowed
+// values of the Type are rejected by validation.
+type Value[T ~string] struct {
+	basetypes.StringValue
+
+	// values contains the allowed values of the Type.
+	values []T
+}
+
+// Equal returns true if the given value is a Value of an equal Type and has
+// the same string value.
+func (v Value[T]) Equal(o attr.Value) bool {
+	other, ok := o.(Value[T])
+
+	if !ok {
+		return false
+	}
+
+	if !slices.Equal(v.values, other.values) {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns the Type with the allowed values of the Value.
+func (v Value[T]) Type(_ context.Context) attr.Type {
+	return Type[T]{
+		values: v.values,
+	}
+}
+
+// ValidateAttribute implements the xattr.ValidateableAttribute interface,
+// returning an error diagnostic if the known value is not an allowed value.
+func (v Value[T]) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() || v.IsValid() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s value must be one of: %s, got: %q", req.Path, Type[T]{values: v.values}.quotedValues(), v.ValueString()),
+	)
+}
+
+// ValidateParameter implements the function.ValidateableParameter interface,
+// returning an error if the known value is not an allowed value.
+func (v Value[T]) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsNull() || v.IsUnknown() || v.IsValid() {
+		return
+	}
+
+	resp.Error = function.NewArgumentFuncError(
+		req.Position,
+		fmt.Sprintf("Invalid Parameter Value: value must be one of: %s, got: %q", Type[T]{values: v.values}.quotedValues(), v.ValueString()),
+	)
+}
+
+// IsValid returns true if the known value is one of the allowed values. Null
+// and unknown values return false.
+func (v Value[T]) IsValid() bool {
+	if v.IsNull() || v.IsUnknown() {
+		return false
+	}
+
+	return slices.Contains(v.values, T(v.ValueString()))
+}
+
+// ValueEnum returns the known value as the provider-defined type. If the Value
+// is null or unknown, returns "".
+func (v Value[T]) ValueEnum() T {
+	return T(v.ValueString())
+}
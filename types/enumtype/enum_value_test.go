@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enumtype_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/enumtype"
+)
+
+func TestValueValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	enumType := enumtype.New(testColorBlue, testColorRed)
+
+	testCases := map[string]struct {
+		value    enumtype.Value[testColor]
+		expected diag.Diagnostics
+	}{
+		"allowed": {
+			value: enumType.NewValue(testColorRed),
+		},
+		"not-allowed": {
+			value: enumType.NewValue("green"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test value must be one of: "blue", "red", got: "green"`,
+				),
+			},
+		},
+		"null": {
+			value: enumType.NewNullValue(),
+		},
+		"unknown": {
+			value: enumType.NewUnknownValue(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &xattr.ValidateAttributeResponse{}
+
+			testCase.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("test")}, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	enumType := enumtype.New(testColorBlue, testColorRed)
+
+	testCases := map[string]struct {
+		value    enumtype.Value[testColor]
+		expected *function.FuncError
+	}{
+		"allowed": {
+			value: enumType.NewValue(testColorBlue),
+		},
+		"not-allowed": {
+			value: enumType.NewValue("green"),
+			expected: function.NewArgumentFuncError(
+				1,
+				`Invalid Parameter Value: value must be one of: "blue", "red", got: "green"`,
+			),
+		},
+		"null": {
+			value: enumType.NewNullValue(),
+		},
+		"unknown": {
+			value: enumType.NewUnknownValue(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.ValidateParameterResponse{}
+
+			testCase.value.ValidateParameter(context.Background(), function.ValidateParameterRequest{Position: 1}, resp)
+
+			if diff := cmp.Diff(resp.Error, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueEqual(t *testing.T) {
+	t.Parallel()
+
+	enumType := enumtype.New(testColorBlue, testColorRed)
+
+	if !enumType.NewValue(testColorBlue).Equal(enumType.NewValue(testColorBlue)) {
+		t.Error("expected equal values")
+	}
+
+	if enumType.NewValue(testColorBlue).Equal(enumType.NewValue(testColorRed)) {
+		t.Error("expected different string values to not be equal")
+	}
+
+	if enumType.NewValue(testColorBlue).Equal(enumtype.New(testColorBlue).NewValue(testColorBlue)) {
+		t.Error("expected values of different types to not be equal")
+	}
+}
+
+func TestValueValueEnum(t *testing.T) {
+	t.Parallel()
+
+	enumType := enumtype.New(testColorBlue, testColorRed)
+
+	testCases := map[string]struct {
+		value         enumtype.Value[testColor]
+		expected      testColor
+		expectedValid bool
+	}{
+		"allowed": {
+			value:         enumType.NewValue(testColorRed),
+			expected:      testColorRed,
+			expectedValid: true,
+		},
+		"not-allowed": {
+			value:    enumType.NewValue("green"),
+			expected: "green",
+		},
+		"null": {
+			value: enumType.NewNullValue(),
+		},
+		"unknown": {
+			value: enumType.NewUnknownValue(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.value.ValueEnum(); got != testCase.expected {
+				t.Errorf("expected ValueEnum %q, got %q", testCase.expected, got)
+			}
+
+			if got := testCase.value.IsValid(); got != testCase.expectedValid {
+				t.Errorf("expected IsValid %t, got %t", testCase.expectedValid, got)
+			}
+		})
+	}
+}
+
+func TestValueConfigGet(t *testing.T) {
+	t.Parallel()
+
+	enumType := enumtype.New(testColorBlue, testColorRed)
+
+	config := tfsdk.Config{
+		Raw: tftypes.NewValue(
+			tftypes.Object{AttributeTypes: map[string]tftypes.Type{"color": tftypes.String}},
+			map[string]tftypes.Value{"color": tftypes.NewValue(tftypes.String, "green")},
+		),
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"color": schema.StringAttribute{
+					CustomType: enumType,
+					Required:   true,
+				},
+			},
+		},
+	}
+
+	var got enumtype.Value[testColor]
+
+	diags := config.GetAttribute(context.Background(), path.Root("color"), &got)
+
+	expected := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("color"),
+			"Invalid Attribute Value",
+			`Attribute color value must be one of: "blue", "red", got: "green"`,
+		),
+	}
+
+	if diff := cmp.Diff(diags, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
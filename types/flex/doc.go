@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package flex contains helpers for converting between framework value types,
+// such as types.List and types.Map, and the Go types commonly found in remote
+// system API SDKs.
+//
+// Expand functions convert framework values into Go types, treating null and
+// unknown framework values as the Go zero-value. Flatten functions convert Go
+// types into framework values, treating nil slices, maps, and pointers as
+// null framework values.
+package flex
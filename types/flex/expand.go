@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Expand converts the given value into the Go type T using the framework
+// reflection rules. If the value is nil, null, or unknown, the zero-value of T
+// is returned without diagnostics.
+func Expand[T any](ctx context.Context, value attr.Value) (T, diag.Diagnostics) {
+	var result T
+
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return result, nil
+	}
+
+	diags := tfsdk.ValueAs(ctx, value, &result)
+
+	return result, diags
+}
+
+// ExpandStringList converts the given list of strings into a []string. If the
+// list is null or unknown, nil is returned.
+func ExpandStringList(ctx context.Context, list basetypes.ListValue) ([]string, diag.Diagnostics) {
+	return Expand[[]string](ctx, list)
+}
+
+// ExpandStringSet converts the given set of strings into a []string. If the
+// set is null or unknown, nil is returned.
+func ExpandStringSet(ctx context.Context, set basetypes.SetValue) ([]string, diag.Diagnostics) {
+	return Expand[[]string](ctx, set)
+}
+
+// ExpandStringValueMap converts the given map of strings into a
+// map[string]string. If the map is null or unknown, nil is returned.
+func ExpandStringValueMap(ctx context.Context, m basetypes.MapValue) (map[string]string, diag.Diagnostics) {
+	return Expand[map[string]string](ctx, m)
+}
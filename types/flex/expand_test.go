@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/flex"
+)
+
+func TestExpand(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Name types.String `tfsdk:"name"`
+	}
+
+	testCases := map[string]struct {
+		value         attr.Value
+		expected      []testStruct
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			value:    nil,
+			expected: nil,
+		},
+		"null": {
+			value: types.ListNull(types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": types.StringType,
+				},
+			}),
+			expected: nil,
+		},
+		"unknown": {
+			value: types.ListUnknown(types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": types.StringType,
+				},
+			}),
+			expected: nil,
+		},
+		"known": {
+			value: types.ListValueMust(
+				types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": types.StringType,
+					},
+				},
+				[]attr.Value{
+					types.ObjectValueMust(
+						map[string]attr.Type{
+							"name": types.StringType,
+						},
+						map[string]attr.Value{
+							"name": types.StringValue("test"),
+						},
+					),
+				},
+			),
+			expected: []testStruct{
+				{
+					Name: types.StringValue("test"),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := flex.Expand[[]testStruct](context.Background(), testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandStringList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		list          types.List
+		expected      []string
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			list:     types.ListNull(types.StringType),
+			expected: nil,
+		},
+		"unknown": {
+			list:     types.ListUnknown(types.StringType),
+			expected: nil,
+		},
+		"empty": {
+			list:     types.ListValueMust(types.StringType, []attr.Value{}),
+			expected: []string{},
+		},
+		"known": {
+			list: types.ListValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("one"),
+					types.StringValue("two"),
+				},
+			),
+			expected: []string{"one", "two"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := flex.ExpandStringList(context.Background(), testCase.list)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandStringSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		set           types.Set
+		expected      []string
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			set:      types.SetNull(types.StringType),
+			expected: nil,
+		},
+		"unknown": {
+			set:      types.SetUnknown(types.StringType),
+			expected: nil,
+		},
+		"known": {
+			set: types.SetValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("one"),
+				},
+			),
+			expected: []string{"one"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := flex.ExpandStringSet(context.Background(), testCase.set)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandStringValueMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		m             types.Map
+		expected      map[string]string
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			m:        types.MapNull(types.StringType),
+			expected: nil,
+		},
+		"unknown": {
+			m:        types.MapUnknown(types.StringType),
+			expected: nil,
+		},
+		"known": {
+			m: types.MapValueMust(
+				types.StringType,
+				map[string]attr.Value{
+					"key": types.StringValue("value"),
+				},
+			),
+			expected: map[string]string{
+				"key": "value",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := flex.ExpandStringValueMap(context.Background(), testCase.m)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Flatten converts the given Go value into a value of the given type using the
+// framework reflection rules. Nil slices, maps, and pointers are converted
+// into null values.
+func Flatten[T any](ctx context.Context, typ attr.Type, value T) (attr.Value, diag.Diagnostics) {
+	var result attr.Value
+
+	diags := tfsdk.ValueFrom(ctx, value, typ, &result)
+
+	return result, diags
+}
+
+// FlattenObject converts the given Go struct, or pointer to a struct, into an
+// object value with the given attribute types. It is intended for structs from
+// remote system API SDKs, which do not have "tfsdk" struct tags, such as when
+// setting a computed nested attribute in a Read method:
+//
+//	obj, diags := flex.FlattenObject(ctx, attrTypes, apiObj)
+//	resp.Diagnostics.Append(diags...)
+//	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("status"), obj)...)
+//
+// Struct fields, including fields of nested structs, are matched to object
+// attributes by their "tfsdk" struct tag, then by the name in their "json"
+// struct tag, then by their field name converted to snake case, such as
+// http_endpoint for HTTPEndpoint. Fields without a matching attribute are
+// ignored and attributes without a matching field are null. If the value is a
+// nil pointer, a null object is returned.
+func FlattenObject(ctx context.Context, attrTypes map[string]attr.Type, value any) (basetypes.ObjectValue, diag.Diagnostics) {
+	typ := basetypes.ObjectType{AttrTypes: attrTypes}
+
+	result, diags := reflect.FromValue(ctx, typ, value, reflect.Options{LooseStructFields: true}, path.Empty())
+
+	if diags.HasError() {
+		return basetypes.NewObjectUnknown(attrTypes), diags
+	}
+
+	objectValuable, ok := result.(basetypes.ObjectValuable)
+
+	if !ok {
+		diags.AddError(
+			"Object Conversion Error",
+			"An unexpected error was encountered trying to convert into an object. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("expected basetypes.ObjectValuable, got: %T", result),
+		)
+
+		return basetypes.NewObjectUnknown(attrTypes), diags
+	}
+
+	objectValue, objectDiags := objectValuable.ToObjectValue(ctx)
+
+	diags.Append(objectDiags...)
+
+	return objectValue, diags
+}
+
+// FlattenStringList converts the given []string into a list of strings. If the
+// slice is nil, a null list is returned.
+func FlattenStringList(ctx context.Context, elements []string) (basetypes.ListValue, diag.Diagnostics) {
+	return basetypes.NewListValueFrom(ctx, basetypes.StringType{}, elements)
+}
+
+// FlattenStringSet converts the given []string into a set of strings. If the
+// slice is nil, a null set is returned.
+func FlattenStringSet(ctx context.Context, elements []string) (basetypes.SetValue, diag.Diagnostics) {
+	return basetypes.NewSetValueFrom(ctx, basetypes.StringType{}, elements)
+}
+
+// FlattenStringValueMap converts the given map[string]string into a map of
+// strings. If the map is nil, a null map is returned.
+func FlattenStringValueMap(ctx context.Context, elements map[string]string) (basetypes.MapValue, diag.Diagnostics) {
+	return basetypes.NewMapValueFrom(ctx, basetypes.StringType{}, elements)
+}
+
+// BoolNullIfFalseZero converts the given *bool into a bool value. If the
+// pointer is nil or points to false, a null bool is returned. This is useful
+// for remote system APIs which omit false values, so an unset attribute does
+// not produce a difference from the remote value.
+func BoolNullIfFalseZero(value *bool) basetypes.BoolValue {
+	if value == nil || !*value {
+		return basetypes.NewBoolNull()
+	}
+
+	return basetypes.NewBoolValue(true)
+}
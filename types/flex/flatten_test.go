@@ -0,0 +1,363 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/flex"
+)
+
+func TestFlatten(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Name *string `tfsdk:"name"`
+	}
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+		},
+	}
+
+	testCases := map[string]struct {
+		value         *testStruct
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			value:    nil,
+			expected: types.ObjectNull(objectType.AttrTypes),
+		},
+		"nil-field": {
+			value: &testStruct{},
+			expected: types.ObjectValueMust(
+				objectType.AttrTypes,
+				map[string]attr.Value{
+					"name": types.StringNull(),
+				},
+			),
+		},
+		"known": {
+			value: &testStruct{
+				Name: pointer("test"),
+			},
+			expected: types.ObjectValueMust(
+				objectType.AttrTypes,
+				map[string]attr.Value{
+					"name": types.StringValue("test"),
+				},
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := flex.Flatten(context.Background(), objectType, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenObject(t *testing.T) {
+	t.Parallel()
+
+	type testAPIRule struct {
+		Action string `json:"action"`
+	}
+
+	type testAPIObject struct {
+		ID           string
+		HTTPEndpoint *string
+		DisplayName  string `json:"display_name,omitempty"`
+		Renamed      string `tfsdk:"tfsdk_name" json:"json_name"`
+		Ignored      string `json:"-"`
+		Unmatched    string
+		Rules        []testAPIRule `json:"rules"`
+		unexported   string
+	}
+
+	ruleAttrTypes := map[string]attr.Type{
+		"action": types.StringType,
+	}
+
+	attrTypes := map[string]attr.Type{
+		"display_name":  types.StringType,
+		"http_endpoint": types.StringType,
+		"id":            types.StringType,
+		"ignored":       types.StringType,
+		"missing":       types.Int64Type,
+		"rules":         types.ListType{ElemType: types.ObjectType{AttrTypes: ruleAttrTypes}},
+		"tfsdk_name":    types.StringType,
+	}
+
+	testCases := map[string]struct {
+		value         any
+		expected      types.Object
+		expectedDiags diag.Diagnostics
+	}{
+		"nil-pointer": {
+			value:    (*testAPIObject)(nil),
+			expected: types.ObjectNull(attrTypes),
+		},
+		"pointer": {
+			value: &testAPIObject{
+				ID:           "test-id",
+				HTTPEndpoint: pointer("https://example.com"),
+				DisplayName:  "test-display-name",
+				Renamed:      "test-renamed",
+				Ignored:      "test-ignored",
+				Unmatched:    "test-unmatched",
+				Rules: []testAPIRule{
+					{Action: "allow"},
+				},
+				unexported: "test-unexported",
+			},
+			expected: types.ObjectValueMust(
+				attrTypes,
+				map[string]attr.Value{
+					"display_name":  types.StringValue("test-display-name"),
+					"http_endpoint": types.StringValue("https://example.com"),
+					"id":            types.StringValue("test-id"),
+					"ignored":       types.StringNull(),
+					"missing":       types.Int64Null(),
+					"rules": types.ListValueMust(
+						types.ObjectType{AttrTypes: ruleAttrTypes},
+						[]attr.Value{
+							types.ObjectValueMust(
+								ruleAttrTypes,
+								map[string]attr.Value{
+									"action": types.StringValue("allow"),
+								},
+							),
+						},
+					),
+					"tfsdk_name": types.StringValue("test-renamed"),
+				},
+			),
+		},
+		"struct": {
+			value: testAPIObject{
+				ID: "test-id",
+			},
+			expected: types.ObjectValueMust(
+				attrTypes,
+				map[string]attr.Value{
+					"display_name":  types.StringValue(""),
+					"http_endpoint": types.StringNull(),
+					"id":            types.StringValue("test-id"),
+					"ignored":       types.StringNull(),
+					"missing":       types.Int64Null(),
+					"rules":         types.ListNull(types.ObjectType{AttrTypes: ruleAttrTypes}),
+					"tfsdk_name":    types.StringValue(""),
+				},
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := flex.FlattenObject(context.Background(), attrTypes, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenStringList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elements      []string
+		expected      types.List
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			elements: nil,
+			expected: types.ListNull(types.StringType),
+		},
+		"empty": {
+			elements: []string{},
+			expected: types.ListValueMust(types.StringType, []attr.Value{}),
+		},
+		"known": {
+			elements: []string{"one", "two"},
+			expected: types.ListValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("one"),
+					types.StringValue("two"),
+				},
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := flex.FlattenStringList(context.Background(), testCase.elements)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenStringSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elements      []string
+		expected      types.Set
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			elements: nil,
+			expected: types.SetNull(types.StringType),
+		},
+		"known": {
+			elements: []string{"one"},
+			expected: types.SetValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("one"),
+				},
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := flex.FlattenStringSet(context.Background(), testCase.elements)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenStringValueMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elements      map[string]string
+		expected      types.Map
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			elements: nil,
+			expected: types.MapNull(types.StringType),
+		},
+		"known": {
+			elements: map[string]string{
+				"key": "value",
+			},
+			expected: types.MapValueMust(
+				types.StringType,
+				map[string]attr.Value{
+					"key": types.StringValue("value"),
+				},
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := flex.FlattenStringValueMap(context.Background(), testCase.elements)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBoolNullIfFalseZero(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    *bool
+		expected types.Bool
+	}{
+		"nil": {
+			value:    nil,
+			expected: types.BoolNull(),
+		},
+		"false": {
+			value:    pointer(false),
+			expected: types.BoolNull(),
+		},
+		"true": {
+			value:    pointer(true),
+			expected: types.BoolValue(true),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := flex.BoolNullIfFalseZero(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
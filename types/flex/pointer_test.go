@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex_test
+
+func pointer[T any](value T) *T {
+	return &value
+}
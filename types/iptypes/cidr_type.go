@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ basetypes.StringTypable = CIDRType{}
+
+// CIDRType is a string type for CIDR strings, such as 192.0.2.0/24.
+// CIDR is the associated value type.
+type CIDRType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the given type is equivalent.
+func (t CIDRType) Equal(o attr.Type) bool {
+	_, ok := o.(CIDRType)
+
+	return ok
+}
+
+// String returns a human readable string of the type name.
+func (t CIDRType) String() string {
+	return "iptypes.CIDRType"
+}
+
+// ValueFromString returns a CIDR given a StringValue.
+func (t CIDRType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return CIDR{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a CIDR given a tftypes.Value.
+func (t CIDRType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return CIDR{
+		StringValue: stringValue,
+	}, nil
+}
+
+// ValueType returns the CIDR type.
+func (t CIDRType) ValueType(_ context.Context) attr.Value {
+	return CIDR{}
+}
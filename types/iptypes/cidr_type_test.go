@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/iptypes"
+)
+
+func TestCIDRTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    tftypes.Value
+		expected attr.Value
+	}{
+		"known": {
+			input:    tftypes.NewValue(tftypes.String, "192.0.2.0/24"),
+			expected: iptypes.NewCIDRValue("192.0.2.0/24"),
+		},
+		"known-invalid": {
+			input:    tftypes.NewValue(tftypes.String, "not-a-cidr"),
+			expected: iptypes.NewCIDRValue("not-a-cidr"),
+		},
+		"null": {
+			input:    tftypes.NewValue(tftypes.String, nil),
+			expected: iptypes.NewCIDRNull(),
+		},
+		"unknown": {
+			input:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: iptypes.NewCIDRUnknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := iptypes.CIDRType{}.ValueFromTerraform(context.Background(), testCase.input)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
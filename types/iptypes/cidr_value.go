@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = CIDR{}
+	_ xattr.ValidateableAttribute                = CIDR{}
+	_ function.ValidateableParameter             = CIDR{}
+)
+
+// CIDR is a CIDR string value of CIDRType.
+type CIDR struct {
+	basetypes.StringValue
+}
+
+// NewCIDRNull creates a CIDR with a null value.
+func NewCIDRNull() CIDR {
+	return CIDR{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewCIDRUnknown creates a CIDR with an unknown value.
+func NewCIDRUnknown() CIDR {
+	return CIDR{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewCIDRValue creates a CIDR with a known value. The value is not
+// validated until the CIDR is used with the framework.
+func NewCIDRValue(value string) CIDR {
+	return CIDR{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewCIDRNetipValue creates a CIDR with a known value from the given
+// netip.Prefix, formatted by its String method.
+func NewCIDRNetipValue(value netip.Prefix) CIDR {
+	return NewCIDRValue(value.String())
+}
+
+// Equal returns true if the given value is a CIDR with the same string
+// value. Use StringSemanticEquals to compare the represented prefixes.
+func (v CIDR) Equal(o attr.Value) bool {
+	other, ok := o.(CIDR)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns a CIDRType.
+func (v CIDR) Type(_ context.Context) attr.Type {
+	return CIDRType{}
+}
+
+// StringSemanticEquals returns true if the given value represents the same
+// address and prefix length, ignoring IPv6 zero compression, leading zeros,
+// and letter case, such as 2001:db8::/32 and 2001:0DB8::/32.
+func (v CIDR) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(CIDR)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorPrefix, err := parseCIDR(v.ValueString())
+
+	// Invalid values are reported by validation.
+	if err != nil {
+		return false, diags
+	}
+
+	newPrefix, err := parseCIDR(newValue.ValueString())
+
+	if err != nil {
+		return false, diags
+	}
+
+	return priorPrefix == newPrefix, diags
+}
+
+// ValidateAttribute implements the xattr.ValidateableAttribute interface,
+// returning an error diagnostic if the known value is not a valid CIDR
+// string.
+func (v CIDR) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	_, err := parseCIDR(v.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR String Value",
+			"A string value was provided that is not a valid CIDR string. "+
+				"A CIDR string is an IPv4 or IPv6 address followed by a slash and a prefix length, such as 192.0.2.0/24 or 2001:db8::/32.\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+// ValidateParameter implements the function.ValidateableParameter interface,
+// returning an error if the known value is not a valid CIDR string.
+func (v CIDR) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	_, err := parseCIDR(v.ValueString())
+
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid CIDR String Value: "+
+				"A string value was provided that is not a valid CIDR string. "+
+				"A CIDR string is an IPv4 or IPv6 address followed by a slash and a prefix length, such as 192.0.2.0/24 or 2001:db8::/32.\n\n"+
+				fmt.Sprintf("Position: %d", req.Position)+"\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+// ValueCIDR returns the known value as a netip.Prefix. An error
+// diagnostic is returned if the value is null, unknown, or not a valid
+// CIDR string.
+func (v CIDR) ValueCIDR() (netip.Prefix, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		diags.AddError(
+			"CIDR ValueCIDR Error",
+			"A null or unknown CIDR cannot be converted to a netip.Prefix. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Value: "+v.String(),
+		)
+
+		return netip.Prefix{}, diags
+	}
+
+	prefix, err := parseCIDR(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"CIDR ValueCIDR Error",
+			"An unexpected error occurred while converting a CIDR to a netip.Prefix. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return netip.Prefix{}, diags
+	}
+
+	return prefix, diags
+}
+
+// parseCIDR parses the given CIDR string.
+func parseCIDR(value string) (netip.Prefix, error) {
+	return netip.ParsePrefix(value)
+}
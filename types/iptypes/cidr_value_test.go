@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/iptypes"
+)
+
+func TestCIDRStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current       iptypes.CIDR
+		given         basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"exact": {
+			current:       iptypes.NewCIDRValue("192.0.2.0/24"),
+			given:         iptypes.NewCIDRValue("192.0.2.0/24"),
+			expectedMatch: true,
+		},
+		"semantically-equal-ipv6": {
+			current:       iptypes.NewCIDRValue("2001:db8::/32"),
+			given:         iptypes.NewCIDRValue("2001:0DB8:0::/32"),
+			expectedMatch: true,
+		},
+		"different-prefix-length": {
+			current:       iptypes.NewCIDRValue("192.0.2.0/24"),
+			given:         iptypes.NewCIDRValue("192.0.2.0/25"),
+			expectedMatch: false,
+		},
+		"invalid": {
+			current:       iptypes.NewCIDRValue("192.0.2.0/24"),
+			given:         iptypes.NewCIDRValue("192.0.2.0"),
+			expectedMatch: false,
+		},
+		"wrong-type": {
+			current:       iptypes.NewCIDRValue("2001:db8::/32"),
+			given:         basetypes.NewStringValue("2001:db8::/32"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: iptypes.CIDR\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.current.StringSemanticEquals(context.Background(), testCase.given)
+
+			if match != testCase.expectedMatch {
+				t.Errorf("expected match %t, got %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCIDRValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         iptypes.CIDR
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			value: iptypes.NewCIDRValue("2001:db8::/32"),
+		},
+		"null": {
+			value: iptypes.NewCIDRNull(),
+		},
+		"unknown": {
+			value: iptypes.NewCIDRUnknown(),
+		},
+		"invalid": {
+			value: iptypes.NewCIDRValue("192.0.2.0/33"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid CIDR String Value",
+					"A string value was provided that is not a valid CIDR string. "+
+						"A CIDR string is an IPv4 or IPv6 address followed by a slash and a prefix length, such as 192.0.2.0/24 or 2001:db8::/32.\n\n"+
+						"Path: test\n"+
+						"Given Value: 192.0.2.0/33\n"+
+						"Error: netip.ParsePrefix(\"192.0.2.0/33\"): prefix length out of range",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.value.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCIDRValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         iptypes.CIDR
+		expectedError *function.FuncError
+	}{
+		"valid": {
+			value: iptypes.NewCIDRValue("2001:db8::/32"),
+		},
+		"null": {
+			value: iptypes.NewCIDRNull(),
+		},
+		"invalid": {
+			value: iptypes.NewCIDRValue("192.0.2.0/33"),
+			expectedError: function.NewArgumentFuncError(
+				0,
+				"Invalid CIDR String Value: "+
+					"A string value was provided that is not a valid CIDR string. "+
+					"A CIDR string is an IPv4 or IPv6 address followed by a slash and a prefix length, such as 192.0.2.0/24 or 2001:db8::/32.\n\n"+
+					"Position: 0\n"+
+					"Given Value: 192.0.2.0/33\n"+
+					"Error: netip.ParsePrefix(\"192.0.2.0/33\"): prefix length out of range",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.value.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCIDRValueCIDR(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         iptypes.CIDR
+		expected      netip.Prefix
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			value:    iptypes.NewCIDRValue("192.0.2.0/24"),
+			expected: netip.MustParsePrefix("192.0.2.0/24"),
+		},
+		"from-netip": {
+			value:    iptypes.NewCIDRNetipValue(netip.MustParsePrefix("192.0.2.0/24")),
+			expected: netip.MustParsePrefix("192.0.2.0/24"),
+		},
+		"null": {
+			value: iptypes.NewCIDRNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"CIDR ValueCIDR Error",
+					"A null or unknown CIDR cannot be converted to a netip.Prefix. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Value: <null>",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ValueCIDR()
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
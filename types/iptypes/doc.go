@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package iptypes contains string-backed custom types for networking values,
+// with validation and semantic equality logic:
+//
+//   - IPv4Address: IPv4 address strings, such as 192.0.2.1.
+//   - IPv6Address: IPv6 address strings, such as 2001:db8::1, where
+//     addresses differing only in zero compression, leading zeros, or letter
+//     case are semantically equal.
+//   - CIDR: IPv4 or IPv6 CIDR strings, such as 192.0.2.0/24 or
+//     2001:db8::/32, with the same IPv6 semantic equality.
+//
+// Set the schema attribute CustomType field to the type, such as
+// iptypes.IPv4AddressType{}, and use the value type in data models. Values
+// are parsed with the net/netip package and can be converted with the
+// ValueIPv4Address, ValueIPv6Address, and ValueCIDR methods.
+package iptypes
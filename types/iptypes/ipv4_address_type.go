@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ basetypes.StringTypable = IPv4AddressType{}
+
+// IPv4AddressType is a string type for IPv4 address strings, such as 192.0.2.1.
+// IPv4Address is the associated value type.
+type IPv4AddressType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the given type is equivalent.
+func (t IPv4AddressType) Equal(o attr.Type) bool {
+	_, ok := o.(IPv4AddressType)
+
+	return ok
+}
+
+// String returns a human readable string of the type name.
+func (t IPv4AddressType) String() string {
+	return "iptypes.IPv4AddressType"
+}
+
+// ValueFromString returns an IPv4Address given a StringValue.
+func (t IPv4AddressType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPv4Address{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns an IPv4Address given a tftypes.Value.
+func (t IPv4AddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return IPv4Address{
+		StringValue: stringValue,
+	}, nil
+}
+
+// ValueType returns the IPv4Address type.
+func (t IPv4AddressType) ValueType(_ context.Context) attr.Value {
+	return IPv4Address{}
+}
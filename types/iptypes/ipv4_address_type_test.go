@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/iptypes"
+)
+
+func TestIPv4AddressTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    tftypes.Value
+		expected attr.Value
+	}{
+		"known": {
+			input:    tftypes.NewValue(tftypes.String, "192.0.2.1"),
+			expected: iptypes.NewIPv4AddressValue("192.0.2.1"),
+		},
+		"known-invalid": {
+			input:    tftypes.NewValue(tftypes.String, "not-an-address"),
+			expected: iptypes.NewIPv4AddressValue("not-an-address"),
+		},
+		"null": {
+			input:    tftypes.NewValue(tftypes.String, nil),
+			expected: iptypes.NewIPv4AddressNull(),
+		},
+		"unknown": {
+			input:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: iptypes.NewIPv4AddressUnknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := iptypes.IPv4AddressType{}.ValueFromTerraform(context.Background(), testCase.input)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
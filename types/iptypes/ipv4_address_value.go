@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = IPv4Address{}
+	_ xattr.ValidateableAttribute                = IPv4Address{}
+	_ function.ValidateableParameter             = IPv4Address{}
+)
+
+// IPv4Address is an IPv4 address string value of IPv4AddressType.
+type IPv4Address struct {
+	basetypes.StringValue
+}
+
+// NewIPv4AddressNull creates an IPv4Address with a null value.
+func NewIPv4AddressNull() IPv4Address {
+	return IPv4Address{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIPv4AddressUnknown creates an IPv4Address with an unknown value.
+func NewIPv4AddressUnknown() IPv4Address {
+	return IPv4Address{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIPv4AddressValue creates an IPv4Address with a known value. The value is
+// not validated until the IPv4Address is used with the framework.
+func NewIPv4AddressValue(value string) IPv4Address {
+	return IPv4Address{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIPv4AddressNetipValue creates an IPv4Address with a known value from the
+// given netip.Addr, formatted by its String method.
+func NewIPv4AddressNetipValue(value netip.Addr) IPv4Address {
+	return NewIPv4AddressValue(value.String())
+}
+
+// Equal returns true if the given value is an IPv4Address with the same
+// string value. Use StringSemanticEquals to compare the represented addresses.
+func (v IPv4Address) Equal(o attr.Value) bool {
+	other, ok := o.(IPv4Address)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns an IPv4AddressType.
+func (v IPv4Address) Type(_ context.Context) attr.Type {
+	return IPv4AddressType{}
+}
+
+// StringSemanticEquals returns true if the given value represents the same
+// IPv4 address.
+func (v IPv4Address) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPv4Address)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorAddr, err := parseIPv4Address(v.ValueString())
+
+	// Invalid values are reported by validation.
+	if err != nil {
+		return false, diags
+	}
+
+	newAddr, err := parseIPv4Address(newValue.ValueString())
+
+	if err != nil {
+		return false, diags
+	}
+
+	return priorAddr == newAddr, diags
+}
+
+// ValidateAttribute implements the xattr.ValidateableAttribute interface,
+// returning an error diagnostic if the known value is not a valid IPv4 address
+// string.
+func (v IPv4Address) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	_, err := parseIPv4Address(v.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv4 Address String Value",
+			"A string value was provided that is not a valid IPv4 address string. "+
+				"An IPv4 address string is four decimal octets separated by dots, such as 192.0.2.1.\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+// ValidateParameter implements the function.ValidateableParameter interface,
+// returning an error if the known value is not a valid IPv4 address string.
+func (v IPv4Address) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	_, err := parseIPv4Address(v.ValueString())
+
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IPv4 Address String Value: "+
+				"A string value was provided that is not a valid IPv4 address string. "+
+				"An IPv4 address string is four decimal octets separated by dots, such as 192.0.2.1.\n\n"+
+				fmt.Sprintf("Position: %d", req.Position)+"\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+// ValueIPv4Address returns the known value as a netip.Addr. An error
+// diagnostic is returned if the value is null, unknown, or not a valid
+// IPv4 address string.
+func (v IPv4Address) ValueIPv4Address() (netip.Addr, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		diags.AddError(
+			"IPv4Address ValueIPv4Address Error",
+			"A null or unknown IPv4Address cannot be converted to a netip.Addr. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Value: "+v.String(),
+		)
+
+		return netip.Addr{}, diags
+	}
+
+	addr, err := parseIPv4Address(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"IPv4Address ValueIPv4Address Error",
+			"An unexpected error occurred while converting an IPv4Address to a netip.Addr. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return netip.Addr{}, diags
+	}
+
+	return addr, diags
+}
+
+// parseIPv4Address parses the given IPv4 address string.
+func parseIPv4Address(value string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(value)
+
+	if err != nil {
+		return addr, err
+	}
+
+	if !addr.Is4() {
+		return addr, fmt.Errorf("address %s is not an IPv4 address", value)
+	}
+
+	return addr, nil
+}
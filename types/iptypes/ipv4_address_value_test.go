@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/iptypes"
+)
+
+func TestIPv4AddressStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current       iptypes.IPv4Address
+		given         basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"exact": {
+			current:       iptypes.NewIPv4AddressValue("192.0.2.1"),
+			given:         iptypes.NewIPv4AddressValue("192.0.2.1"),
+			expectedMatch: true,
+		},
+		"different": {
+			current:       iptypes.NewIPv4AddressValue("192.0.2.1"),
+			given:         iptypes.NewIPv4AddressValue("192.0.2.2"),
+			expectedMatch: false,
+		},
+		"invalid": {
+			current:       iptypes.NewIPv4AddressValue("192.0.2.1"),
+			given:         iptypes.NewIPv4AddressValue("192.000.002.001"),
+			expectedMatch: false,
+		},
+		"wrong-type": {
+			current:       iptypes.NewIPv4AddressValue("192.0.2.1"),
+			given:         basetypes.NewStringValue("192.0.2.1"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: iptypes.IPv4Address\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.current.StringSemanticEquals(context.Background(), testCase.given)
+
+			if match != testCase.expectedMatch {
+				t.Errorf("expected match %t, got %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv4AddressValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         iptypes.IPv4Address
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			value: iptypes.NewIPv4AddressValue("192.0.2.1"),
+		},
+		"null": {
+			value: iptypes.NewIPv4AddressNull(),
+		},
+		"unknown": {
+			value: iptypes.NewIPv4AddressUnknown(),
+		},
+		"invalid": {
+			value: iptypes.NewIPv4AddressValue("192.0.2.256"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Address String Value",
+					"A string value was provided that is not a valid IPv4 address string. "+
+						"An IPv4 address string is four decimal octets separated by dots, such as 192.0.2.1.\n\n"+
+						"Path: test\n"+
+						"Given Value: 192.0.2.256\n"+
+						"Error: ParseAddr(\"192.0.2.256\"): IPv4 field has value >255",
+				),
+			},
+		},
+		"ipv6": {
+			value: iptypes.NewIPv4AddressValue("2001:db8::1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Address String Value",
+					"A string value was provided that is not a valid IPv4 address string. "+
+						"An IPv4 address string is four decimal octets separated by dots, such as 192.0.2.1.\n\n"+
+						"Path: test\n"+
+						"Given Value: 2001:db8::1\n"+
+						"Error: address 2001:db8::1 is not an IPv4 address",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.value.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv4AddressValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         iptypes.IPv4Address
+		expectedError *function.FuncError
+	}{
+		"valid": {
+			value: iptypes.NewIPv4AddressValue("192.0.2.1"),
+		},
+		"null": {
+			value: iptypes.NewIPv4AddressNull(),
+		},
+		"invalid": {
+			value: iptypes.NewIPv4AddressValue("192.0.2.256"),
+			expectedError: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv4 Address String Value: "+
+					"A string value was provided that is not a valid IPv4 address string. "+
+					"An IPv4 address string is four decimal octets separated by dots, such as 192.0.2.1.\n\n"+
+					"Position: 0\n"+
+					"Given Value: 192.0.2.256\n"+
+					"Error: ParseAddr(\"192.0.2.256\"): IPv4 field has value >255",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.value.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv4AddressValueIPv4Address(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         iptypes.IPv4Address
+		expected      netip.Addr
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			value:    iptypes.NewIPv4AddressValue("192.0.2.1"),
+			expected: netip.MustParseAddr("192.0.2.1"),
+		},
+		"from-netip": {
+			value:    iptypes.NewIPv4AddressNetipValue(netip.MustParseAddr("192.0.2.1")),
+			expected: netip.MustParseAddr("192.0.2.1"),
+		},
+		"null": {
+			value: iptypes.NewIPv4AddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv4Address ValueIPv4Address Error",
+					"A null or unknown IPv4Address cannot be converted to a netip.Addr. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Value: <null>",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ValueIPv4Address()
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ basetypes.StringTypable = IPv6AddressType{}
+
+// IPv6AddressType is a string type for IPv6 address strings, such as
+// 2001:db8::1. IPv6Address is the associated value type.
+type IPv6AddressType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the given type is equivalent.
+func (t IPv6AddressType) Equal(o attr.Type) bool {
+	_, ok := o.(IPv6AddressType)
+
+	return ok
+}
+
+// String returns a human readable string of the type name.
+func (t IPv6AddressType) String() string {
+	return "iptypes.IPv6AddressType"
+}
+
+// ValueFromString returns an IPv6Address given a StringValue.
+func (t IPv6AddressType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPv6Address{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns an IPv6Address given a tftypes.Value.
+func (t IPv6AddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return IPv6Address{
+		StringValue: stringValue,
+	}, nil
+}
+
+// ValueType returns the IPv6Address type.
+func (t IPv6AddressType) ValueType(_ context.Context) attr.Value {
+	return IPv6Address{}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/iptypes"
+)
+
+func TestIPv6AddressTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    tftypes.Value
+		expected attr.Value
+	}{
+		"known": {
+			input:    tftypes.NewValue(tftypes.String, "2001:db8::1"),
+			expected: iptypes.NewIPv6AddressValue("2001:db8::1"),
+		},
+		"known-invalid": {
+			input:    tftypes.NewValue(tftypes.String, "not-an-address"),
+			expected: iptypes.NewIPv6AddressValue("not-an-address"),
+		},
+		"null": {
+			input:    tftypes.NewValue(tftypes.String, nil),
+			expected: iptypes.NewIPv6AddressNull(),
+		},
+		"unknown": {
+			input:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: iptypes.NewIPv6AddressUnknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := iptypes.IPv6AddressType{}.ValueFromTerraform(context.Background(), testCase.input)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
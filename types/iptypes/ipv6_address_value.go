@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = IPv6Address{}
+	_ xattr.ValidateableAttribute                = IPv6Address{}
+	_ function.ValidateableParameter             = IPv6Address{}
+)
+
+// IPv6Address is an IPv6 address string value of IPv6AddressType.
+type IPv6Address struct {
+	basetypes.StringValue
+}
+
+// NewIPv6AddressNull creates an IPv6Address with a null value.
+func NewIPv6AddressNull() IPv6Address {
+	return IPv6Address{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIPv6AddressUnknown creates an IPv6Address with an unknown value.
+func NewIPv6AddressUnknown() IPv6Address {
+	return IPv6Address{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIPv6AddressValue creates an IPv6Address with a known value. The value is
+// not validated until the IPv6Address is used with the framework.
+func NewIPv6AddressValue(value string) IPv6Address {
+	return IPv6Address{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIPv6AddressNetipValue creates an IPv6Address with a known value from the
+// given netip.Addr, formatted by its String method.
+func NewIPv6AddressNetipValue(value netip.Addr) IPv6Address {
+	return NewIPv6AddressValue(value.String())
+}
+
+// Equal returns true if the given value is an IPv6Address with the same
+// string value. Use StringSemanticEquals to compare the represented addresses.
+func (v IPv6Address) Equal(o attr.Value) bool {
+	other, ok := o.(IPv6Address)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns an IPv6AddressType.
+func (v IPv6Address) Type(_ context.Context) attr.Type {
+	return IPv6AddressType{}
+}
+
+// StringSemanticEquals returns true if the given value represents the same
+// IPv6 address, ignoring zero compression, leading zeros, and letter case,
+// such as 2001:db8::1 and 2001:0DB8:0:0:0:0:0:1.
+func (v IPv6Address) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPv6Address)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorAddr, err := parseIPv6Address(v.ValueString())
+
+	// Invalid values are reported by validation.
+	if err != nil {
+		return false, diags
+	}
+
+	newAddr, err := parseIPv6Address(newValue.ValueString())
+
+	if err != nil {
+		return false, diags
+	}
+
+	return priorAddr == newAddr, diags
+}
+
+// ValidateAttribute implements the xattr.ValidateableAttribute interface,
+// returning an error diagnostic if the known value is not a valid IPv6 address
+// string.
+func (v IPv6Address) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	_, err := parseIPv6Address(v.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv6 Address String Value",
+			"A string value was provided that is not a valid IPv6 address string. "+
+				"An IPv6 address string is eight groups of hexadecimal digits separated by colons, optionally compressed, such as 2001:db8::1.\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+// ValidateParameter implements the function.ValidateableParameter interface,
+// returning an error if the known value is not a valid IPv6 address string.
+func (v IPv6Address) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	_, err := parseIPv6Address(v.ValueString())
+
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IPv6 Address String Value: "+
+				"A string value was provided that is not a valid IPv6 address string. "+
+				"An IPv6 address string is eight groups of hexadecimal digits separated by colons, optionally compressed, such as 2001:db8::1.\n\n"+
+				fmt.Sprintf("Position: %d", req.Position)+"\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+// ValueIPv6Address returns the known value as a netip.Addr. An error
+// diagnostic is returned if the value is null, unknown, or not a valid
+// IPv6 address string.
+func (v IPv6Address) ValueIPv6Address() (netip.Addr, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		diags.AddError(
+			"IPv6Address ValueIPv6Address Error",
+			"A null or unknown IPv6Address cannot be converted to a netip.Addr. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Value: "+v.String(),
+		)
+
+		return netip.Addr{}, diags
+	}
+
+	addr, err := parseIPv6Address(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"IPv6Address ValueIPv6Address Error",
+			"An unexpected error occurred while converting an IPv6Address to a netip.Addr. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return netip.Addr{}, diags
+	}
+
+	return addr, diags
+}
+
+// parseIPv6Address parses the given IPv6 address string.
+func parseIPv6Address(value string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(value)
+
+	if err != nil {
+		return addr, err
+	}
+
+	if !addr.Is6() {
+		return addr, fmt.Errorf("address %s is not an IPv6 address", value)
+	}
+
+	return addr, nil
+}
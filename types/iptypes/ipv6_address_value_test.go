@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/iptypes"
+)
+
+func TestIPv6AddressStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current       iptypes.IPv6Address
+		given         basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"exact": {
+			current:       iptypes.NewIPv6AddressValue("2001:db8::1"),
+			given:         iptypes.NewIPv6AddressValue("2001:db8::1"),
+			expectedMatch: true,
+		},
+		"semantically-equal-compression": {
+			current:       iptypes.NewIPv6AddressValue("2001:db8::1"),
+			given:         iptypes.NewIPv6AddressValue("2001:db8:0:0:0:0:0:1"),
+			expectedMatch: true,
+		},
+		"semantically-equal-leading-zeros": {
+			current:       iptypes.NewIPv6AddressValue("2001:db8::1"),
+			given:         iptypes.NewIPv6AddressValue("2001:0db8::0001"),
+			expectedMatch: true,
+		},
+		"semantically-equal-case": {
+			current:       iptypes.NewIPv6AddressValue("2001:db8::abcd"),
+			given:         iptypes.NewIPv6AddressValue("2001:DB8::ABCD"),
+			expectedMatch: true,
+		},
+		"different": {
+			current:       iptypes.NewIPv6AddressValue("2001:db8::1"),
+			given:         iptypes.NewIPv6AddressValue("2001:db8::2"),
+			expectedMatch: false,
+		},
+		"invalid": {
+			current:       iptypes.NewIPv6AddressValue("2001:db8::1"),
+			given:         iptypes.NewIPv6AddressValue("2001:db8::g"),
+			expectedMatch: false,
+		},
+		"wrong-type": {
+			current:       iptypes.NewIPv6AddressValue("2001:db8::1"),
+			given:         basetypes.NewStringValue("2001:db8::1"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: iptypes.IPv6Address\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.current.StringSemanticEquals(context.Background(), testCase.given)
+
+			if match != testCase.expectedMatch {
+				t.Errorf("expected match %t, got %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv6AddressValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         iptypes.IPv6Address
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			value: iptypes.NewIPv6AddressValue("2001:db8::1"),
+		},
+		"null": {
+			value: iptypes.NewIPv6AddressNull(),
+		},
+		"unknown": {
+			value: iptypes.NewIPv6AddressUnknown(),
+		},
+		"ipv4": {
+			value: iptypes.NewIPv6AddressValue("192.0.2.1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv6 Address String Value",
+					"A string value was provided that is not a valid IPv6 address string. "+
+						"An IPv6 address string is eight groups of hexadecimal digits separated by colons, optionally compressed, such as 2001:db8::1.\n\n"+
+						"Path: test\n"+
+						"Given Value: 192.0.2.1\n"+
+						"Error: address 192.0.2.1 is not an IPv6 address",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.value.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv6AddressValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         iptypes.IPv6Address
+		expectedError *function.FuncError
+	}{
+		"valid": {
+			value: iptypes.NewIPv6AddressValue("2001:db8::1"),
+		},
+		"null": {
+			value: iptypes.NewIPv6AddressNull(),
+		},
+		"ipv4": {
+			value: iptypes.NewIPv6AddressValue("192.0.2.1"),
+			expectedError: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv6 Address String Value: "+
+					"A string value was provided that is not a valid IPv6 address string. "+
+					"An IPv6 address string is eight groups of hexadecimal digits separated by colons, optionally compressed, such as 2001:db8::1.\n\n"+
+					"Position: 0\n"+
+					"Given Value: 192.0.2.1\n"+
+					"Error: address 192.0.2.1 is not an IPv6 address",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.value.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv6AddressValueIPv6Address(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         iptypes.IPv6Address
+		expected      netip.Addr
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			value:    iptypes.NewIPv6AddressValue("2001:0DB8::1"),
+			expected: netip.MustParseAddr("2001:db8::1"),
+		},
+		"from-netip": {
+			value:    iptypes.NewIPv6AddressNetipValue(netip.MustParseAddr("2001:db8::1")),
+			expected: netip.MustParseAddr("2001:db8::1"),
+		},
+		"null": {
+			value: iptypes.NewIPv6AddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv6Address ValueIPv6Address Error",
+					"A null or unknown IPv6Address cannot be converted to a netip.Addr. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Value: <null>",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ValueIPv6Address()
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package jsontypes contains string-backed custom types for JSON encoded
+// values, with validation and semantic equality logic:
+//
+//   - Normalized: JSON strings, such as policy documents, where values
+//     differing only in insignificant whitespace or object key order are
+//     semantically equal.
+//
+// Use the Unmarshal method to decode known values into Go types.
+package jsontypes
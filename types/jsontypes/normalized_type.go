@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ basetypes.StringTypable = NormalizedType{}
+
+// NormalizedType is a string type for JSON strings, where semantic equality
+// ignores insignificant whitespace and object key order. Normalized is the
+// associated value type.
+type NormalizedType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the given type is equivalent.
+func (t NormalizedType) Equal(o attr.Type) bool {
+	_, ok := o.(NormalizedType)
+
+	return ok
+}
+
+// String returns a human readable string of the type name.
+func (t NormalizedType) String() string {
+	return "jsontypes.NormalizedType"
+}
+
+// ValueFromString returns a Normalized given a StringValue.
+func (t NormalizedType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Normalized{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Normalized given a tftypes.Value.
+func (t NormalizedType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return Normalized{
+		StringValue: stringValue,
+	}, nil
+}
+
+// ValueType returns the Normalized type.
+func (t NormalizedType) ValueType(_ context.Context) attr.Value {
+	return Normalized{}
+}
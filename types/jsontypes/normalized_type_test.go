@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/jsontypes"
+)
+
+func TestNormalizedTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    tftypes.Value
+		expected attr.Value
+	}{
+		"known": {
+			input:    tftypes.NewValue(tftypes.String, "{\"a\":1}"),
+			expected: jsontypes.NewNormalizedValue("{\"a\":1}"),
+		},
+		"known-invalid": {
+			input:    tftypes.NewValue(tftypes.String, "not-json"),
+			expected: jsontypes.NewNormalizedValue("not-json"),
+		},
+		"null": {
+			input:    tftypes.NewValue(tftypes.String, nil),
+			expected: jsontypes.NewNormalizedNull(),
+		},
+		"unknown": {
+			input:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: jsontypes.NewNormalizedUnknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := jsontypes.NormalizedType{}.ValueFromTerraform(context.Background(), testCase.input)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
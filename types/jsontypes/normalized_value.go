@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = Normalized{}
+	_ xattr.ValidateableAttribute                = Normalized{}
+	_ function.ValidateableParameter             = Normalized{}
+)
+
+// Normalized is a JSON string value of NormalizedType.
+type Normalized struct {
+	basetypes.StringValue
+}
+
+// NewNormalizedNull creates a Normalized with a null value.
+func NewNormalizedNull() Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewNormalizedUnknown creates a Normalized with an unknown value.
+func NewNormalizedUnknown() Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewNormalizedValue creates a Normalized with a known value. The value is
+// not validated until the Normalized is used with the framework.
+func NewNormalizedValue(value string) Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// Equal returns true if the given value is a Normalized with the same string
+// value. Use StringSemanticEquals to compare the represented JSON.
+func (v Normalized) Equal(o attr.Value) bool {
+	other, ok := o.(Normalized)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns a NormalizedType.
+func (v Normalized) Type(_ context.Context) attr.Type {
+	return NormalizedType{}
+}
+
+// StringSemanticEquals returns true if the given value represents the same
+// JSON, ignoring insignificant whitespace and object key order, such as
+// {"a":1,"b":2} and { "b": 2, "a": 1 }.
+func (v Normalized) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Normalized)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorJSON, err := normalizeJSON(v.ValueString())
+
+	// Invalid values are reported by validation.
+	if err != nil {
+		return false, diags
+	}
+
+	newJSON, err := normalizeJSON(newValue.ValueString())
+
+	if err != nil {
+		return false, diags
+	}
+
+	return bytes.Equal(priorJSON, newJSON), diags
+}
+
+// ValidateAttribute implements the xattr.ValidateableAttribute interface,
+// returning an error diagnostic if the known value is not valid JSON.
+func (v Normalized) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Given Value: "+v.ValueString(),
+		)
+	}
+}
+
+// ValidateParameter implements the function.ValidateableParameter interface,
+// returning an error if the known value is not valid JSON.
+func (v Normalized) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid JSON String Value: "+
+				"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+				fmt.Sprintf("Position: %d", req.Position)+"\n"+
+				"Given Value: "+v.ValueString(),
+		)
+	}
+}
+
+// Unmarshal decodes the known JSON value into the given target, following
+// the rules of the encoding/json package Unmarshal function. An error
+// diagnostic is returned if the value is null, unknown, or cannot be decoded
+// into the target.
+func (v Normalized) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		diags.AddError(
+			"Normalized JSON Unmarshal Error",
+			"A null or unknown Normalized cannot be unmarshalled. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Value: "+v.String(),
+		)
+
+		return diags
+	}
+
+	err := json.Unmarshal([]byte(v.ValueString()), target)
+
+	if err != nil {
+		diags.AddError(
+			"Normalized JSON Unmarshal Error",
+			"An unexpected error occurred while unmarshalling a Normalized JSON value. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// normalizeJSON returns the given JSON string re-encoded without
+// insignificant whitespace and with object keys sorted.
+func normalizeJSON(value string) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(value)))
+
+	// Preserve the exact representation of numbers, which would otherwise
+	// lose precision when decoded into float64.
+	decoder.UseNumber()
+
+	var decoded any
+
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	if decoder.More() {
+		return nil, errors.New("unexpected data after top-level JSON value")
+	}
+
+	return json.Marshal(decoded)
+}
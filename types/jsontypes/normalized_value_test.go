@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/jsontypes"
+)
+
+func TestNormalizedStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current       jsontypes.Normalized
+		given         basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"exact": {
+			current:       jsontypes.NewNormalizedValue(`{"a":1,"b":[true,null]}`),
+			given:         jsontypes.NewNormalizedValue(`{"a":1,"b":[true,null]}`),
+			expectedMatch: true,
+		},
+		"semantically-equal-whitespace": {
+			current:       jsontypes.NewNormalizedValue(`{"a":1,"b":[true,null]}`),
+			given:         jsontypes.NewNormalizedValue("{\n  \"a\": 1,\n  \"b\": [ true, null ]\n}\n"),
+			expectedMatch: true,
+		},
+		"semantically-equal-key-order": {
+			current:       jsontypes.NewNormalizedValue(`{"Statement":[{"Effect":"Allow","Action":"s3:GetObject"}],"Version":"2012-10-17"}`),
+			given:         jsontypes.NewNormalizedValue(`{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow"}]}`),
+			expectedMatch: true,
+		},
+		"different-array-order": {
+			current:       jsontypes.NewNormalizedValue(`[1,2]`),
+			given:         jsontypes.NewNormalizedValue(`[2,1]`),
+			expectedMatch: false,
+		},
+		"different-large-number": {
+			current:       jsontypes.NewNormalizedValue(`{"id":9007199254740993}`),
+			given:         jsontypes.NewNormalizedValue(`{"id":9007199254740992}`),
+			expectedMatch: false,
+		},
+		"invalid": {
+			current:       jsontypes.NewNormalizedValue(`{"a":1}`),
+			given:         jsontypes.NewNormalizedValue(`{"a":1`),
+			expectedMatch: false,
+		},
+		"wrong-type": {
+			current:       jsontypes.NewNormalizedValue(`{"a":1}`),
+			given:         basetypes.NewStringValue(`{"a":1}`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.Normalized\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.current.StringSemanticEquals(context.Background(), testCase.given)
+
+			if match != testCase.expectedMatch {
+				t.Errorf("expected match %t, got %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizedValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         jsontypes.Normalized
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			value: jsontypes.NewNormalizedValue(`{"a":[1,2,3]}`),
+		},
+		"null": {
+			value: jsontypes.NewNormalizedNull(),
+		},
+		"unknown": {
+			value: jsontypes.NewNormalizedUnknown(),
+		},
+		"invalid": {
+			value: jsontypes.NewNormalizedValue(`{"a":`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid JSON String Value",
+					"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+						"Path: test\n"+
+						"Given Value: {\"a\":",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.value.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizedValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         jsontypes.Normalized
+		expectedError *function.FuncError
+	}{
+		"valid": {
+			value: jsontypes.NewNormalizedValue(`"a"`),
+		},
+		"null": {
+			value: jsontypes.NewNormalizedNull(),
+		},
+		"invalid": {
+			value: jsontypes.NewNormalizedValue(`a`),
+			expectedError: function.NewArgumentFuncError(
+				0,
+				"Invalid JSON String Value: "+
+					"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+					"Position: 0\n"+
+					"Given Value: a",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.value.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizedUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	type policy struct {
+		Version string `json:"Version"`
+	}
+
+	testCases := map[string]struct {
+		value         jsontypes.Normalized
+		expected      policy
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			value:    jsontypes.NewNormalizedValue(`{"Version":"2012-10-17"}`),
+			expected: policy{Version: "2012-10-17"},
+		},
+		"unknown": {
+			value: jsontypes.NewNormalizedUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Normalized JSON Unmarshal Error",
+					"A null or unknown Normalized cannot be unmarshalled. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Value: <unknown>",
+				),
+			},
+		},
+		"wrong-target": {
+			value: jsontypes.NewNormalizedValue(`{"Version":1}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Normalized JSON Unmarshal Error",
+					"An unexpected error occurred while unmarshalling a Normalized JSON value. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: json: cannot unmarshal number into Go struct field policy.Version of type string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got policy
+
+			diags := testCase.value.Unmarshal(&got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
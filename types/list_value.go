@@ -48,3 +48,12 @@ func ListValueFrom(ctx context.Context, elementType attr.Type, elements any) (ba
 func ListValueMust(elementType attr.Type, elements []attr.Value) basetypes.ListValue {
 	return basetypes.NewListValueMust(elementType, elements)
 }
+
+type ListBuilder = basetypes.ListBuilder
+
+// ListValueBuilder creates a ListBuilder without elements, which constructs
+// a List element by element. Use the List type Builder method to start from
+// the elements of an existing List.
+func ListValueBuilder(elementType attr.Type) basetypes.ListBuilder {
+	return basetypes.NewListBuilder(elementType)
+}
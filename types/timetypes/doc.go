@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package timetypes contains string-backed custom types for time-related
+// values, with validation and semantic equality logic:
+//
+//   - Duration: Go duration strings, such as 1h30m, where 1h and 60m are
+//     semantically equal.
+//   - RFC3339: RFC 3339 timestamp strings, such as 2006-01-02T15:04:05Z, where
+//     timestamps representing the same instant in different time zones are
+//     semantically equal.
+//
+// Use the ValueDuration and ValueTime methods to convert known values into a
+// time.Duration or time.Time.
+package timetypes
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ basetypes.StringTypable = DurationType{}
+
+// DurationType is a string type for Go duration strings, as accepted by the
+// time.ParseDuration function. Duration is the associated value type.
+type DurationType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the given type is equivalent.
+func (t DurationType) Equal(o attr.Type) bool {
+	_, ok := o.(DurationType)
+
+	return ok
+}
+
+// String returns a human readable string of the type name.
+func (t DurationType) String() string {
+	return "timetypes.DurationType"
+}
+
+// ValueFromString returns a Duration given a StringValue.
+func (t DurationType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Duration{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Duration given a tftypes.Value.
+func (t DurationType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return Duration{
+		StringValue: stringValue,
+	}, nil
+}
+
+// ValueType returns the Duration type.
+func (t DurationType) ValueType(_ context.Context) attr.Value {
+	return Duration{}
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = Duration{}
+	_ xattr.ValidateableAttribute                = Duration{}
+	_ function.ValidateableParameter             = Duration{}
+)
+
+// Duration is a Go duration string value of DurationType.
+type Duration struct {
+	basetypes.StringValue
+}
+
+// NewDurationNull creates a Duration with a null value.
+func NewDurationNull() Duration {
+	return Duration{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewDurationUnknown creates a Duration with an unknown value.
+func NewDurationUnknown() Duration {
+	return Duration{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewDurationValue creates a Duration with a known value. The value is not
+// validated until the Duration is used with the framework.
+func NewDurationValue(value string) Duration {
+	return Duration{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewDurationTimeValue creates a Duration with a known value from the given
+// time.Duration, formatted by its String method.
+func NewDurationTimeValue(value time.Duration) Duration {
+	return NewDurationValue(value.String())
+}
+
+// Equal returns true if the given value is a Duration with the same string
+// value. Use StringSemanticEquals to compare the represented durations.
+func (v Duration) Equal(o attr.Value) bool {
+	other, ok := o.(Duration)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns a DurationType.
+func (v Duration) Type(_ context.Context) attr.Type {
+	return DurationType{}
+}
+
+// StringSemanticEquals returns true if the given value represents the same
+// duration, such as 1h and 60m.
+func (v Duration) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Duration)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorDuration, err := time.ParseDuration(v.ValueString())
+
+	// Invalid values are reported by validation.
+	if err != nil {
+		return false, diags
+	}
+
+	newDuration, err := time.ParseDuration(newValue.ValueString())
+
+	if err != nil {
+		return false, diags
+	}
+
+	return priorDuration == newDuration, diags
+}
+
+// ValidateAttribute implements the xattr.ValidateableAttribute interface,
+// returning an error diagnostic if the known value is not a valid Go duration
+// string.
+func (v Duration) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	_, err := time.ParseDuration(v.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration String Value",
+			"A string value was provided that is not a valid Go duration string. "+
+				"Duration strings are a sequence of decimal numbers with unit suffixes, such as 30s, 1.5h, or 2h45m. "+
+				"Valid units are ns, us, ms, s, m, and h.\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+// ValidateParameter implements the function.ValidateableParameter interface,
+// returning an error if the known value is not a valid Go duration string.
+func (v Duration) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	_, err := time.ParseDuration(v.ValueString())
+
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Duration String Value: "+
+				"A string value was provided that is not a valid Go duration string. "+
+				"Duration strings are a sequence of decimal numbers with unit suffixes, such as 30s, 1.5h, or 2h45m. "+
+				"Valid units are ns, us, ms, s, m, and h.\n\n"+
+				fmt.Sprintf("Position: %d", req.Position)+"\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+// ValueDuration returns the known value as a time.Duration. An error
+// diagnostic is returned if the value is null, unknown, or not a valid Go
+// duration string.
+func (v Duration) ValueDuration() (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		diags.AddError(
+			"Duration ValueDuration Error",
+			"A null or unknown Duration cannot be converted to a time.Duration. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Value: "+v.String(),
+		)
+
+		return 0, diags
+	}
+
+	duration, err := time.ParseDuration(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Duration ValueDuration Error",
+			"An unexpected error occurred while converting a Duration to a time.Duration. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return 0, diags
+	}
+
+	return duration, diags
+}
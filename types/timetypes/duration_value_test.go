@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+)
+
+func TestDurationStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentDuration timetypes.Duration
+		givenDuration   basetypes.StringValuable
+		expectedMatch   bool
+		expectedDiags   diag.Diagnostics
+	}{
+		"exact": {
+			currentDuration: timetypes.NewDurationValue("1h"),
+			givenDuration:   timetypes.NewDurationValue("1h"),
+			expectedMatch:   true,
+		},
+		"semantically-equal": {
+			currentDuration: timetypes.NewDurationValue("1h"),
+			givenDuration:   timetypes.NewDurationValue("60m"),
+			expectedMatch:   true,
+		},
+		"semantically-equal-mixed-units": {
+			currentDuration: timetypes.NewDurationValue("1h30m"),
+			givenDuration:   timetypes.NewDurationValue("5400s"),
+			expectedMatch:   true,
+		},
+		"different": {
+			currentDuration: timetypes.NewDurationValue("1h"),
+			givenDuration:   timetypes.NewDurationValue("61m"),
+			expectedMatch:   false,
+		},
+		"invalid": {
+			currentDuration: timetypes.NewDurationValue("1h"),
+			givenDuration:   timetypes.NewDurationValue("one hour"),
+			expectedMatch:   false,
+		},
+		"wrong-type": {
+			currentDuration: timetypes.NewDurationValue("1h"),
+			givenDuration:   basetypes.NewStringValue("1h"),
+			expectedMatch:   false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.Duration\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentDuration.StringSemanticEquals(context.Background(), testCase.givenDuration)
+
+			if match != testCase.expectedMatch {
+				t.Errorf("expected match %t, got %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDurationValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		duration      timetypes.Duration
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			duration: timetypes.NewDurationValue("2h45m"),
+		},
+		"null": {
+			duration: timetypes.NewDurationNull(),
+		},
+		"unknown": {
+			duration: timetypes.NewDurationUnknown(),
+		},
+		"invalid": {
+			duration: timetypes.NewDurationValue("one hour"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Duration String Value",
+					"A string value was provided that is not a valid Go duration string. "+
+						"Duration strings are a sequence of decimal numbers with unit suffixes, such as 30s, 1.5h, or 2h45m. "+
+						"Valid units are ns, us, ms, s, m, and h.\n\n"+
+						"Path: test\n"+
+						"Given Value: one hour\n"+
+						"Error: time: invalid duration \"one hour\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.duration.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDurationValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		duration      timetypes.Duration
+		expectedError *function.FuncError
+	}{
+		"valid": {
+			duration: timetypes.NewDurationValue("30s"),
+		},
+		"null": {
+			duration: timetypes.NewDurationNull(),
+		},
+		"unknown": {
+			duration: timetypes.NewDurationUnknown(),
+		},
+		"invalid": {
+			duration: timetypes.NewDurationValue("30"),
+			expectedError: function.NewArgumentFuncError(
+				0,
+				"Invalid Duration String Value: "+
+					"A string value was provided that is not a valid Go duration string. "+
+					"Duration strings are a sequence of decimal numbers with unit suffixes, such as 30s, 1.5h, or 2h45m. "+
+					"Valid units are ns, us, ms, s, m, and h.\n\n"+
+					"Position: 0\n"+
+					"Given Value: 30\n"+
+					"Error: time: missing unit in duration \"30\"",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.duration.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDurationValueDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		duration         timetypes.Duration
+		expectedDuration time.Duration
+		expectedDiags    diag.Diagnostics
+	}{
+		"valid": {
+			duration:         timetypes.NewDurationValue("1h30m"),
+			expectedDuration: 90 * time.Minute,
+		},
+		"from-time-duration": {
+			duration:         timetypes.NewDurationTimeValue(90 * time.Second),
+			expectedDuration: 90 * time.Second,
+		},
+		"null": {
+			duration: timetypes.NewDurationNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Duration ValueDuration Error",
+					"A null or unknown Duration cannot be converted to a time.Duration. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Value: <null>",
+				),
+			},
+		},
+		"invalid": {
+			duration: timetypes.NewDurationValue("1 hour"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Duration ValueDuration Error",
+					"An unexpected error occurred while converting a Duration to a time.Duration. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: time: unknown unit \" hour\" in duration \"1 hour\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.duration.ValueDuration()
+
+			if got != testCase.expectedDuration {
+				t.Errorf("expected %s, got %s", testCase.expectedDuration, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
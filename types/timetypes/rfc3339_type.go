@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ basetypes.StringTypable = RFC3339Type{}
+
+// RFC3339Type is a string type for RFC 3339 timestamp strings. RFC3339 is the
+// associated value type.
+type RFC3339Type struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the given type is equivalent.
+func (t RFC3339Type) Equal(o attr.Type) bool {
+	_, ok := o.(RFC3339Type)
+
+	return ok
+}
+
+// String returns a human readable string of the type name.
+func (t RFC3339Type) String() string {
+	return "timetypes.RFC3339Type"
+}
+
+// ValueFromString returns a RFC3339 given a StringValue.
+func (t RFC3339Type) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return RFC3339{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a RFC3339 given a tftypes.Value.
+func (t RFC3339Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return RFC3339{
+		StringValue: stringValue,
+	}, nil
+}
+
+// ValueType returns the RFC3339 type.
+func (t RFC3339Type) ValueType(_ context.Context) attr.Value {
+	return RFC3339{}
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = RFC3339{}
+	_ xattr.ValidateableAttribute                = RFC3339{}
+	_ function.ValidateableParameter             = RFC3339{}
+)
+
+// RFC3339 is an RFC 3339 timestamp string value of RFC3339Type.
+type RFC3339 struct {
+	basetypes.StringValue
+}
+
+// NewRFC3339Null creates a RFC3339 with a null value.
+func NewRFC3339Null() RFC3339 {
+	return RFC3339{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewRFC3339Unknown creates a RFC3339 with an unknown value.
+func NewRFC3339Unknown() RFC3339 {
+	return RFC3339{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewRFC3339Value creates a RFC3339 with a known value. The value is not
+// validated until the RFC3339 is used with the framework.
+func NewRFC3339Value(value string) RFC3339 {
+	return RFC3339{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewRFC3339TimeValue creates a RFC3339 with a known value from the given
+// time.Time, formatted with the time.RFC3339Nano layout.
+func NewRFC3339TimeValue(value time.Time) RFC3339 {
+	return NewRFC3339Value(value.Format(time.RFC3339Nano))
+}
+
+// Equal returns true if the given value is a RFC3339 with the same string
+// value. Use StringSemanticEquals to compare the represented instants.
+func (v RFC3339) Equal(o attr.Value) bool {
+	other, ok := o.(RFC3339)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns a RFC3339Type.
+func (v RFC3339) Type(_ context.Context) attr.Type {
+	return RFC3339Type{}
+}
+
+// StringSemanticEquals returns true if the given value represents the same
+// instant, such as 2006-01-02T15:04:05Z and 2006-01-02T16:04:05+01:00.
+func (v RFC3339) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(RFC3339)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorTime, err := time.Parse(time.RFC3339, v.ValueString())
+
+	// Invalid values are reported by validation.
+	if err != nil {
+		return false, diags
+	}
+
+	newTime, err := time.Parse(time.RFC3339, newValue.ValueString())
+
+	if err != nil {
+		return false, diags
+	}
+
+	return priorTime.Equal(newTime), diags
+}
+
+// ValidateAttribute implements the xattr.ValidateableAttribute interface,
+// returning an error diagnostic if the known value is not a valid RFC 3339
+// timestamp string.
+func (v RFC3339) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	_, err := time.Parse(time.RFC3339, v.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid RFC 3339 String Value",
+			"A string value was provided that is not valid RFC 3339 string format. "+
+				"The RFC 3339 string format is YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05+07:00.\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+// ValidateParameter implements the function.ValidateableParameter interface,
+// returning an error if the known value is not a valid RFC 3339 timestamp
+// string.
+func (v RFC3339) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	_, err := time.Parse(time.RFC3339, v.ValueString())
+
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid RFC 3339 String Value: "+
+				"A string value was provided that is not valid RFC 3339 string format. "+
+				"The RFC 3339 string format is YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05+07:00.\n\n"+
+				fmt.Sprintf("Position: %d", req.Position)+"\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+// ValueTime returns the known value as a time.Time. An error diagnostic is
+// returned if the value is null, unknown, or not a valid RFC 3339 timestamp
+// string.
+func (v RFC3339) ValueTime() (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		diags.AddError(
+			"RFC3339 ValueTime Error",
+			"A null or unknown RFC3339 cannot be converted to a time.Time. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Value: "+v.String(),
+		)
+
+		return time.Time{}, diags
+	}
+
+	t, err := time.Parse(time.RFC3339, v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"RFC3339 ValueTime Error",
+			"An unexpected error occurred while converting a RFC3339 to a time.Time. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return time.Time{}, diags
+	}
+
+	return t, diags
+}
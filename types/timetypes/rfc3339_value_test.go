@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+)
+
+func TestRFC3339StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentTimestamp timetypes.RFC3339
+		givenTimestamp   basetypes.StringValuable
+		expectedMatch    bool
+		expectedDiags    diag.Diagnostics
+	}{
+		"exact": {
+			currentTimestamp: timetypes.NewRFC3339Value("2006-01-02T15:04:05Z"),
+			givenTimestamp:   timetypes.NewRFC3339Value("2006-01-02T15:04:05Z"),
+			expectedMatch:    true,
+		},
+		"semantically-equal-offset": {
+			currentTimestamp: timetypes.NewRFC3339Value("2006-01-02T15:04:05Z"),
+			givenTimestamp:   timetypes.NewRFC3339Value("2006-01-02T16:04:05+01:00"),
+			expectedMatch:    true,
+		},
+		"semantically-equal-fractional-seconds": {
+			currentTimestamp: timetypes.NewRFC3339Value("2006-01-02T15:04:05Z"),
+			givenTimestamp:   timetypes.NewRFC3339Value("2006-01-02T15:04:05.000Z"),
+			expectedMatch:    true,
+		},
+		"different": {
+			currentTimestamp: timetypes.NewRFC3339Value("2006-01-02T15:04:05Z"),
+			givenTimestamp:   timetypes.NewRFC3339Value("2006-01-02T15:04:05+01:00"),
+			expectedMatch:    false,
+		},
+		"invalid": {
+			currentTimestamp: timetypes.NewRFC3339Value("2006-01-02T15:04:05Z"),
+			givenTimestamp:   timetypes.NewRFC3339Value("2006-01-02"),
+			expectedMatch:    false,
+		},
+		"wrong-type": {
+			currentTimestamp: timetypes.NewRFC3339Value("2006-01-02T15:04:05Z"),
+			givenTimestamp:   basetypes.NewStringValue("2006-01-02T15:04:05Z"),
+			expectedMatch:    false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.RFC3339\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentTimestamp.StringSemanticEquals(context.Background(), testCase.givenTimestamp)
+
+			if match != testCase.expectedMatch {
+				t.Errorf("expected match %t, got %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339ValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		timestamp     timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			timestamp: timetypes.NewRFC3339Value("2006-01-02T15:04:05+07:00"),
+		},
+		"null": {
+			timestamp: timetypes.NewRFC3339Null(),
+		},
+		"unknown": {
+			timestamp: timetypes.NewRFC3339Unknown(),
+		},
+		"invalid": {
+			timestamp: timetypes.NewRFC3339Value("2006-01-02"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC 3339 String Value",
+					"A string value was provided that is not valid RFC 3339 string format. "+
+						"The RFC 3339 string format is YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05+07:00.\n\n"+
+						"Path: test\n"+
+						"Given Value: 2006-01-02\n"+
+						"Error: parsing time \"2006-01-02\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"\" as \"T\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.timestamp.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339ValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		timestamp     timetypes.RFC3339
+		expectedError *function.FuncError
+	}{
+		"valid": {
+			timestamp: timetypes.NewRFC3339Value("2006-01-02T15:04:05Z"),
+		},
+		"null": {
+			timestamp: timetypes.NewRFC3339Null(),
+		},
+		"unknown": {
+			timestamp: timetypes.NewRFC3339Unknown(),
+		},
+		"invalid": {
+			timestamp: timetypes.NewRFC3339Value("2006-01-02"),
+			expectedError: function.NewArgumentFuncError(
+				0,
+				"Invalid RFC 3339 String Value: "+
+					"A string value was provided that is not valid RFC 3339 string format. "+
+					"The RFC 3339 string format is YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05+07:00.\n\n"+
+					"Position: 0\n"+
+					"Given Value: 2006-01-02\n"+
+					"Error: parsing time \"2006-01-02\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"\" as \"T\"",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.timestamp.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339ValueTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		timestamp     timetypes.RFC3339
+		expectedTime  time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			timestamp:    timetypes.NewRFC3339Value("2006-01-02T15:04:05Z"),
+			expectedTime: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"from-time": {
+			timestamp:    timetypes.NewRFC3339TimeValue(time.Date(2006, 1, 2, 15, 4, 5, 123, time.UTC)),
+			expectedTime: time.Date(2006, 1, 2, 15, 4, 5, 123, time.UTC),
+		},
+		"unknown": {
+			timestamp: timetypes.NewRFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RFC3339 ValueTime Error",
+					"A null or unknown RFC3339 cannot be converted to a time.Time. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Value: <unknown>",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.timestamp.ValueTime()
+
+			if !got.Equal(testCase.expectedTime) {
+				t.Errorf("expected %s, got %s", testCase.expectedTime, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}